@@ -2,15 +2,40 @@ package main
 
 import (
 	"fmt"
+	"github.com/UpMeetApp/server/pkg/abuse"
+	"github.com/UpMeetApp/server/pkg/attendance"
+	"github.com/UpMeetApp/server/pkg/avatar"
+	"github.com/UpMeetApp/server/pkg/block"
+	"github.com/UpMeetApp/server/pkg/broker"
+	"github.com/UpMeetApp/server/pkg/chat"
 	"github.com/UpMeetApp/server/pkg/config"
+	"github.com/UpMeetApp/server/pkg/conversation"
+	"github.com/UpMeetApp/server/pkg/device"
 	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/UpMeetApp/server/pkg/email"
+	"github.com/UpMeetApp/server/pkg/filter"
+	"github.com/UpMeetApp/server/pkg/invitation"
+	"github.com/UpMeetApp/server/pkg/job"
+	"github.com/UpMeetApp/server/pkg/meetup"
+	"github.com/UpMeetApp/server/pkg/moderation"
+	"github.com/UpMeetApp/server/pkg/notification"
+	"github.com/UpMeetApp/server/pkg/outbox"
+	"github.com/UpMeetApp/server/pkg/push"
+	"github.com/UpMeetApp/server/pkg/ratelimit"
+	"github.com/UpMeetApp/server/pkg/realtime"
+	"github.com/UpMeetApp/server/pkg/review"
 	"github.com/UpMeetApp/server/pkg/server"
+	"github.com/UpMeetApp/server/pkg/storage"
 	"github.com/UpMeetApp/server/pkg/user"
+	"github.com/UpMeetApp/server/pkg/verification"
+	"github.com/UpMeetApp/server/pkg/webhook"
 	"github.com/getsentry/sentry-go"
 	"go.uber.org/zap"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"log"
+	"time"
+	_ "time/tzdata"
 )
 
 func main() {
@@ -35,15 +60,179 @@ func main() {
 		zap.L().Fatal("failed to connect to database", zap.Error(err))
 	}
 
-	err = db.AutoMigrate(domain.User{}, domain.Meetup{}, domain.ParticipantPermissions{})
+	err = db.AutoMigrate(
+		domain.User{},
+		domain.EmailVerification{},
+		domain.Meetup{},
+		domain.ParticipantPermissions{},
+		domain.Invitation{},
+		domain.Attendance{},
+		domain.Review{},
+		domain.Message{},
+		domain.Reaction{},
+		domain.ReadMarker{},
+		domain.Conversation{},
+		domain.ConversationMember{},
+		domain.Notification{},
+		domain.NotificationSettings{},
+		domain.NotificationPreference{},
+		domain.DeferredPush{},
+		domain.Device{},
+		domain.Job{},
+		domain.Event{},
+		domain.Webhook{},
+		domain.WebhookDelivery{},
+		domain.Block{},
+		domain.Report{},
+		domain.ModerationAction{},
+		domain.Appeal{},
+		domain.AgeVerification{},
+		domain.UserAction{},
+		domain.AbuseSignal{},
+	)
 	if err != nil {
 		sentry.CaptureException(err)
 		zap.L().Fatal("failed to migrate database", zap.Error(err))
 	}
+	err = user.MigrateAgeToBirthdate(db)
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Fatal("failed to migrate user ages", zap.Error(err))
+	}
+	err = job.MigrateKeyIndex(db)
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Fatal("failed to migrate job key index", zap.Error(err))
+	}
 
 	userRepository := user.NewUserRepository(db)
-	userService := user.NewUserService(userRepository)
+	emailVerificationRepository := user.NewEmailVerificationRepository(db)
+	meetupRepository := meetup.NewMeetupRepository(db)
+	invitationRepository := invitation.NewInvitationRepository(db)
+	attendanceRepository := attendance.NewAttendanceRepository(db)
+	reviewRepository := review.NewReviewRepository(db)
+	messageRepository := chat.NewMessageRepository(db)
+	readMarkerRepository := chat.NewReadMarkerRepository(db)
+	conversationRepository := conversation.NewConversationRepository(db)
+	notificationRepository := notification.NewNotificationRepository(db)
+	notificationSettingsRepository := notification.NewNotificationSettingsRepository(db)
+	deviceRepository := device.NewDeviceRepository(db)
+	jobRepository := job.NewJobRepository(db)
+	outboxRepository := outbox.NewOutboxRepository(db)
+	webhookRepository := webhook.NewWebhookRepository(db)
+	webhookDeliveryRepository := webhook.NewWebhookDeliveryRepository(db)
+	blockRepository := block.NewBlockRepository(db)
+	reportRepository := moderation.NewReportRepository(db)
+	moderationActionRepository := moderation.NewModerationActionRepository(db)
+	appealRepository := moderation.NewAppealRepository(db)
+	ageVerificationRepository := verification.NewAgeVerificationRepository(db)
+	userActionRepository := abuse.NewUserActionRepository(db)
+	abuseSignalRepository := abuse.NewAbuseSignalRepository(db)
+
+	fbApp := server.NewFirebaseApp(cfg)
+	hub := realtime.NewHub()
+
+	var pushSender domain.PushSender
+	switch cfg.PushSender {
+	case "fcm":
+		pushSender = push.NewFCMSender(fbApp)
+	case "log":
+		pushSender = push.NewLogSender()
+	default:
+		zap.L().Fatal("unknown push sender", zap.String("push_sender", cfg.PushSender))
+	}
+	emailSender := email.NewSMTPSender(cfg)
+
+	var eventPublisher domain.EventPublisher
+	switch cfg.EventPublisher {
+	case "nats":
+		eventPublisher, err = broker.NewNATSPublisher(cfg.NATSURL)
+		if err != nil {
+			sentry.CaptureException(err)
+			zap.L().Fatal("failed to connect to nats", zap.Error(err))
+		}
+	case "memory":
+		eventPublisher = broker.NewMemoryPublisher()
+	default:
+		zap.L().Fatal("unknown event publisher", zap.String("event_publisher", cfg.EventPublisher))
+	}
+	defer eventPublisher.Close()
+
+	var rateLimitStore domain.RateLimitStore
+	switch cfg.RateLimitStore {
+	case "redis":
+		rateLimitStore, err = ratelimit.NewRedisStore(cfg.RedisURL)
+		if err != nil {
+			sentry.CaptureException(err)
+			zap.L().Fatal("failed to connect to redis", zap.Error(err))
+		}
+	case "memory":
+		rateLimitStore = ratelimit.NewMemoryStore()
+	default:
+		zap.L().Fatal("unknown rate limit store", zap.String("rate_limit_store", cfg.RateLimitStore))
+	}
+	defer rateLimitStore.Close()
+
+	var fileStorage domain.FileStorage
+	switch cfg.FileStorage {
+	case "gcs":
+		fileStorage = storage.NewGCSStorage(fbApp, cfg.StorageBucket, cfg.FileBaseURL)
+	case "local":
+		baseURL := cfg.FileBaseURL
+		if len(baseURL) == 0 {
+			baseURL = "/files"
+		}
+		fileStorage = storage.NewLocalStorage(cfg.FileStorageDir, baseURL)
+	default:
+		zap.L().Fatal("unknown file storage", zap.String("file_storage", cfg.FileStorage))
+	}
+
+	contentFilter, err := filter.NewContentFilter(cfg.ContentFilterWords, cfg.ReservedUsernames)
+	if err != nil {
+		zap.L().Fatal("failed to load content filter", zap.Error(err))
+	}
+	contentFilter.Start()
+
+	jobScheduler := job.NewJobScheduler(jobRepository)
+	eventRelay := outbox.NewRelay(outboxRepository)
+
+	abuseService := abuse.NewAbuseService(userActionRepository, abuseSignalRepository, userRepository, cfg.AbuseLimits())
+	abuseService.Start()
+	avatarService := avatar.NewAvatarService(userRepository, fileStorage)
+	notificationService := notification.NewNotificationService(notificationRepository, notificationSettingsRepository, deviceRepository, userRepository, meetupRepository, pushSender, emailSender, jobScheduler, hub)
+	deviceService := device.NewDeviceService(deviceRepository)
+	userService := user.NewUserService(userRepository, attendanceRepository, reviewRepository, blockRepository, emailVerificationRepository, contentFilter, avatarService, emailSender, cfg.EmailVerificationURL)
+	meetupService := meetup.NewMeetupService(meetupRepository, userRepository, invitationRepository, blockRepository, contentFilter, abuseService, notificationService, jobScheduler, hub, cfg.MeetupReminderOffset)
+	attendanceService := attendance.NewAttendanceService(attendanceRepository, meetupRepository)
+	reviewService := review.NewReviewService(reviewRepository, meetupRepository, attendanceRepository, review.NewContentFilterModerator(contentFilter))
+	invitationService := invitation.NewInvitationService(invitationRepository, meetupRepository, userRepository, blockRepository, abuseService, notificationService, hub)
+	chatService := chat.NewChatService(messageRepository, readMarkerRepository, meetupRepository, conversationRepository, hub)
+	conversationService := conversation.NewConversationService(conversationRepository, messageRepository, readMarkerRepository, userRepository, blockRepository, hub)
+	blockService := block.NewBlockService(blockRepository, userRepository)
+	moderationService := moderation.NewModerationService(reportRepository, moderationActionRepository, appealRepository, userRepository, meetupRepository, meetupService, messageRepository, conversationRepository, avatarService, notificationService, hub)
+	ageVerificationService := verification.NewAgeVerificationService(ageVerificationRepository, userRepository, notificationService)
+	webhookService := webhook.NewWebhookService(webhookRepository, webhookDeliveryRepository, meetupRepository, jobScheduler, &webhook.Dialer{})
+
+	jobScheduler.Register(domain.JobTypeMeetupReminder, meetupService.SendMeetupReminder)
+	jobScheduler.Register(domain.JobTypeWebhookDelivery, webhookService.DeliverWebhook)
+	jobScheduler.Register(domain.JobTypeNotification, notificationService.SendNotifications)
+	jobScheduler.Register(domain.JobTypeWeeklyDigest, notificationService.SendWeeklyDigests)
+	jobScheduler.Start()
+	err = notificationService.ScheduleWeeklyDigest()
+	if err != nil {
+		zap.L().Fatal("failed to schedule weekly digest", zap.Error(err))
+	}
+	eventRelay.Subscribe(domain.EventTypeAll, webhookService.DispatchEvent)
+	eventRelay.Subscribe(domain.EventTypeAll, broker.Forwarder(eventPublisher))
+	eventRelay.Start()
+
+	// Push notifications deferred during quiet hours are sent once the quiet hours are over.
+	go func() {
+		for range time.Tick(time.Minute) {
+			_ = notificationService.SendDeferredPushes()
+		}
+	}()
 
-	s := server.New(cfg, userService)
+	s := server.New(cfg, fbApp, hub, userService, meetupService, attendanceService, reviewService, chatService, conversationService, invitationService, notificationService, deviceService, webhookService, blockService, moderationService, abuseService, ageVerificationService, avatarService, rateLimitStore)
 	s.Start(cfg.BindAddress)
 }
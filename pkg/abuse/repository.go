@@ -0,0 +1,54 @@
+package abuse
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"time"
+)
+
+type userActionRepository struct {
+	db *gorm.DB
+}
+
+// NewUserActionRepository creates a new user action repository instance.
+func NewUserActionRepository(db *gorm.DB) domain.UserActionRepository {
+	return &userActionRepository{
+		db: db,
+	}
+}
+
+func (r *userActionRepository) CreateUserAction(a *domain.UserAction) error {
+	err := r.db.Create(a).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to create user action", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *userActionRepository) CountUserActions(userID string, action string, since time.Time) (int64, error) {
+	var count int64
+	err := r.db.Model(&domain.UserAction{}).
+		Where("user_id = ? AND action = ? AND created_at > ?", userID, action, since).
+		Count(&count).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to count user actions", zap.Error(err))
+		return 0, fiber.ErrInternalServerError
+	}
+	return count, nil
+}
+
+func (r *userActionRepository) DeleteUserActionsBefore(before time.Time) error {
+	err := r.db.Where("created_at < ?", before).Delete(&domain.UserAction{}).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to delete user actions", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
@@ -0,0 +1,189 @@
+package abuse
+
+import (
+	"fmt"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"strings"
+	"sync"
+	"time"
+)
+
+type abuseService struct {
+	userActionRepository  domain.UserActionRepository
+	abuseSignalRepository domain.AbuseSignalRepository
+	userRepository        domain.UserRepository
+	limits                domain.AbuseLimits
+	scorers               []domain.AbuseScorer
+	mu                    sync.RWMutex
+}
+
+// NewAbuseService creates a new abuse service instance.
+// The quota and burst scorers for the limits are registered right away, further scorers can be added with RegisterScorer.
+func NewAbuseService(userActionRepository domain.UserActionRepository, abuseSignalRepository domain.AbuseSignalRepository, userRepository domain.UserRepository, limits domain.AbuseLimits) domain.AbuseService {
+	s := &abuseService{
+		userActionRepository:  userActionRepository,
+		abuseSignalRepository: abuseSignalRepository,
+		userRepository:        userRepository,
+		limits:                limits,
+	}
+	s.RegisterScorer(QuotaScorer(limits))
+	s.RegisterScorer(BurstScorer(limits))
+	return s
+}
+
+func (s *abuseService) RegisterScorer(scorer domain.AbuseScorer) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.scorers = append(s.scorers, scorer)
+}
+
+func (s *abuseService) Check(uid string, action string) error {
+	u, err := s.userRepository.GetUserByID(uid)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	c := &domain.AbuseCheck{
+		UserID:     uid,
+		Action:     action,
+		AccountAge: now.Sub(u.CreatedAt),
+	}
+	for _, count := range []struct {
+		count  *int64
+		window time.Duration
+	}{
+		{&c.MinuteCount, time.Minute},
+		{&c.HourCount, time.Hour},
+		{&c.DayCount, 24 * time.Hour},
+	} {
+		*count.count, err = s.userActionRepository.CountUserActions(uid, action, now.Add(-count.window))
+		if err != nil {
+			return err
+		}
+	}
+
+	score, reasons := s.score(c)
+	decision := domain.AbuseDecisionAllow
+	switch {
+	case score >= s.limits.BlockScore:
+		decision = domain.AbuseDecisionBlock
+	case score >= s.limits.FlagScore:
+		decision = domain.AbuseDecisionFlag
+	}
+
+	if decision != domain.AbuseDecisionAllow {
+		err = s.abuseSignalRepository.CreateAbuseSignal(&domain.AbuseSignal{
+			ID:        uuid.NewString(),
+			UserID:    uid,
+			Action:    action,
+			Score:     score,
+			Decision:  decision,
+			Reasons:   strings.Join(reasons, "; "),
+			CreatedAt: now,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	if decision == domain.AbuseDecisionBlock {
+		return domain.ErrActionLimitExceeded
+	}
+	return s.userActionRepository.CreateUserAction(&domain.UserAction{
+		ID:        uuid.NewString(),
+		UserID:    uid,
+		Action:    action,
+		CreatedAt: now,
+	})
+}
+
+func (s *abuseService) GetAbuseSignals(filter *domain.AbuseSignalFilter) ([]*domain.AbuseSignal, error) {
+	if filter.Limit <= 0 || filter.Limit > domain.AbuseSignalsMaxLimit {
+		filter.Limit = domain.AbuseSignalsDefaultLimit
+	}
+	return s.abuseSignalRepository.GetAbuseSignals(filter)
+}
+
+func (s *abuseService) Start() {
+	go func() {
+		for range time.Tick(domain.UserActionCleanupInterval) {
+			_ = s.userActionRepository.DeleteUserActionsBefore(time.Now().Add(-domain.UserActionRetention))
+		}
+	}()
+}
+
+// score adds up the scores of all scorers. A panicking scorer is logged and skipped, so it can't break the checked action.
+func (s *abuseService) score(c *domain.AbuseCheck) (int, []string) {
+	s.mu.RLock()
+	scorers := s.scorers
+	s.mu.RUnlock()
+
+	total := 0
+	var reasons []string
+	for _, scorer := range scorers {
+		score, reason := safeScore(scorer, c)
+		if score != 0 {
+			total += score
+			reasons = append(reasons, reason)
+		}
+	}
+	return total, reasons
+}
+
+func safeScore(scorer domain.AbuseScorer, c *domain.AbuseCheck) (score int, reason string) {
+	defer func() {
+		if r := recover(); r != nil {
+			zap.L().Error("abuse scorer panicked", zap.String("action", c.Action), zap.Any("panic", r))
+			score, reason = 0, ""
+		}
+	}()
+	return scorer(c)
+}
+
+// QuotaScorer blocks actions over the quota of the user, which is lower for new accounts.
+// Meetup creation is limited per day, invitations and joins per hour.
+func QuotaScorer(limits domain.AbuseLimits) domain.AbuseScorer {
+	return func(c *domain.AbuseCheck) (int, string) {
+		isNew := c.AccountAge < limits.NewAccountAge
+		var limit int
+		var count int64
+		var window string
+		switch c.Action {
+		case domain.AbuseActionMeetupCreate:
+			limit, count, window = pick(isNew, limits.MeetupsPerDayNew, limits.MeetupsPerDay), c.DayCount, "day"
+		case domain.AbuseActionInvitationCreate:
+			limit, count, window = pick(isNew, limits.InvitationsPerHourNew, limits.InvitationsPerHour), c.HourCount, "hour"
+		case domain.AbuseActionMeetupJoin:
+			limit, count, window = pick(isNew, limits.JoinsPerHourNew, limits.JoinsPerHour), c.HourCount, "hour"
+		default:
+			return 0, ""
+		}
+		if limit <= 0 || count < int64(limit) {
+			return 0, ""
+		}
+		account := "account"
+		if isNew {
+			account = "new account"
+		}
+		return limits.BlockScore, fmt.Sprintf("%s quota of %d per %s reached (%s)", c.Action, limit, window, account)
+	}
+}
+
+// BurstScorer flags more than BurstLimit actions of a kind within a minute.
+func BurstScorer(limits domain.AbuseLimits) domain.AbuseScorer {
+	return func(c *domain.AbuseCheck) (int, string) {
+		if limits.BurstLimit <= 0 || c.MinuteCount < int64(limits.BurstLimit) {
+			return 0, ""
+		}
+		return limits.FlagScore, fmt.Sprintf("%d %s within a minute", c.MinuteCount+1, c.Action)
+	}
+}
+
+func pick(isNew bool, newLimit int, limit int) int {
+	if isNew {
+		return newLimit
+	}
+	return limit
+}
@@ -0,0 +1,117 @@
+package abuse
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/UpMeetApp/server/pkg/domain/mock"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var limits = domain.AbuseLimits{
+	NewAccountAge:         72 * time.Hour,
+	MeetupsPerDay:         10,
+	MeetupsPerDayNew:      3,
+	InvitationsPerHour:    50,
+	InvitationsPerHourNew: 10,
+	JoinsPerHour:          30,
+	JoinsPerHourNew:       10,
+	BurstLimit:            5,
+	FlagScore:             50,
+	BlockScore:            100,
+}
+
+func expectCounts(repo *mock.MockUserActionRepository, uid string, action string, minute int64, hour int64, day int64) {
+	gomock.InOrder(
+		repo.EXPECT().CountUserActions(gomock.Eq(uid), gomock.Eq(action), gomock.Any()).Return(minute, nil),
+		repo.EXPECT().CountUserActions(gomock.Eq(uid), gomock.Eq(action), gomock.Any()).Return(hour, nil),
+		repo.EXPECT().CountUserActions(gomock.Eq(uid), gomock.Eq(action), gomock.Any()).Return(day, nil),
+	)
+}
+
+func Test_abuseService_Check(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	actionRepo := mock.NewMockUserActionRepository(ctrl)
+	signalRepo := mock.NewMockAbuseSignalRepository(ctrl)
+	userRepo := mock.NewMockUserRepository(ctrl)
+	s := NewAbuseService(actionRepo, signalRepo, userRepo, limits)
+
+	uid := "1"
+	oldUser := &domain.User{ID: uid, CreatedAt: time.Now().Add(-30 * 24 * time.Hour)}
+	newUser := &domain.User{ID: uid, CreatedAt: time.Now().Add(-time.Hour)}
+
+	// Allowed actions are recorded
+	userRepo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(oldUser, nil)
+	expectCounts(actionRepo, uid, domain.AbuseActionMeetupCreate, 0, 1, 5)
+	actionRepo.EXPECT().CreateUserAction(gomock.Any()).DoAndReturn(func(a *domain.UserAction) error {
+		assert.Equal(t, uid, a.UserID)
+		assert.Equal(t, domain.AbuseActionMeetupCreate, a.Action)
+		return nil
+	})
+	err := s.Check(uid, domain.AbuseActionMeetupCreate)
+	assert.NoError(t, err)
+
+	// New accounts have a lower meetup quota
+	userRepo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(newUser, nil)
+	expectCounts(actionRepo, uid, domain.AbuseActionMeetupCreate, 0, 3, 3)
+	signalRepo.EXPECT().CreateAbuseSignal(gomock.Any()).DoAndReturn(func(signal *domain.AbuseSignal) error {
+		assert.Equal(t, domain.AbuseDecisionBlock, signal.Decision)
+		assert.Equal(t, limits.BlockScore, signal.Score)
+		assert.Contains(t, signal.Reasons, "new account")
+		return nil
+	})
+	err = s.Check(uid, domain.AbuseActionMeetupCreate)
+	assert.ErrorIs(t, err, domain.ErrActionLimitExceeded)
+
+	// Invitation quota per hour
+	userRepo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(oldUser, nil)
+	expectCounts(actionRepo, uid, domain.AbuseActionInvitationCreate, 0, 50, 50)
+	signalRepo.EXPECT().CreateAbuseSignal(gomock.Any()).Return(nil)
+	err = s.Check(uid, domain.AbuseActionInvitationCreate)
+	assert.ErrorIs(t, err, domain.ErrActionLimitExceeded)
+
+	// Bursts are flagged but allowed
+	userRepo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(oldUser, nil)
+	expectCounts(actionRepo, uid, domain.AbuseActionMeetupJoin, 5, 5, 5)
+	signalRepo.EXPECT().CreateAbuseSignal(gomock.Any()).DoAndReturn(func(signal *domain.AbuseSignal) error {
+		assert.Equal(t, domain.AbuseDecisionFlag, signal.Decision)
+		return nil
+	})
+	actionRepo.EXPECT().CreateUserAction(gomock.Any()).Return(nil)
+	err = s.Check(uid, domain.AbuseActionMeetupJoin)
+	assert.NoError(t, err)
+
+	// Registered scorers add to the score and a panicking scorer is skipped
+	s.RegisterScorer(func(c *domain.AbuseCheck) (int, string) {
+		panic("broken scorer")
+	})
+	s.RegisterScorer(func(c *domain.AbuseCheck) (int, string) {
+		return 60, "custom"
+	})
+	userRepo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(oldUser, nil)
+	expectCounts(actionRepo, uid, domain.AbuseActionMeetupJoin, 5, 5, 5)
+	signalRepo.EXPECT().CreateAbuseSignal(gomock.Any()).DoAndReturn(func(signal *domain.AbuseSignal) error {
+		assert.Equal(t, domain.AbuseDecisionBlock, signal.Decision)
+		assert.Equal(t, 110, signal.Score)
+		assert.Equal(t, "6 meetup.join within a minute; custom", signal.Reasons)
+		return nil
+	})
+	err = s.Check(uid, domain.AbuseActionMeetupJoin)
+	assert.ErrorIs(t, err, domain.ErrActionLimitExceeded)
+}
+
+func Test_abuseService_GetAbuseSignals(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	signalRepo := mock.NewMockAbuseSignalRepository(ctrl)
+	s := NewAbuseService(mock.NewMockUserActionRepository(ctrl), signalRepo, mock.NewMockUserRepository(ctrl), limits)
+
+	// Limit defaults
+	signalRepo.EXPECT().GetAbuseSignals(gomock.Any()).DoAndReturn(func(filter *domain.AbuseSignalFilter) ([]*domain.AbuseSignal, error) {
+		assert.Equal(t, domain.AbuseSignalsDefaultLimit, filter.Limit)
+		return []*domain.AbuseSignal{{ID: "s1"}}, nil
+	})
+	signals, err := s.GetAbuseSignals(&domain.AbuseSignalFilter{Limit: 1000})
+	assert.NoError(t, err)
+	assert.Len(t, signals, 1)
+}
@@ -0,0 +1,51 @@
+package abuse
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type abuseSignalRepository struct {
+	db *gorm.DB
+}
+
+// NewAbuseSignalRepository creates a new abuse signal repository instance.
+func NewAbuseSignalRepository(db *gorm.DB) domain.AbuseSignalRepository {
+	return &abuseSignalRepository{
+		db: db,
+	}
+}
+
+func (r *abuseSignalRepository) CreateAbuseSignal(s *domain.AbuseSignal) error {
+	err := r.db.Create(s).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to create abuse signal", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *abuseSignalRepository) GetAbuseSignals(filter *domain.AbuseSignalFilter) ([]*domain.AbuseSignal, error) {
+	var signals []*domain.AbuseSignal
+	q := r.db
+	if len(filter.UserID) > 0 {
+		q = q.Where("user_id = ?", filter.UserID)
+	}
+	if len(filter.Decision) > 0 {
+		q = q.Where("decision = ?", filter.Decision)
+	}
+	if len(filter.Before) > 0 {
+		q = q.Where("created_at < (SELECT created_at FROM abuse_signals WHERE id = ?)", filter.Before)
+	}
+	err := q.Order("created_at DESC").Limit(filter.Limit).Find(&signals).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get abuse signals", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return signals, nil
+}
@@ -0,0 +1,75 @@
+package attendance
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type attendanceRepository struct {
+	db *gorm.DB
+}
+
+// NewAttendanceRepository creates a new attendance repository instance.
+func NewAttendanceRepository(db *gorm.DB) domain.AttendanceRepository {
+	return &attendanceRepository{
+		db: db,
+	}
+}
+
+func (r *attendanceRepository) SaveAttendance(a *domain.Attendance) error {
+	err := r.db.Save(a).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to save attendance", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *attendanceRepository) GetAttendance(meetupID string, userID string) (*domain.Attendance, error) {
+	a := &domain.Attendance{}
+	err := r.db.Where("meetup_id = ? AND user_id = ?", meetupID, userID).First(a).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fiber.ErrNotFound
+		}
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get attendance", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return a, nil
+}
+
+func (r *attendanceRepository) GetAttendanceByMeetupID(meetupID string) ([]*domain.Attendance, error) {
+	var attendance []*domain.Attendance
+	err := r.db.Where("meetup_id = ?", meetupID).Find(&attendance).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get attendance by meetup id", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return attendance, nil
+}
+
+func (r *attendanceRepository) GetAttendanceStatsByUserID(userID string) (*domain.AttendanceStats, error) {
+	stats := &domain.AttendanceStats{}
+	// Joined meetups are the ones the user was marked for and the ones they are a participant of with attendance taken.
+	err := r.db.Raw(`SELECT COUNT(*) AS joined, COUNT(*) FILTER (WHERE a.attended) AS attended
+		FROM meetups m LEFT JOIN attendances a ON a.meetup_id = m.id AND a.user_id = ?
+		WHERE m.owner_id <> ? AND (a.user_id IS NOT NULL OR (
+			EXISTS (SELECT 1 FROM participants p WHERE p.meetup_id = m.id AND p.user_id = ?) AND
+			EXISTS (SELECT 1 FROM attendances t WHERE t.meetup_id = m.id)))`, userID, userID, userID).
+		Scan(stats).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get attendance stats by user id", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	if stats.Joined > 0 {
+		stats.Reliability = float64(stats.Attended) / float64(stats.Joined)
+	}
+	return stats, nil
+}
@@ -0,0 +1,78 @@
+package attendance
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"time"
+)
+
+type attendanceService struct {
+	attendanceRepository domain.AttendanceRepository
+	meetupRepository     domain.MeetupRepository
+}
+
+// NewAttendanceService creates a new attendance service instance.
+func NewAttendanceService(attendanceRepository domain.AttendanceRepository, meetupRepository domain.MeetupRepository) domain.AttendanceService {
+	return &attendanceService{
+		attendanceRepository: attendanceRepository,
+		meetupRepository:     meetupRepository,
+	}
+}
+
+func (s *attendanceService) GetMeetupAttendance(uid string, meetupID string) ([]*domain.Attendance, error) {
+	m, err := s.meetupRepository.GetMeetupByID(meetupID)
+	if err != nil {
+		return nil, err
+	}
+	if m.OwnerID != uid {
+		return nil, domain.ErrNotMeetupOwner
+	}
+	return s.attendanceRepository.GetAttendanceByMeetupID(meetupID)
+}
+
+func (s *attendanceService) MarkAttendance(uid string, meetupID string, dto *domain.MarkAttendanceDTO) ([]*domain.Attendance, error) {
+	m, err := s.meetupRepository.GetMeetupByID(meetupID)
+	if err != nil {
+		return nil, err
+	}
+	if m.OwnerID != uid {
+		return nil, domain.ErrNotMeetupOwner
+	}
+	// Nobody can have missed a meetup before it started.
+	if time.Now().Before(m.StartsAt) {
+		return nil, domain.ErrMeetupNotStarted
+	}
+
+	// The host is always present at their own meetup, so they can't be marked.
+	for userID := range dto.Attendance {
+		if userID == m.OwnerID {
+			return nil, domain.ErrNotParticipant
+		}
+		ok, err := s.meetupRepository.IsParticipant(meetupID, userID)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, domain.ErrNotParticipant
+		}
+	}
+
+	attendance := make([]*domain.Attendance, 0, len(dto.Attendance))
+	for userID, attended := range dto.Attendance {
+		a := &domain.Attendance{
+			MeetupID:  meetupID,
+			UserID:    userID,
+			Attended:  attended,
+			UpdatedAt: time.Now(),
+		}
+		err = s.attendanceRepository.SaveAttendance(a)
+		if err != nil {
+			return nil, err
+		}
+		attendance = append(attendance, a)
+	}
+	return attendance, nil
+}
+
+func (s *attendanceService) GetAttendanceStats(userID string) (*domain.AttendanceStats, error) {
+	return s.attendanceRepository.GetAttendanceStatsByUserID(userID)
+}
@@ -0,0 +1,90 @@
+package attendance
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/UpMeetApp/server/pkg/domain/mock"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func Test_attendanceService_GetMeetupAttendance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockAttendanceRepository(ctrl)
+	meetupRepo := mock.NewMockMeetupRepository(ctrl)
+	s := NewAttendanceService(repo, meetupRepo)
+
+	uid := "1"
+	id := "m1"
+
+	// Meetup not found
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(nil, fiber.ErrNotFound)
+	a, err := s.GetMeetupAttendance(uid, id)
+	assert.ErrorIs(t, err, fiber.ErrNotFound)
+	assert.Nil(t, a)
+
+	// Not the owner
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: "2"}, nil)
+	a, err = s.GetMeetupAttendance(uid, id)
+	assert.ErrorIs(t, err, domain.ErrNotMeetupOwner)
+	assert.Nil(t, a)
+
+	// GetMeetupAttendance successful
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: uid}, nil)
+	repo.EXPECT().GetAttendanceByMeetupID(gomock.Eq(id)).Return([]*domain.Attendance{{MeetupID: id, UserID: "2"}}, nil)
+	a, err = s.GetMeetupAttendance(uid, id)
+	assert.NoError(t, err)
+	assert.Len(t, a, 1)
+}
+
+func Test_attendanceService_MarkAttendance(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockAttendanceRepository(ctrl)
+	meetupRepo := mock.NewMockMeetupRepository(ctrl)
+	s := NewAttendanceService(repo, meetupRepo)
+
+	uid := "1"
+	id := "m1"
+
+	// Not the owner
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: "2"}, nil)
+	a, err := s.MarkAttendance(uid, id, &domain.MarkAttendanceDTO{})
+	assert.ErrorIs(t, err, domain.ErrNotMeetupOwner)
+	assert.Nil(t, a)
+
+	// Meetup not started yet
+	dto := &domain.MarkAttendanceDTO{Attendance: map[string]bool{"2": false}}
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: uid, StartsAt: time.Now().Add(time.Hour)}, nil)
+	a, err = s.MarkAttendance(uid, id, dto)
+	assert.ErrorIs(t, err, domain.ErrMeetupNotStarted)
+	assert.Nil(t, a)
+
+	// Owner can't be marked
+	dto = &domain.MarkAttendanceDTO{Attendance: map[string]bool{uid: true}}
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: uid}, nil)
+	a, err = s.MarkAttendance(uid, id, dto)
+	assert.ErrorIs(t, err, domain.ErrNotParticipant)
+	assert.Nil(t, a)
+
+	// Not a participant
+	dto = &domain.MarkAttendanceDTO{Attendance: map[string]bool{"2": true}}
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: uid}, nil)
+	meetupRepo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq("2")).Return(false, nil)
+	a, err = s.MarkAttendance(uid, id, dto)
+	assert.ErrorIs(t, err, domain.ErrNotParticipant)
+	assert.Nil(t, a)
+
+	// MarkAttendance successful
+	dto = &domain.MarkAttendanceDTO{Attendance: map[string]bool{"2": true, "3": false}}
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: uid}, nil)
+	meetupRepo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Any()).Return(true, nil).Times(2)
+	repo.EXPECT().SaveAttendance(gomock.Any()).Return(nil).Times(2)
+	a, err = s.MarkAttendance(uid, id, dto)
+	assert.NoError(t, err)
+	assert.Len(t, a, 2)
+	for _, e := range a {
+		assert.Equal(t, dto.Attendance[e.UserID], e.Attended)
+	}
+}
@@ -0,0 +1,180 @@
+package avatar
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"golang.org/x/image/draw"
+	_ "golang.org/x/image/webp"
+	"image"
+	"image/jpeg"
+	_ "image/png"
+	"net/http"
+)
+
+// avatarQuality is the JPEG quality of the stored avatar and its thumbnails.
+const avatarQuality = 85
+
+// exifOrientationTag is the tag of the orientation in the EXIF data of JPEG images.
+const exifOrientationTag = 0x0112
+
+// decodeAvatar validates and decodes the avatar image, it returns the image and the orientation of its EXIF data.
+func decodeAvatar(data []byte) (image.Image, int, error) {
+	if len(data) == 0 {
+		return nil, 0, domain.ErrInvalidAvatar
+	}
+	if len(data) > domain.AvatarMaxSize {
+		return nil, 0, domain.ErrAvatarTooLarge
+	}
+	contentType := http.DetectContentType(data)
+	if !isAvatarType(contentType) {
+		return nil, 0, domain.ErrInvalidAvatar
+	}
+	// The dimensions are checked before decoding, so small files with huge dimensions can't exhaust the memory.
+	cfg, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, 0, domain.ErrInvalidAvatar
+	}
+	if cfg.Width < domain.AvatarMinDimension || cfg.Height < domain.AvatarMinDimension || cfg.Width > domain.AvatarMaxDimension || cfg.Height > domain.AvatarMaxDimension {
+		return nil, 0, domain.ErrInvalidAvatarDimensions
+	}
+	if cfg.Width*cfg.Height > domain.AvatarMaxPixels {
+		return nil, 0, domain.ErrInvalidAvatarDimensions
+	}
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, 0, domain.ErrInvalidAvatar
+	}
+	orientation := 1
+	if contentType == "image/jpeg" {
+		orientation = exifOrientation(data)
+	}
+	return img, orientation, nil
+}
+
+// encodeAvatar crops the centered square of the image, scales it down to the size and encodes it as JPEG.
+// Transparent areas become white, images smaller than the size aren't scaled up.
+// Encoding drops the EXIF data, so its orientation is applied. The crop is centered, so it is applied to the scaled down square.
+func encodeAvatar(img image.Image, orientation int, size int) ([]byte, error) {
+	b := img.Bounds()
+	side := b.Dx()
+	if b.Dy() < side {
+		side = b.Dy()
+	}
+	crop := image.Rect(0, 0, side, side).Add(b.Min).Add(image.Pt((b.Dx()-side)/2, (b.Dy()-side)/2))
+	if side < size {
+		size = side
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, size, size))
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, crop, draw.Over, nil)
+
+	var buf bytes.Buffer
+	err := jpeg.Encode(&buf, orient(dst, orientation), &jpeg.Options{Quality: avatarQuality})
+	if err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// isAvatarType returns whether the content type is an accepted avatar image type.
+func isAvatarType(contentType string) bool {
+	for _, t := range domain.AvatarTypes {
+		if t == contentType {
+			return true
+		}
+	}
+	return false
+}
+
+// exifOrientation returns the orientation stored in the EXIF data of the JPEG image, 1 (unchanged) if there is none.
+func exifOrientation(data []byte) int {
+	// The EXIF data is stored in an APP1 segment before the image data starts (SOS).
+	for i := 2; i+4 <= len(data) && data[i] == 0xFF; {
+		marker := data[i+1]
+		length := int(binary.BigEndian.Uint16(data[i+2:]))
+		if marker == 0xDA || length < 2 || i+2+length > len(data) {
+			break
+		}
+		segment := data[i+4 : i+2+length]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			return tiffOrientation(segment[6:])
+		}
+		i += 2 + length
+	}
+	return 1
+}
+
+// tiffOrientation returns the orientation tag of the first image file directory of the TIFF structure in EXIF data.
+func tiffOrientation(tiff []byte) int {
+	if len(tiff) < 8 {
+		return 1
+	}
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return 1
+	}
+	offset := int(order.Uint32(tiff[4:]))
+	if offset < 8 || offset+2 > len(tiff) {
+		return 1
+	}
+	count := int(order.Uint16(tiff[offset:]))
+	for i := 0; i < count; i++ {
+		entry := offset + 2 + i*12
+		if entry+12 > len(tiff) {
+			break
+		}
+		if order.Uint16(tiff[entry:]) == exifOrientationTag {
+			o := int(order.Uint16(tiff[entry+8:]))
+			if o < 1 || o > 8 {
+				return 1
+			}
+			return o
+		}
+	}
+	return 1
+}
+
+// orient transforms the image, so it is shown upright without its EXIF orientation.
+func orient(img image.Image, orientation int) image.Image {
+	if orientation <= 1 || orientation > 8 {
+		return img
+	}
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	dw, dh := w, h
+	// Orientations 5 to 8 swap width and height.
+	if orientation >= 5 {
+		dw, dh = h, w
+	}
+	dst := image.NewRGBA(image.Rect(0, 0, dw, dh))
+	for y := 0; y < dh; y++ {
+		for x := 0; x < dw; x++ {
+			var sx, sy int
+			switch orientation {
+			case 2: // mirrored horizontally
+				sx, sy = w-1-x, y
+			case 3: // rotated by 180°
+				sx, sy = w-1-x, h-1-y
+			case 4: // mirrored vertically
+				sx, sy = x, h-1-y
+			case 5: // transposed
+				sx, sy = y, x
+			case 6: // rotated by 90° clockwise
+				sx, sy = y, h-1-x
+			case 7: // transversed
+				sx, sy = w-1-y, h-1-x
+			case 8: // rotated by 90° counterclockwise
+				sx, sy = w-1-y, x
+			}
+			dst.Set(x, y, img.At(b.Min.X+sx, b.Min.Y+sy))
+		}
+	}
+	return dst
+}
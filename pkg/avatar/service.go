@@ -0,0 +1,99 @@
+package avatar
+
+import (
+	"fmt"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"strconv"
+)
+
+type avatarService struct {
+	userRepository domain.UserRepository
+	fileStorage    domain.FileStorage
+}
+
+// NewAvatarService creates a new avatar service instance.
+func NewAvatarService(userRepository domain.UserRepository, fileStorage domain.FileStorage) domain.AvatarService {
+	return &avatarService{
+		userRepository: userRepository,
+		fileStorage:    fileStorage,
+	}
+}
+
+// UpdateAvatar stores the image in its full size and as thumbnails and replaces the avatar of the user with it.
+// Every avatar gets a new ID, so the URLs of an avatar never change and can be cached forever.
+func (s *avatarService) UpdateAvatar(uid string, data []byte) (*domain.Avatar, error) {
+	img, orientation, err := decodeAvatar(data)
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.userRepository.GetUserByID(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	id := uuid.NewString()
+	a := &domain.Avatar{
+		Thumbnails: make(map[string]string, len(domain.AvatarThumbnailSizes)),
+	}
+	for _, size := range avatarSizes() {
+		b, err := encodeAvatar(img, orientation, size)
+		if err != nil {
+			sentry.CaptureException(err)
+			zap.L().Error("failed to encode avatar", zap.Error(err))
+			_ = s.DeleteAvatarFiles(uid, id)
+			return nil, fiber.ErrInternalServerError
+		}
+		key := avatarKey(uid, id, size)
+		err = s.fileStorage.Put(key, domain.AvatarContentType, b)
+		if err != nil {
+			_ = s.DeleteAvatarFiles(uid, id)
+			return nil, err
+		}
+		if size == domain.AvatarSize {
+			a.URL = s.fileStorage.URL(key)
+		} else {
+			a.Thumbnails[strconv.Itoa(size)] = s.fileStorage.URL(key)
+		}
+	}
+
+	oldID := u.AvatarID
+	u.AvatarID = id
+	u.ProfilePicture = a.URL
+	err = s.userRepository.UpdateUser(u)
+	if err != nil {
+		_ = s.DeleteAvatarFiles(uid, id)
+		return nil, err
+	}
+	// The new avatar is already in place, so files of the old one that couldn't be deleted are only logged.
+	_ = s.DeleteAvatarFiles(uid, oldID)
+	return a, nil
+}
+
+// DeleteAvatarFiles deletes the stored files of the avatar, the user has to be updated by the caller.
+// All files are tried even if one of them fails.
+func (s *avatarService) DeleteAvatarFiles(uid string, avatarID string) error {
+	if len(avatarID) == 0 {
+		return nil
+	}
+	var err error
+	for _, size := range avatarSizes() {
+		if dErr := s.fileStorage.Delete(avatarKey(uid, avatarID, size)); dErr != nil {
+			err = dErr
+		}
+	}
+	return err
+}
+
+// avatarSizes returns the sizes of all files stored for an avatar, the full size comes first.
+func avatarSizes() []int {
+	return append([]int{domain.AvatarSize}, domain.AvatarThumbnailSizes...)
+}
+
+// avatarKey returns the file storage key of the avatar in the given size.
+func avatarKey(uid string, avatarID string, size int) string {
+	return fmt.Sprintf("avatars/%s/%s/%d.jpg", uid, avatarID, size)
+}
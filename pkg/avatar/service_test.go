@@ -0,0 +1,158 @@
+package avatar
+
+import (
+	"bytes"
+	"encoding/binary"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/UpMeetApp/server/pkg/domain/mock"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+// testImage returns an image that is red in the top and blue in the bottom half.
+func testImage(w int, h int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, w, h))
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := color.RGBA{R: 255, A: 255}
+			if y >= h/2 {
+				c = color.RGBA{B: 255, A: 255}
+			}
+			img.Set(x, y, c)
+		}
+	}
+	return img
+}
+
+func encodePNG(t *testing.T, img image.Image) []byte {
+	var buf bytes.Buffer
+	assert.NoError(t, png.Encode(&buf, img))
+	return buf.Bytes()
+}
+
+// pngHeader returns the signature and header chunk of a PNG image with the given dimensions, without any image data.
+func pngHeader(w uint32, h uint32) []byte {
+	ihdr := make([]byte, 17)
+	copy(ihdr, "IHDR")
+	binary.BigEndian.PutUint32(ihdr[4:], w)
+	binary.BigEndian.PutUint32(ihdr[8:], h)
+	copy(ihdr[12:], []byte{8, 6, 0, 0, 0})
+	b := make([]byte, 8+4+len(ihdr)+4)
+	copy(b, "\x89PNG\r\n\x1a\n")
+	binary.BigEndian.PutUint32(b[8:], uint32(len(ihdr)-4))
+	copy(b[12:], ihdr)
+	binary.BigEndian.PutUint32(b[12+len(ihdr):], crc32.ChecksumIEEE(ihdr))
+	return b
+}
+
+// encodeJPEG encodes the image as JPEG with the orientation in its EXIF data.
+func encodeJPEG(t *testing.T, img image.Image, orientation byte) []byte {
+	var buf bytes.Buffer
+	assert.NoError(t, jpeg.Encode(&buf, img, nil))
+	exif := []byte("Exif\x00\x00MM\x00\x2a\x00\x00\x00\x08\x00\x01\x01\x12\x00\x03\x00\x00\x00\x01\x00")
+	exif = append(exif, orientation, 0, 0, 0, 0, 0, 0)
+	app1 := append([]byte{0xFF, 0xE1, 0, byte(len(exif) + 2)}, exif...)
+	return append(append(buf.Bytes()[:2:2], app1...), buf.Bytes()[2:]...)
+}
+
+func Test_avatarService_UpdateAvatar(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	userRepo := mock.NewMockUserRepository(ctrl)
+	fileStorage := mock.NewMockFileStorage(ctrl)
+	s := NewAvatarService(userRepo, fileStorage)
+
+	uid := "1"
+	fileStorage.EXPECT().URL(gomock.Any()).DoAndReturn(func(key string) string {
+		return "https://files.upmeet.app/" + key
+	}).AnyTimes()
+
+	// Not an image
+	a, err := s.UpdateAvatar(uid, []byte("%PDF-1.4"))
+	assert.ErrorIs(t, err, domain.ErrInvalidAvatar)
+	assert.Nil(t, a)
+
+	// Too large
+	a, err = s.UpdateAvatar(uid, make([]byte, domain.AvatarMaxSize+1))
+	assert.ErrorIs(t, err, domain.ErrAvatarTooLarge)
+	assert.Nil(t, a)
+
+	// Too small
+	a, err = s.UpdateAvatar(uid, encodePNG(t, testImage(32, 32)))
+	assert.ErrorIs(t, err, domain.ErrInvalidAvatarDimensions)
+	assert.Nil(t, a)
+
+	// Too many pixels, only the header is needed since the image is rejected before decoding
+	a, err = s.UpdateAvatar(uid, pngHeader(6000, 6000))
+	assert.ErrorIs(t, err, domain.ErrInvalidAvatarDimensions)
+	assert.Nil(t, a)
+
+	// Files stored before a failed upload are deleted
+	userRepo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{ID: uid}, nil)
+	gomock.InOrder(
+		fileStorage.EXPECT().Put(gomock.Any(), gomock.Eq(domain.AvatarContentType), gomock.Any()).Return(nil),
+		fileStorage.EXPECT().Put(gomock.Any(), gomock.Eq(domain.AvatarContentType), gomock.Any()).Return(fiber.ErrInternalServerError),
+	)
+	fileStorage.EXPECT().Delete(gomock.Any()).Return(nil).Times(len(domain.AvatarThumbnailSizes) + 1)
+	a, err = s.UpdateAvatar(uid, encodePNG(t, testImage(200, 100)))
+	assert.ErrorIs(t, err, fiber.ErrInternalServerError)
+	assert.Nil(t, a)
+
+	// UpdateAvatar successful, the EXIF orientation is applied and the old avatar is deleted
+	stored := map[string][]byte{}
+	userRepo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{ID: uid, AvatarID: "old"}, nil)
+	fileStorage.EXPECT().Put(gomock.Any(), gomock.Eq(domain.AvatarContentType), gomock.Any()).DoAndReturn(func(key string, contentType string, data []byte) error {
+		stored[key] = data
+		return nil
+	}).Times(len(domain.AvatarThumbnailSizes) + 1)
+	userRepo.EXPECT().UpdateUser(gomock.Any()).DoAndReturn(func(u *domain.User) error {
+		assert.NotEqual(t, "old", u.AvatarID)
+		assert.True(t, strings.HasPrefix(u.ProfilePicture, "https://files.upmeet.app/avatars/1/"+u.AvatarID+"/"))
+		return nil
+	})
+	fileStorage.EXPECT().Delete(gomock.Eq("avatars/1/old/1024.jpg")).Return(nil)
+	fileStorage.EXPECT().Delete(gomock.Eq("avatars/1/old/256.jpg")).Return(nil)
+	fileStorage.EXPECT().Delete(gomock.Eq("avatars/1/old/64.jpg")).Return(nil)
+	a, err = s.UpdateAvatar(uid, encodeJPEG(t, testImage(200, 100), 6))
+	assert.NoError(t, err)
+	assert.Len(t, a.Thumbnails, len(domain.AvatarThumbnailSizes))
+	key := strings.TrimPrefix(a.Thumbnails["64"], "https://files.upmeet.app/")
+	img, err := jpeg.Decode(bytes.NewReader(stored[key]))
+	assert.NoError(t, err)
+	assert.Equal(t, image.Rect(0, 0, 64, 64), img.Bounds())
+	// Rotated clockwise the top half is on the right.
+	r, _, b, _ := img.At(8, 16).RGBA()
+	assert.Greater(t, b, r)
+	r, _, b, _ = img.At(56, 16).RGBA()
+	assert.Greater(t, r, b)
+	// Smaller images aren't scaled up.
+	key = strings.TrimPrefix(a.URL, "https://files.upmeet.app/")
+	cfg, err := jpeg.DecodeConfig(bytes.NewReader(stored[key]))
+	assert.NoError(t, err)
+	assert.Equal(t, 100, cfg.Width)
+	assert.Equal(t, 100, cfg.Height)
+}
+
+func Test_avatarService_DeleteAvatarFiles(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	fileStorage := mock.NewMockFileStorage(ctrl)
+	s := NewAvatarService(mock.NewMockUserRepository(ctrl), fileStorage)
+
+	// No avatar
+	err := s.DeleteAvatarFiles("1", "")
+	assert.NoError(t, err)
+
+	// All files are tried even if one fails
+	fileStorage.EXPECT().Delete(gomock.Eq("avatars/1/a1/1024.jpg")).Return(fiber.ErrInternalServerError)
+	fileStorage.EXPECT().Delete(gomock.Eq("avatars/1/a1/256.jpg")).Return(nil)
+	fileStorage.EXPECT().Delete(gomock.Eq("avatars/1/a1/64.jpg")).Return(nil)
+	err = s.DeleteAvatarFiles("1", "a1")
+	assert.ErrorIs(t, err, fiber.ErrInternalServerError)
+}
@@ -0,0 +1,82 @@
+package block
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type blockRepository struct {
+	db *gorm.DB
+}
+
+// NewBlockRepository creates a new block repository instance.
+func NewBlockRepository(db *gorm.DB) domain.BlockRepository {
+	return &blockRepository{
+		db: db,
+	}
+}
+
+func (r *blockRepository) CreateBlock(b *domain.Block) error {
+	err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(b).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to create block", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *blockRepository) DeleteBlock(userID string, blockedUserID string) error {
+	err := r.db.Where("user_id = ? AND blocked_user_id = ?", userID, blockedUserID).Delete(&domain.Block{}).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to delete block", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *blockRepository) GetBlockedUsers(userID string) ([]*domain.BlockedUser, error) {
+	var users []*domain.BlockedUser
+	err := r.db.Table("blocks").
+		Select("users.id, users.username, users.name, users.profile_picture, blocks.created_at AS blocked_at").
+		Joins("JOIN users ON users.id = blocks.blocked_user_id").
+		Where("blocks.user_id = ?", userID).
+		Order("blocks.created_at DESC").
+		Scan(&users).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get blocked users", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return users, nil
+}
+
+func (r *blockRepository) IsBlocked(userID string, otherUserID string) (bool, error) {
+	var count int64
+	err := r.db.Model(&domain.Block{}).
+		Where("(user_id = ? AND blocked_user_id = ?) OR (user_id = ? AND blocked_user_id = ?)", userID, otherUserID, otherUserID, userID).
+		Count(&count).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to check block", zap.Error(err))
+		return false, fiber.ErrInternalServerError
+	}
+	return count > 0, nil
+}
+
+func (r *blockRepository) GetBlockedUserIDs(userID string) ([]string, error) {
+	var ids []string
+	err := r.db.Raw("SELECT blocked_user_id FROM blocks WHERE user_id = ? UNION SELECT user_id FROM blocks WHERE blocked_user_id = ?", userID, userID).
+		Scan(&ids).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get blocked user ids", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return ids, nil
+}
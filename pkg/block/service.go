@@ -0,0 +1,46 @@
+package block
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"time"
+)
+
+type blockService struct {
+	blockRepository domain.BlockRepository
+	userRepository  domain.UserRepository
+}
+
+// NewBlockService creates a new block service instance.
+func NewBlockService(blockRepository domain.BlockRepository, userRepository domain.UserRepository) domain.BlockService {
+	return &blockService{
+		blockRepository: blockRepository,
+		userRepository:  userRepository,
+	}
+}
+
+func (s *blockService) GetBlockedUsers(uid string) ([]*domain.BlockedUser, error) {
+	return s.blockRepository.GetBlockedUsers(uid)
+}
+
+func (s *blockService) BlockUser(uid string, username string) error {
+	u, err := s.userRepository.GetUserByUsername(username)
+	if err != nil {
+		return err
+	}
+	if u.ID == uid {
+		return domain.ErrCannotBlockSelf
+	}
+	return s.blockRepository.CreateBlock(&domain.Block{
+		UserID:        uid,
+		BlockedUserID: u.ID,
+		CreatedAt:     time.Now(),
+	})
+}
+
+func (s *blockService) UnblockUser(uid string, username string) error {
+	u, err := s.userRepository.GetUserByUsername(username)
+	if err != nil {
+		return err
+	}
+	return s.blockRepository.DeleteBlock(uid, u.ID)
+}
@@ -0,0 +1,60 @@
+package block
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/UpMeetApp/server/pkg/domain/mock"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_blockService_BlockUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockBlockRepository(ctrl)
+	userRepo := mock.NewMockUserRepository(ctrl)
+	s := NewBlockService(repo, userRepo)
+
+	uid := "1"
+
+	// Unknown user
+	userRepo.EXPECT().GetUserByUsername(gomock.Eq("test")).Return(nil, fiber.ErrNotFound)
+	err := s.BlockUser(uid, "test")
+	assert.ErrorIs(t, err, fiber.ErrNotFound)
+
+	// Blocking yourself
+	userRepo.EXPECT().GetUserByUsername(gomock.Eq("me")).Return(&domain.User{ID: uid}, nil)
+	err = s.BlockUser(uid, "me")
+	assert.ErrorIs(t, err, domain.ErrCannotBlockSelf)
+
+	// Success
+	userRepo.EXPECT().GetUserByUsername(gomock.Eq("test")).Return(&domain.User{ID: "2"}, nil)
+	repo.EXPECT().CreateBlock(gomock.Any()).DoAndReturn(func(b *domain.Block) error {
+		assert.Equal(t, uid, b.UserID)
+		assert.Equal(t, "2", b.BlockedUserID)
+		assert.False(t, b.CreatedAt.IsZero())
+		return nil
+	})
+	err = s.BlockUser(uid, "test")
+	assert.NoError(t, err)
+}
+
+func Test_blockService_UnblockUser(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockBlockRepository(ctrl)
+	userRepo := mock.NewMockUserRepository(ctrl)
+	s := NewBlockService(repo, userRepo)
+
+	uid := "1"
+
+	// Unknown user
+	userRepo.EXPECT().GetUserByUsername(gomock.Eq("test")).Return(nil, fiber.ErrNotFound)
+	err := s.UnblockUser(uid, "test")
+	assert.ErrorIs(t, err, fiber.ErrNotFound)
+
+	// Success
+	userRepo.EXPECT().GetUserByUsername(gomock.Eq("test")).Return(&domain.User{ID: "2"}, nil)
+	repo.EXPECT().DeleteBlock(gomock.Eq(uid), gomock.Eq("2")).Return(nil)
+	err = s.UnblockUser(uid, "test")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,142 @@
+package broker
+
+import (
+	"encoding/json"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func event(t *testing.T, eventType string, payload interface{}) *domain.Event {
+	b, err := json.Marshal(payload)
+	assert.NoError(t, err)
+	return &domain.Event{ID: "e1", Type: eventType, Payload: b, CreatedAt: time.Date(2022, 4, 1, 18, 0, 0, 0, time.UTC)}
+}
+
+func TestForwarder(t *testing.T) {
+	p := NewMemoryPublisher()
+	var subjects []string
+	var messages [][]byte
+	p.Subscribe(">", func(data []byte) {
+		messages = append(messages, data)
+	})
+	p.Subscribe(Subject(domain.EventTypeUserCreated), func(data []byte) {
+		subjects = append(subjects, Subject(domain.EventTypeUserCreated))
+	})
+	forward := Forwarder(p)
+
+	// User events leave out private details
+	birthdate := time.Date(2003, 4, 1, 0, 0, 0, 0, time.UTC)
+	err := forward(event(t, domain.EventTypeUserCreated, &domain.User{ID: "1", Username: "test", Name: "Test", Email: "test@upmeet.app", Birthdate: &birthdate}))
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"upmeet.user.created.v1"}, subjects)
+	assert.JSONEq(t, `{"id":"e1","type":"user.created","version":1,"occurred_at":"2022-04-01T18:00:00Z","data":{"id":"1","username":"test","name":"Test","created_at":"0001-01-01T00:00:00Z"}}`, string(messages[0]))
+
+	// Participant events are published as is
+	err = forward(event(t, domain.EventTypeParticipantAdded, &domain.ParticipantEvent{MeetupID: "m1", UserID: "1"}))
+	assert.NoError(t, err)
+	assert.Len(t, messages, 2)
+	assert.Contains(t, string(messages[1]), `"data":{"meetup_id":"m1","user_id":"1"}`)
+
+	// Other events aren't published
+	err = forward(event(t, "something.else", nil))
+	assert.NoError(t, err)
+	assert.Len(t, messages, 2)
+
+	// Invalid payload fails so the event is retried
+	err = forward(&domain.Event{Type: domain.EventTypeMeetupUpdated, Payload: json.RawMessage(`"invalid"`)})
+	assert.Error(t, err)
+}
+
+// TestSchemas checks that the published messages match the properties of their JSON schemas.
+func TestSchemas(t *testing.T) {
+	samples := map[string]interface{}{
+		domain.EventTypeUserCreated:        &domain.User{ID: "1"},
+		domain.EventTypeUserUpdated:        &domain.User{ID: "1"},
+		domain.EventTypeUserDeleted:        &domain.DeletedEvent{ID: "1"},
+		domain.EventTypeMeetupCreated:      &domain.Meetup{ID: "m1", MeetupLocation: domain.MeetupLocation{Country: "DE", City: "Berlin"}},
+		domain.EventTypeMeetupUpdated:      &domain.Meetup{ID: "m1", MeetupLocation: domain.MeetupLocation{Country: "DE", City: "Berlin"}},
+		domain.EventTypeMeetupDeleted:      &domain.DeletedEvent{ID: "m1"},
+		domain.EventTypeParticipantAdded:   &domain.ParticipantEvent{MeetupID: "m1", UserID: "1"},
+		domain.EventTypeParticipantRemoved: &domain.ParticipantEvent{MeetupID: "m1", UserID: "1"},
+	}
+
+	type schema struct {
+		Required   []string                   `json:"required"`
+		Properties map[string]json.RawMessage `json:"properties"`
+	}
+	checkObject := func(name string, s *schema, object map[string]json.RawMessage) {
+		for _, key := range s.Required {
+			assert.Contains(t, object, key, name)
+		}
+		for key := range object {
+			assert.Contains(t, s.Properties, key, name)
+		}
+	}
+
+	for eventType, payload := range samples {
+		name := eventType + ".v1.json"
+		b, err := Schemas.ReadFile("schemas/" + name)
+		if !assert.NoError(t, err, name) {
+			continue
+		}
+		envelopeSchema := &schema{}
+		assert.NoError(t, json.Unmarshal(b, envelopeSchema), name)
+		dataSchema := &schema{}
+		assert.NoError(t, json.Unmarshal(envelopeSchema.Properties["data"], dataSchema), name)
+
+		p := NewMemoryPublisher()
+		var message []byte
+		p.Subscribe(Subject(eventType), func(data []byte) {
+			message = data
+		})
+		assert.NoError(t, Forwarder(p)(event(t, eventType, payload)), name)
+
+		envelope := map[string]json.RawMessage{}
+		assert.NoError(t, json.Unmarshal(message, &envelope), name)
+		checkObject(name, envelopeSchema, envelope)
+		data := map[string]json.RawMessage{}
+		assert.NoError(t, json.Unmarshal(envelope["data"], &data), name)
+		checkObject(name, dataSchema, data)
+	}
+}
+
+func Test_natsPublisher(t *testing.T) {
+	ns, err := server.NewServer(&server.Options{Host: "127.0.0.1", Port: server.RANDOM_PORT, NoLog: true, NoSigs: true})
+	if !assert.NoError(t, err) {
+		return
+	}
+	go ns.Start()
+	defer ns.Shutdown()
+	if !ns.ReadyForConnections(5 * time.Second) {
+		t.Fatal("nats server not ready")
+	}
+
+	conn, err := nats.Connect(ns.ClientURL())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer conn.Close()
+	sub, err := conn.SubscribeSync("upmeet.>")
+	assert.NoError(t, err)
+	assert.NoError(t, conn.Flush())
+
+	p, err := NewNATSPublisher(ns.ClientURL())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer p.Close()
+
+	err = Forwarder(p)(event(t, domain.EventTypeMeetupDeleted, &domain.DeletedEvent{ID: "m1"}))
+	assert.NoError(t, err)
+
+	msg, err := sub.NextMsg(5 * time.Second)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, "upmeet.meetup.deleted.v1", msg.Subject)
+	assert.JSONEq(t, `{"id":"e1","type":"meetup.deleted","version":1,"occurred_at":"2022-04-01T18:00:00Z","data":{"id":"m1"}}`, string(msg.Data))
+}
@@ -0,0 +1,73 @@
+package broker
+
+import (
+	"encoding/json"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"go.uber.org/zap"
+)
+
+// Forwarder returns an outbox subscriber that publishes user and meetup events with the publisher.
+// Subscribed to the outbox relay, every event is published at least once, consumers deduplicate by the envelope id.
+// The relay doesn't track which subscribers handled an event, so when another subscriber like the webhook dispatcher fails,
+// the event is published again on every retry even though publishing it succeeded before.
+func Forwarder(publisher domain.EventPublisher) domain.EventHandler {
+	return func(e *domain.Event) error {
+		data, err := messageData(e)
+		if err != nil {
+			sentry.CaptureException(err)
+			zap.L().Error("failed to convert event to message", zap.String("id", e.ID), zap.String("type", e.Type), zap.Error(err))
+			return err
+		}
+		if data == nil {
+			return nil
+		}
+
+		b, err := json.Marshal(&Envelope{
+			ID:         e.ID,
+			Type:       e.Type,
+			Version:    SchemaVersion,
+			OccurredAt: e.CreatedAt,
+			Data:       data,
+		})
+		if err != nil {
+			return err
+		}
+		return publisher.Publish(Subject(e.Type), b)
+	}
+}
+
+// messageData converts the payload of an event to the versioned message data, or nil for events that aren't published.
+func messageData(e *domain.Event) (interface{}, error) {
+	switch e.Type {
+	case domain.EventTypeUserCreated, domain.EventTypeUserUpdated:
+		u := &domain.User{}
+		err := json.Unmarshal(e.Payload, u)
+		if err != nil {
+			return nil, err
+		}
+		return newUserV1(u), nil
+	case domain.EventTypeMeetupCreated, domain.EventTypeMeetupUpdated:
+		m := &domain.Meetup{}
+		err := json.Unmarshal(e.Payload, m)
+		if err != nil {
+			return nil, err
+		}
+		return newMeetupV1(m), nil
+	case domain.EventTypeParticipantAdded, domain.EventTypeParticipantRemoved:
+		p := &ParticipantV1{}
+		err := json.Unmarshal(e.Payload, p)
+		if err != nil {
+			return nil, err
+		}
+		return p, nil
+	case domain.EventTypeUserDeleted, domain.EventTypeMeetupDeleted:
+		d := &DeletedV1{}
+		err := json.Unmarshal(e.Payload, d)
+		if err != nil {
+			return nil, err
+		}
+		return d, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,39 @@
+package broker
+
+import (
+	"sync"
+)
+
+// MemoryPublisher delivers published messages to in-process subscribers.
+// It is meant for local development and tests, without subscribers the messages are dropped.
+type MemoryPublisher struct {
+	subscribers map[string][]func(data []byte)
+	mu          sync.RWMutex
+}
+
+// NewMemoryPublisher creates a new in-memory publisher instance.
+func NewMemoryPublisher() *MemoryPublisher {
+	return &MemoryPublisher{
+		subscribers: make(map[string][]func(data []byte)),
+	}
+}
+
+// Subscribe calls the handler with every message published to the subject, ">" subscribes to all subjects.
+func (p *MemoryPublisher) Subscribe(subject string, handler func(data []byte)) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.subscribers[subject] = append(p.subscribers[subject], handler)
+}
+
+func (p *MemoryPublisher) Publish(subject string, data []byte) error {
+	p.mu.RLock()
+	handlers := append(append([]func(data []byte){}, p.subscribers[subject]...), p.subscribers[">"]...)
+	p.mu.RUnlock()
+
+	for _, handler := range handlers {
+		handler(data)
+	}
+	return nil
+}
+
+func (p *MemoryPublisher) Close() {}
@@ -0,0 +1,55 @@
+package broker
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/nats-io/nats.go"
+	"go.uber.org/zap"
+	"time"
+)
+
+// natsFlushTimeout is how long publishing waits for the server to acknowledge the connection is healthy.
+const natsFlushTimeout = 5 * time.Second
+
+type natsPublisher struct {
+	conn *nats.Conn
+}
+
+// NewNATSPublisher creates a new NATS publisher instance connected to the server at the url.
+func NewNATSPublisher(url string) (domain.EventPublisher, error) {
+	conn, err := nats.Connect(url,
+		nats.Name("upmeet-server"),
+		nats.MaxReconnects(-1),
+		nats.DisconnectErrHandler(func(_ *nats.Conn, err error) {
+			if err != nil {
+				zap.L().Warn("disconnected from nats", zap.Error(err))
+			}
+		}),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &natsPublisher{
+		conn: conn,
+	}, nil
+}
+
+func (p *natsPublisher) Publish(subject string, data []byte) error {
+	err := p.conn.Publish(subject, data)
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to publish message", zap.String("subject", subject), zap.Error(err))
+		return err
+	}
+	// Publishing only buffers the message, flushing makes sure it reached the server before the event counts as delivered.
+	err = p.conn.FlushTimeout(natsFlushTimeout)
+	if err != nil {
+		zap.L().Warn("failed to flush nats connection", zap.String("subject", subject), zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+func (p *natsPublisher) Close() {
+	_ = p.conn.Drain()
+}
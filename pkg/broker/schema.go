@@ -0,0 +1,91 @@
+package broker
+
+import (
+	"embed"
+	"fmt"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"time"
+)
+
+// Schemas contains the JSON schemas of all published messages, named "<type>.v<version>.json".
+// Changing a message in an incompatible way requires a new version, consumers rely on them.
+//
+//go:embed schemas/*.json
+var Schemas embed.FS
+
+// SchemaVersion is the version of the published message schemas.
+const SchemaVersion = 1
+
+// Envelope wraps the data of every published message.
+type Envelope struct {
+	ID         string      `json:"id"`
+	Type       string      `json:"type"`
+	Version    int         `json:"version"`
+	OccurredAt time.Time   `json:"occurred_at"`
+	Data       interface{} `json:"data"`
+}
+
+// UserV1 is the data of user.created and user.updated messages.
+// Private profile details and contact data are left out on purpose.
+type UserV1 struct {
+	ID        string    `json:"id"`
+	Username  string    `json:"username"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// MeetupV1 is the data of meetup.created and meetup.updated messages.
+type MeetupV1 struct {
+	ID                 string    `json:"id"`
+	Name               string    `json:"name"`
+	OwnerID            string    `json:"owner_id"`
+	InviteOnly         bool      `json:"invite_only"`
+	MinAge             int       `json:"min_age"`
+	RequireVerifiedAge bool      `json:"require_verified_age,omitempty"`
+	Country            string    `json:"country,omitempty"`
+	City               string    `json:"city,omitempty"`
+	StartsAt           time.Time `json:"starts_at"`
+	EndsAt             time.Time `json:"ends_at"`
+	CreatedAt          time.Time `json:"created_at"`
+}
+
+// ParticipantV1 is the data of meetup.participant_added and meetup.participant_removed messages.
+type ParticipantV1 struct {
+	MeetupID string `json:"meetup_id"`
+	UserID   string `json:"user_id"`
+}
+
+// DeletedV1 is the data of user.deleted and meetup.deleted messages.
+type DeletedV1 struct {
+	ID string `json:"id"`
+}
+
+// Subject returns the subject messages of the event type are published to.
+func Subject(eventType string) string {
+	return fmt.Sprintf("upmeet.%s.v%d", eventType, SchemaVersion)
+}
+
+func newUserV1(u *domain.User) *UserV1 {
+	return &UserV1{
+		ID:        u.ID,
+		Username:  u.Username,
+		Name:      u.Name,
+		CreatedAt: u.CreatedAt,
+	}
+}
+
+func newMeetupV1(m *domain.Meetup) *MeetupV1 {
+	return &MeetupV1{
+		ID:                 m.ID,
+		Name:               m.Name,
+		OwnerID:            m.OwnerID,
+		InviteOnly:         m.InviteOnly,
+		MinAge:             m.MinAge,
+		RequireVerifiedAge: m.RequireVerifiedAge,
+		Country:            m.MeetupLocation.Country,
+		City:               m.MeetupLocation.City,
+		StartsAt:           m.StartsAt,
+		EndsAt:             m.EndsAt,
+		CreatedAt:          m.CreatedAt,
+	}
+}
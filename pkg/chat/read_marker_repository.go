@@ -0,0 +1,55 @@
+package chat
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type readMarkerRepository struct {
+	db *gorm.DB
+}
+
+// NewReadMarkerRepository creates a new read marker repository instance.
+func NewReadMarkerRepository(db *gorm.DB) domain.ReadMarkerRepository {
+	return &readMarkerRepository{
+		db: db,
+	}
+}
+
+func (r *readMarkerRepository) SaveReadMarker(rm *domain.ReadMarker) error {
+	err := r.db.Save(rm).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to save read marker", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *readMarkerRepository) GetReadMarker(chatID string, userID string) (*domain.ReadMarker, error) {
+	rm := &domain.ReadMarker{}
+	err := r.db.Where("chat_id = ? AND user_id = ?", chatID, userID).First(rm).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fiber.ErrNotFound
+		}
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get read marker", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return rm, nil
+}
+
+func (r *readMarkerRepository) GetReadMarkersByChatID(chatID string) ([]*domain.ReadMarker, error) {
+	var markers []*domain.ReadMarker
+	err := r.db.Where("chat_id = ?", chatID).Find(&markers).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get read markers by chat id", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return markers, nil
+}
@@ -0,0 +1,173 @@
+package chat
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type messageRepository struct {
+	db *gorm.DB
+}
+
+// NewMessageRepository creates a new message repository instance.
+func NewMessageRepository(db *gorm.DB) domain.MessageRepository {
+	return &messageRepository{
+		db: db,
+	}
+}
+
+func (r *messageRepository) CreateMessage(m *domain.Message) error {
+	err := r.db.Create(m).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to create message", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *messageRepository) GetMessageByID(id string) (*domain.Message, error) {
+	m := &domain.Message{}
+	err := r.db.Preload("Reactions").Where("id = ?", id).First(m).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fiber.ErrNotFound
+		}
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get message by id", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return m, nil
+}
+
+func (r *messageRepository) GetMessagesByMeetupID(meetupID string, before string, limit int) ([]*domain.Message, error) {
+	var messages []*domain.Message
+	q := r.db.Preload("Reactions").Where("meetup_id = ?", meetupID).
+		Where("author_id NOT IN (SELECT id FROM users WHERE status = ?)", domain.UserStatusBanned)
+	if len(before) > 0 {
+		// The cursor has to be a message of the same chat, messages sent at the same time are ordered by their id.
+		q = q.Where("(created_at, id) < (SELECT created_at, id FROM messages WHERE id = ? AND meetup_id = ?)", before, meetupID)
+	}
+	err := q.Order("created_at DESC, id DESC").Limit(limit).Find(&messages).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get messages by meetup id", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return messages, nil
+}
+
+func (r *messageRepository) GetMessagesByConversationID(conversationID string, before string, limit int) ([]*domain.Message, error) {
+	var messages []*domain.Message
+	q := r.db.Preload("Reactions").Where("conversation_id = ?", conversationID).
+		Where("author_id NOT IN (SELECT id FROM users WHERE status = ?)", domain.UserStatusBanned)
+	if len(before) > 0 {
+		// The cursor has to be a message of the same chat, messages sent at the same time are ordered by their id.
+		q = q.Where("(created_at, id) < (SELECT created_at, id FROM messages WHERE id = ? AND conversation_id = ?)", before, conversationID)
+	}
+	err := q.Order("created_at DESC, id DESC").Limit(limit).Find(&messages).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get messages by conversation id", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return messages, nil
+}
+
+func (r *messageRepository) GetLastMessageByConversationID(conversationID string) (*domain.Message, error) {
+	m := &domain.Message{}
+	err := r.db.Where("conversation_id = ?", conversationID).Order("created_at DESC").First(m).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fiber.ErrNotFound
+		}
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get last message by conversation id", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return m, nil
+}
+
+// GetLastConversationMessages returns the latest message of each of the conversations, conversations without messages are left out.
+// Like the message history, it leaves out messages of banned users.
+func (r *messageRepository) GetLastConversationMessages(conversationIDs []string) ([]*domain.Message, error) {
+	var messages []*domain.Message
+	if len(conversationIDs) == 0 {
+		return messages, nil
+	}
+	err := r.db.Select("DISTINCT ON (conversation_id) *").
+		Where("conversation_id IN ?", conversationIDs).
+		Where("author_id NOT IN (SELECT id FROM users WHERE status = ?)", domain.UserStatusBanned).
+		Order("conversation_id, created_at DESC, id DESC").
+		Find(&messages).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get last conversation messages", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return messages, nil
+}
+
+// CountUnreadConversationMessages counts the messages of others the user hasn't read yet per conversation.
+// Without a read marker every message is unread, conversations without unread messages are left out.
+func (r *messageRepository) CountUnreadConversationMessages(userID string, conversationIDs []string) (map[string]int64, error) {
+	counts := make(map[string]int64, len(conversationIDs))
+	if len(conversationIDs) == 0 {
+		return counts, nil
+	}
+	var rows []struct {
+		ConversationID string
+		Count          int64
+	}
+	err := r.db.Table("messages AS m").
+		Select("m.conversation_id, COUNT(*) AS count").
+		Joins("LEFT JOIN read_markers AS rm ON rm.chat_id = m.conversation_id AND rm.user_id = ?", userID).
+		Where("m.conversation_id IN ? AND m.author_id <> ? AND m.deleted = ?", conversationIDs, userID, false).
+		Where("rm.read_until IS NULL OR m.created_at > rm.read_until").
+		Where("m.author_id NOT IN (SELECT id FROM users WHERE status = ?)", domain.UserStatusBanned).
+		Group("m.conversation_id").
+		Scan(&rows).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to count unread conversation messages", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	for _, row := range rows {
+		counts[row.ConversationID] = row.Count
+	}
+	return counts, nil
+}
+
+func (r *messageRepository) UpdateMessage(m *domain.Message) error {
+	err := r.db.Omit("Reactions").Save(m).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to update message", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *messageRepository) AddReaction(rc *domain.Reaction) error {
+	err := r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(rc).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to add reaction", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *messageRepository) RemoveReaction(messageID string, userID string, emoji string) error {
+	err := r.db.Delete(&domain.Reaction{}, "message_id = ? AND user_id = ? AND emoji = ?", messageID, userID, emoji).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to remove reaction", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
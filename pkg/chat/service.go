@@ -0,0 +1,300 @@
+package chat
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+	"unicode"
+)
+
+type chatService struct {
+	messageRepository      domain.MessageRepository
+	readMarkerRepository   domain.ReadMarkerRepository
+	meetupRepository       domain.MeetupRepository
+	conversationRepository domain.ConversationRepository
+	hub                    domain.Hub
+}
+
+// NewChatService creates a new chat service instance.
+func NewChatService(messageRepository domain.MessageRepository, readMarkerRepository domain.ReadMarkerRepository, meetupRepository domain.MeetupRepository, conversationRepository domain.ConversationRepository, hub domain.Hub) domain.ChatService {
+	return &chatService{
+		messageRepository:      messageRepository,
+		readMarkerRepository:   readMarkerRepository,
+		meetupRepository:       meetupRepository,
+		conversationRepository: conversationRepository,
+		hub:                    hub,
+	}
+}
+
+func (s *chatService) CheckMeetupChatAccess(uid string, meetupID string) error {
+	_, err := s.meetupRepository.GetMeetupByID(meetupID)
+	if err != nil {
+		return err
+	}
+	ok, err := s.meetupRepository.IsParticipant(meetupID, uid)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return domain.ErrNotParticipant
+	}
+	return nil
+}
+
+func (s *chatService) SubscribeMeetupChat(uid string, meetupID string) (domain.Subscription, error) {
+	err := s.CheckMeetupChatAccess(uid, meetupID)
+	if err != nil {
+		return nil, err
+	}
+	// The subscription ends when the user leaves, so they don't keep receiving a chat they are no longer part of.
+	return newParticipantSubscription(s.hub.Subscribe(domain.MeetupChatTopic(meetupID)), uid, func() (bool, error) {
+		return s.meetupRepository.IsParticipant(meetupID, uid)
+	}), nil
+}
+
+func (s *chatService) GetMeetupMessages(uid string, meetupID string, before string, limit int) ([]*domain.Message, error) {
+	err := s.CheckMeetupChatAccess(uid, meetupID)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > domain.MessagesMaxLimit {
+		limit = domain.MessagesDefaultLimit
+	}
+	return s.messageRepository.GetMessagesByMeetupID(meetupID, before, limit)
+}
+
+func (s *chatService) CreateMeetupMessage(uid string, meetupID string, dto *domain.CreateMessageDTO) (*domain.Message, error) {
+	content := strings.TrimSpace(dto.Content)
+	if len(content) == 0 || len(content) > domain.MessageContentMaxLength {
+		return nil, domain.ErrInvalidMessageContent
+	}
+	err := s.CheckMeetupChatAccess(uid, meetupID)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &domain.Message{
+		ID:        uuid.NewString(),
+		MeetupID:  meetupID,
+		AuthorID:  uid,
+		Content:   content,
+		CreatedAt: time.Now(),
+	}
+	err = s.messageRepository.CreateMessage(m)
+	if err != nil {
+		return nil, err
+	}
+	err = s.readMarkerRepository.SaveReadMarker(&domain.ReadMarker{
+		ChatID:    meetupID,
+		UserID:    uid,
+		MessageID: m.ID,
+		ReadUntil: m.CreatedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.hub.Publish(domain.MeetupChatTopic(meetupID), &domain.RealtimeEvent{
+		Type: domain.RealtimeEventMessageCreated,
+		Data: m,
+	})
+	return m, nil
+}
+
+func (s *chatService) GetMeetupReadMarkers(uid string, meetupID string) ([]*domain.ReadMarker, error) {
+	err := s.CheckMeetupChatAccess(uid, meetupID)
+	if err != nil {
+		return nil, err
+	}
+	return s.readMarkerRepository.GetReadMarkersByChatID(meetupID)
+}
+
+func (s *chatService) UpdateMessage(uid string, messageID string, dto *domain.UpdateMessageDTO) (*domain.Message, error) {
+	content := strings.TrimSpace(dto.Content)
+	if len(content) == 0 || len(content) > domain.MessageContentMaxLength {
+		return nil, domain.ErrInvalidMessageContent
+	}
+	m, topics, err := s.getMessage(uid, messageID)
+	if err != nil {
+		return nil, err
+	}
+	if m.AuthorID != uid {
+		return nil, domain.ErrNotMessageAuthor
+	}
+	if m.Deleted {
+		return nil, domain.ErrMessageDeleted
+	}
+	if time.Since(m.CreatedAt) > domain.MessageEditWindow {
+		return nil, domain.ErrMessageEditWindowExpired
+	}
+
+	now := time.Now()
+	m.Content = content
+	m.Edited = true
+	m.EditedAt = &now
+	err = s.messageRepository.UpdateMessage(m)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publish(topics, &domain.RealtimeEvent{
+		Type: domain.RealtimeEventMessageUpdated,
+		Data: m,
+	})
+	return m, nil
+}
+
+func (s *chatService) DeleteMessage(uid string, messageID string) error {
+	m, topics, err := s.getMessage(uid, messageID)
+	if err != nil {
+		return err
+	}
+	if m.AuthorID != uid {
+		return domain.ErrNotMessageAuthor
+	}
+	if m.Deleted {
+		return domain.ErrMessageDeleted
+	}
+
+	now := time.Now()
+	m.Content = ""
+	m.Deleted = true
+	m.DeletedAt = &now
+	err = s.messageRepository.UpdateMessage(m)
+	if err != nil {
+		return err
+	}
+
+	s.publish(topics, &domain.RealtimeEvent{
+		Type: domain.RealtimeEventMessageDeleted,
+		Data: m,
+	})
+	return nil
+}
+
+func (s *chatService) AddReaction(uid string, messageID string, emoji string) error {
+	if !validEmoji(emoji) {
+		return domain.ErrInvalidEmoji
+	}
+	m, topics, err := s.getMessage(uid, messageID)
+	if err != nil {
+		return err
+	}
+	if m.Deleted {
+		return domain.ErrMessageDeleted
+	}
+
+	r := &domain.Reaction{
+		MessageID: messageID,
+		UserID:    uid,
+		Emoji:     emoji,
+		CreatedAt: time.Now(),
+	}
+	err = s.messageRepository.AddReaction(r)
+	if err != nil {
+		return err
+	}
+
+	s.publish(topics, &domain.RealtimeEvent{
+		Type: domain.RealtimeEventReactionAdded,
+		Data: r,
+	})
+	return nil
+}
+
+func (s *chatService) RemoveReaction(uid string, messageID string, emoji string) error {
+	_, topics, err := s.getMessage(uid, messageID)
+	if err != nil {
+		return err
+	}
+	err = s.messageRepository.RemoveReaction(messageID, uid, emoji)
+	if err != nil {
+		return err
+	}
+
+	s.publish(topics, &domain.RealtimeEvent{
+		Type: domain.RealtimeEventReactionRemoved,
+		Data: &domain.Reaction{MessageID: messageID, UserID: uid, Emoji: emoji},
+	})
+	return nil
+}
+
+func (s *chatService) MarkRead(uid string, messageID string) (*domain.ReadMarker, error) {
+	m, topics, err := s.getMessage(uid, messageID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Read markers only ever move forward.
+	rm, err := s.readMarkerRepository.GetReadMarker(m.ChatID(), uid)
+	if err != nil && err != fiber.ErrNotFound {
+		return nil, err
+	}
+	if rm != nil && !m.CreatedAt.After(rm.ReadUntil) {
+		return rm, nil
+	}
+
+	rm = &domain.ReadMarker{
+		ChatID:    m.ChatID(),
+		UserID:    uid,
+		MessageID: m.ID,
+		ReadUntil: m.CreatedAt,
+	}
+	err = s.readMarkerRepository.SaveReadMarker(rm)
+	if err != nil {
+		return nil, err
+	}
+
+	s.publish(topics, &domain.RealtimeEvent{
+		Type: domain.RealtimeEventReadMarkerUpdated,
+		Data: rm,
+	})
+	return rm, nil
+}
+
+// getMessage returns the message if the user has access to the chat it was sent to,
+// along with the real-time topics changes to the message are published to.
+func (s *chatService) getMessage(uid string, messageID string) (*domain.Message, []string, error) {
+	m, err := s.messageRepository.GetMessageByID(messageID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(m.MeetupID) > 0 {
+		err = s.CheckMeetupChatAccess(uid, m.MeetupID)
+		if err != nil {
+			return nil, nil, err
+		}
+		return m, []string{domain.MeetupChatTopic(m.MeetupID)}, nil
+	}
+
+	c, err := s.conversationRepository.GetConversationByID(m.ConversationID)
+	if err != nil {
+		return nil, nil, err
+	}
+	topics := make([]string, 0, len(c.Members))
+	member := false
+	for _, cm := range c.Members {
+		topics = append(topics, domain.UserTopic(cm.UserID))
+		member = member || cm.UserID == uid
+	}
+	if !member {
+		return nil, nil, domain.ErrNotConversationMember
+	}
+	return m, topics, nil
+}
+
+func (s *chatService) publish(topics []string, e *domain.RealtimeEvent) {
+	for _, topic := range topics {
+		s.hub.Publish(topic, e)
+	}
+}
+
+func validEmoji(emoji string) bool {
+	if len(emoji) == 0 || len(emoji) > domain.ReactionEmojiMaxLength {
+		return false
+	}
+	return strings.IndexFunc(emoji, unicode.IsSpace) == -1
+}
@@ -0,0 +1,283 @@
+package chat
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/UpMeetApp/server/pkg/domain/mock"
+	"github.com/UpMeetApp/server/pkg/realtime"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func Test_chatService_CheckMeetupChatAccess(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	meetupRepo := mock.NewMockMeetupRepository(ctrl)
+	s := NewChatService(mock.NewMockMessageRepository(ctrl), mock.NewMockReadMarkerRepository(ctrl), meetupRepo, mock.NewMockConversationRepository(ctrl), mock.NewMockHub(ctrl))
+
+	uid := "1"
+	id := "m1"
+
+	// Meetup not found
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(nil, fiber.ErrNotFound)
+	err := s.CheckMeetupChatAccess(uid, id)
+	assert.ErrorIs(t, err, fiber.ErrNotFound)
+
+	// Not a participant
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id}, nil)
+	meetupRepo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(false, nil)
+	err = s.CheckMeetupChatAccess(uid, id)
+	assert.ErrorIs(t, err, domain.ErrNotParticipant)
+
+	// Participant
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id}, nil)
+	meetupRepo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(true, nil)
+	err = s.CheckMeetupChatAccess(uid, id)
+	assert.NoError(t, err)
+}
+
+func Test_chatService_SubscribeMeetupChat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	meetupRepo := mock.NewMockMeetupRepository(ctrl)
+	hub := realtime.NewHub()
+	s := NewChatService(mock.NewMockMessageRepository(ctrl), mock.NewMockReadMarkerRepository(ctrl), meetupRepo, mock.NewMockConversationRepository(ctrl), hub)
+
+	uid := "1"
+	id := "m1"
+	left := func(userID string) *domain.RealtimeEvent {
+		return &domain.RealtimeEvent{Type: domain.RealtimeEventParticipantLeft, Data: &domain.ParticipantEvent{MeetupID: id, UserID: userID}}
+	}
+	next := func(sub domain.Subscription) (*domain.RealtimeEvent, bool) {
+		select {
+		case e, ok := <-sub.Events():
+			return e, ok
+		case <-time.After(time.Second):
+			t.Fatal("no event received")
+			return nil, false
+		}
+	}
+
+	// Not a participant
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id}, nil)
+	meetupRepo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(false, nil)
+	sub, err := s.SubscribeMeetupChat(uid, id)
+	assert.ErrorIs(t, err, domain.ErrNotParticipant)
+	assert.Nil(t, sub)
+
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id}, nil)
+	meetupRepo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(true, nil)
+	sub, err = s.SubscribeMeetupChat(uid, id)
+	assert.NoError(t, err)
+
+	// Other participants leaving don't end the subscription
+	hub.Publish(domain.MeetupChatTopic(id), left("2"))
+	e, ok := next(sub)
+	assert.True(t, ok)
+	assert.Equal(t, domain.RealtimeEventParticipantLeft, e.Type)
+
+	// The subscription ends after the user left
+	meetupRepo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(false, nil)
+	hub.Publish(domain.MeetupChatTopic(id), left(uid))
+	e, ok = next(sub)
+	assert.True(t, ok)
+	assert.Equal(t, left(uid), e)
+	_, ok = next(sub)
+	assert.False(t, ok)
+	sub.Close()
+}
+
+func Test_chatService_GetMeetupMessages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockMessageRepository(ctrl)
+	meetupRepo := mock.NewMockMeetupRepository(ctrl)
+	s := NewChatService(repo, mock.NewMockReadMarkerRepository(ctrl), meetupRepo, mock.NewMockConversationRepository(ctrl), mock.NewMockHub(ctrl))
+
+	uid := "1"
+	id := "m1"
+
+	// Limit falls back to default
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id}, nil)
+	meetupRepo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(true, nil)
+	repo.EXPECT().GetMessagesByMeetupID(gomock.Eq(id), gomock.Eq("x"), gomock.Eq(domain.MessagesDefaultLimit)).Return([]*domain.Message{}, nil)
+	m, err := s.GetMeetupMessages(uid, id, "x", domain.MessagesMaxLimit+1)
+	assert.NoError(t, err)
+	assert.NotNil(t, m)
+}
+
+func Test_chatService_CreateMeetupMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockMessageRepository(ctrl)
+	meetupRepo := mock.NewMockMeetupRepository(ctrl)
+	readMarkerRepo := mock.NewMockReadMarkerRepository(ctrl)
+	hub := mock.NewMockHub(ctrl)
+	s := NewChatService(repo, readMarkerRepo, meetupRepo, mock.NewMockConversationRepository(ctrl), hub)
+
+	uid := "1"
+	id := "m1"
+
+	// Empty content
+	m, err := s.CreateMeetupMessage(uid, id, &domain.CreateMessageDTO{Content: "   "})
+	assert.ErrorIs(t, err, domain.ErrInvalidMessageContent)
+	assert.Nil(t, m)
+
+	// CreateMessage returns error
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id}, nil)
+	meetupRepo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(true, nil)
+	repo.EXPECT().CreateMessage(gomock.Any()).Return(fiber.ErrInternalServerError)
+	m, err = s.CreateMeetupMessage(uid, id, &domain.CreateMessageDTO{Content: "hi"})
+	assert.ErrorIs(t, err, fiber.ErrInternalServerError)
+	assert.Nil(t, m)
+
+	// CreateMeetupMessage successful and published
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id}, nil)
+	meetupRepo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(true, nil)
+	repo.EXPECT().CreateMessage(gomock.Any()).Return(nil)
+	readMarkerRepo.EXPECT().SaveReadMarker(gomock.Any()).Return(nil)
+	hub.EXPECT().Publish(gomock.Eq(domain.MeetupChatTopic(id)), gomock.Any())
+	m, err = s.CreateMeetupMessage(uid, id, &domain.CreateMessageDTO{Content: " hi "})
+	assert.NoError(t, err)
+	assert.Equal(t, "hi", m.Content)
+	assert.Equal(t, uid, m.AuthorID)
+}
+
+func Test_chatService_UpdateMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockMessageRepository(ctrl)
+	meetupRepo := mock.NewMockMeetupRepository(ctrl)
+	hub := mock.NewMockHub(ctrl)
+	s := NewChatService(repo, mock.NewMockReadMarkerRepository(ctrl), meetupRepo, mock.NewMockConversationRepository(ctrl), hub)
+
+	uid := "1"
+	id := "m1"
+	allowAccess := func() {
+		meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id}, nil)
+		meetupRepo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(true, nil)
+	}
+	dto := &domain.UpdateMessageDTO{Content: "edited"}
+
+	// Not the author
+	repo.EXPECT().GetMessageByID(gomock.Eq("msg")).Return(&domain.Message{ID: "msg", MeetupID: id, AuthorID: "2", CreatedAt: time.Now()}, nil)
+	allowAccess()
+	m, err := s.UpdateMessage(uid, "msg", dto)
+	assert.ErrorIs(t, err, domain.ErrNotMessageAuthor)
+	assert.Nil(t, m)
+
+	// Edit window expired
+	repo.EXPECT().GetMessageByID(gomock.Eq("msg")).Return(&domain.Message{ID: "msg", MeetupID: id, AuthorID: uid, CreatedAt: time.Now().Add(-domain.MessageEditWindow - time.Minute)}, nil)
+	allowAccess()
+	m, err = s.UpdateMessage(uid, "msg", dto)
+	assert.ErrorIs(t, err, domain.ErrMessageEditWindowExpired)
+	assert.Nil(t, m)
+
+	// Deleted message
+	repo.EXPECT().GetMessageByID(gomock.Eq("msg")).Return(&domain.Message{ID: "msg", MeetupID: id, AuthorID: uid, Deleted: true, CreatedAt: time.Now()}, nil)
+	allowAccess()
+	m, err = s.UpdateMessage(uid, "msg", dto)
+	assert.ErrorIs(t, err, domain.ErrMessageDeleted)
+	assert.Nil(t, m)
+
+	// UpdateMessage successful
+	repo.EXPECT().GetMessageByID(gomock.Eq("msg")).Return(&domain.Message{ID: "msg", MeetupID: id, AuthorID: uid, Content: "hi", CreatedAt: time.Now()}, nil)
+	allowAccess()
+	repo.EXPECT().UpdateMessage(gomock.Any()).Return(nil)
+	hub.EXPECT().Publish(gomock.Eq(domain.MeetupChatTopic(id)), gomock.Any())
+	m, err = s.UpdateMessage(uid, "msg", dto)
+	assert.NoError(t, err)
+	assert.Equal(t, "edited", m.Content)
+	assert.True(t, m.Edited)
+	assert.NotNil(t, m.EditedAt)
+}
+
+func Test_chatService_DeleteMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockMessageRepository(ctrl)
+	conversationRepo := mock.NewMockConversationRepository(ctrl)
+	hub := mock.NewMockHub(ctrl)
+	s := NewChatService(repo, mock.NewMockReadMarkerRepository(ctrl), mock.NewMockMeetupRepository(ctrl), conversationRepo, hub)
+
+	uid := "1"
+	c := &domain.Conversation{ID: "c1", Members: []*domain.ConversationMember{{UserID: uid}, {UserID: "2"}}}
+
+	// Not a conversation member
+	repo.EXPECT().GetMessageByID(gomock.Eq("msg")).Return(&domain.Message{ID: "msg", ConversationID: "c1", AuthorID: "3"}, nil)
+	conversationRepo.EXPECT().GetConversationByID(gomock.Eq("c1")).Return(&domain.Conversation{ID: "c1"}, nil)
+	err := s.DeleteMessage(uid, "msg")
+	assert.ErrorIs(t, err, domain.ErrNotConversationMember)
+
+	// DeleteMessage successful and published to both members
+	var deleted *domain.Message
+	repo.EXPECT().GetMessageByID(gomock.Eq("msg")).Return(&domain.Message{ID: "msg", ConversationID: "c1", AuthorID: uid, Content: "hi"}, nil)
+	conversationRepo.EXPECT().GetConversationByID(gomock.Eq("c1")).Return(c, nil)
+	repo.EXPECT().UpdateMessage(gomock.Any()).DoAndReturn(func(m *domain.Message) error {
+		deleted = m
+		return nil
+	})
+	hub.EXPECT().Publish(gomock.Eq(domain.UserTopic(uid)), gomock.Any())
+	hub.EXPECT().Publish(gomock.Eq(domain.UserTopic("2")), gomock.Any())
+	err = s.DeleteMessage(uid, "msg")
+	assert.NoError(t, err)
+	assert.True(t, deleted.Deleted)
+	assert.Empty(t, deleted.Content)
+}
+
+func Test_chatService_AddReaction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockMessageRepository(ctrl)
+	meetupRepo := mock.NewMockMeetupRepository(ctrl)
+	hub := mock.NewMockHub(ctrl)
+	s := NewChatService(repo, mock.NewMockReadMarkerRepository(ctrl), meetupRepo, mock.NewMockConversationRepository(ctrl), hub)
+
+	uid := "1"
+	id := "m1"
+
+	// Invalid emoji
+	err := s.AddReaction(uid, "msg", "a b")
+	assert.ErrorIs(t, err, domain.ErrInvalidEmoji)
+
+	// AddReaction successful
+	repo.EXPECT().GetMessageByID(gomock.Eq("msg")).Return(&domain.Message{ID: "msg", MeetupID: id}, nil)
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id}, nil)
+	meetupRepo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(true, nil)
+	repo.EXPECT().AddReaction(gomock.Any()).Return(nil)
+	hub.EXPECT().Publish(gomock.Eq(domain.MeetupChatTopic(id)), gomock.Any())
+	err = s.AddReaction(uid, "msg", "👍")
+	assert.NoError(t, err)
+}
+
+func Test_chatService_MarkRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockMessageRepository(ctrl)
+	readMarkerRepo := mock.NewMockReadMarkerRepository(ctrl)
+	meetupRepo := mock.NewMockMeetupRepository(ctrl)
+	hub := mock.NewMockHub(ctrl)
+	s := NewChatService(repo, readMarkerRepo, meetupRepo, mock.NewMockConversationRepository(ctrl), hub)
+
+	uid := "1"
+	id := "m1"
+	now := time.Now()
+	allowAccess := func() {
+		meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id}, nil)
+		meetupRepo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(true, nil)
+	}
+
+	// Older messages don't move the marker back
+	current := &domain.ReadMarker{ChatID: id, UserID: uid, MessageID: "new", ReadUntil: now}
+	repo.EXPECT().GetMessageByID(gomock.Eq("old")).Return(&domain.Message{ID: "old", MeetupID: id, CreatedAt: now.Add(-time.Minute)}, nil)
+	allowAccess()
+	readMarkerRepo.EXPECT().GetReadMarker(gomock.Eq(id), gomock.Eq(uid)).Return(current, nil)
+	rm, err := s.MarkRead(uid, "old")
+	assert.NoError(t, err)
+	assert.Equal(t, current, rm)
+
+	// First read marker is created
+	repo.EXPECT().GetMessageByID(gomock.Eq("new")).Return(&domain.Message{ID: "new", MeetupID: id, CreatedAt: now}, nil)
+	allowAccess()
+	readMarkerRepo.EXPECT().GetReadMarker(gomock.Eq(id), gomock.Eq(uid)).Return(nil, fiber.ErrNotFound)
+	readMarkerRepo.EXPECT().SaveReadMarker(gomock.Any()).Return(nil)
+	hub.EXPECT().Publish(gomock.Eq(domain.MeetupChatTopic(id)), gomock.Any())
+	rm, err = s.MarkRead(uid, "new")
+	assert.NoError(t, err)
+	assert.Equal(t, "new", rm.MessageID)
+	assert.Equal(t, now, rm.ReadUntil)
+}
@@ -0,0 +1,58 @@
+package chat
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"sync"
+)
+
+// participantSubscription is a subscription to a meetup chat that ends once the user is no longer a participant of the meetup.
+// Participation is checked again whenever the user is announced to have left the meetup.
+type participantSubscription struct {
+	sub    domain.Subscription
+	events chan *domain.RealtimeEvent
+	done   chan struct{}
+	once   sync.Once
+}
+
+func newParticipantSubscription(sub domain.Subscription, uid string, isParticipant func() (bool, error)) domain.Subscription {
+	s := &participantSubscription{
+		sub:    sub,
+		events: make(chan *domain.RealtimeEvent),
+		done:   make(chan struct{}),
+	}
+	go s.forward(uid, isParticipant)
+	return s
+}
+
+// forward passes the events of the chat on until the subscription is closed or the user left.
+// The event announcing that the user left is still passed on, so the client knows why the subscription ended.
+func (s *participantSubscription) forward(uid string, isParticipant func() (bool, error)) {
+	defer close(s.events)
+	for e := range s.sub.Events() {
+		select {
+		case s.events <- e:
+		case <-s.done:
+			return
+		}
+		p, ok := e.Data.(*domain.ParticipantEvent)
+		if e.Type != domain.RealtimeEventParticipantLeft || !ok || p.UserID != uid {
+			continue
+		}
+		// The user may have joined again in the meantime, if participation can't be checked the subscription ends to be safe.
+		if ok, err := isParticipant(); err != nil || !ok {
+			s.sub.Close()
+			return
+		}
+	}
+}
+
+func (s *participantSubscription) Events() <-chan *domain.RealtimeEvent {
+	return s.events
+}
+
+func (s *participantSubscription) Close() {
+	s.once.Do(func() {
+		close(s.done)
+		s.sub.Close()
+	})
+}
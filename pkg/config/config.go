@@ -1,22 +1,72 @@
 package config
 
 import (
+	"github.com/UpMeetApp/server/pkg/domain"
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
 	"log"
+	"time"
 )
 
 // Config holds the configuration for the application.
 type Config struct {
-	Debug               bool   `envconfig:"DEBUG" default:"false"`
-	FirebaseCredentials string `envconfig:"FIREBASE_ACCOUNT_KEY" required:"true"`
-	PostgresHost        string `envconfig:"POSTGRES_HOST" default:"localhost"`
-	PostgresPort        int    `envconfig:"POSTGRES_PORT" default:"5432"`
-	PostgresUser        string `envconfig:"POSTGRES_USER" default:"upmeet"`
-	PostgresPassword    string `envconfig:"POSTGRES_PASSWORD" default:"upmeet"`
-	PostgresDatabase    string `envconfig:"POSTGRES_DATABASE" default:"upmeet"`
-	PostgresSSLMode     string `envconfig:"POSTGRES_SSLMODE" default:"disable"`
-	BindAddress         string `envconfig:"BIND_ADDRESS" default:":3000"`
+	Debug                      bool          `envconfig:"DEBUG" default:"false"`
+	FirebaseCredentials        string        `envconfig:"FIREBASE_ACCOUNT_KEY" required:"true"`
+	PostgresHost               string        `envconfig:"POSTGRES_HOST" default:"localhost"`
+	PostgresPort               int           `envconfig:"POSTGRES_PORT" default:"5432"`
+	PostgresUser               string        `envconfig:"POSTGRES_USER" default:"upmeet"`
+	PostgresPassword           string        `envconfig:"POSTGRES_PASSWORD" default:"upmeet"`
+	PostgresDatabase           string        `envconfig:"POSTGRES_DATABASE" default:"upmeet"`
+	PostgresSSLMode            string        `envconfig:"POSTGRES_SSLMODE" default:"disable"`
+	BindAddress                string        `envconfig:"BIND_ADDRESS" default:":3000"`
+	PushSender                 string        `envconfig:"PUSH_SENDER" default:"fcm"`
+	SMTPHost                   string        `envconfig:"SMTP_HOST" default:"localhost"`
+	SMTPPort                   int           `envconfig:"SMTP_PORT" default:"1025"`
+	SMTPUsername               string        `envconfig:"SMTP_USERNAME"`
+	SMTPPassword               string        `envconfig:"SMTP_PASSWORD"`
+	SMTPFrom                   string        `envconfig:"SMTP_FROM" default:"UpMeet <noreply@upmeet.app>"`
+	EmailVerificationURL       string        `envconfig:"EMAIL_VERIFICATION_URL" default:"https://upmeet.app/verify-email"`
+	MeetupReminderOffset       time.Duration `envconfig:"MEETUP_REMINDER_OFFSET" default:"2h"`
+	EventPublisher             string        `envconfig:"EVENT_PUBLISHER" default:"nats"`
+	NATSURL                    string        `envconfig:"NATS_URL" default:"nats://localhost:4222"`
+	ContentFilterWords         string        `envconfig:"CONTENT_FILTER_WORDS"`
+	ReservedUsernames          string        `envconfig:"RESERVED_USERNAMES"`
+	AbuseNewAccountAge         time.Duration `envconfig:"ABUSE_NEW_ACCOUNT_AGE" default:"72h"`
+	AbuseMeetupsPerDay         int           `envconfig:"ABUSE_MEETUPS_PER_DAY" default:"10"`
+	AbuseMeetupsPerDayNew      int           `envconfig:"ABUSE_MEETUPS_PER_DAY_NEW" default:"3"`
+	AbuseInvitationsPerHour    int           `envconfig:"ABUSE_INVITATIONS_PER_HOUR" default:"50"`
+	AbuseInvitationsPerHourNew int           `envconfig:"ABUSE_INVITATIONS_PER_HOUR_NEW" default:"10"`
+	AbuseJoinsPerHour          int           `envconfig:"ABUSE_JOINS_PER_HOUR" default:"30"`
+	AbuseJoinsPerHourNew       int           `envconfig:"ABUSE_JOINS_PER_HOUR_NEW" default:"10"`
+	AbuseBurstLimit            int           `envconfig:"ABUSE_BURST_LIMIT" default:"5"`
+	AbuseFlagScore             int           `envconfig:"ABUSE_FLAG_SCORE" default:"50"`
+	AbuseBlockScore            int           `envconfig:"ABUSE_BLOCK_SCORE" default:"100"`
+	RateLimitStore             string        `envconfig:"RATE_LIMIT_STORE" default:"memory"`
+	RateLimitRequests          int           `envconfig:"RATE_LIMIT_REQUESTS" default:"600"`
+	RateLimitWindow            time.Duration `envconfig:"RATE_LIMIT_WINDOW" default:"1m"`
+	RedisURL                   string        `envconfig:"REDIS_URL" default:"redis://localhost:6379/0"`
+	ProxyHeader                string        `envconfig:"PROXY_HEADER"`
+	TrustedProxies             []string      `envconfig:"TRUSTED_PROXIES"`
+	FileStorage                string        `envconfig:"FILE_STORAGE" default:"gcs"`
+	FileStorageDir             string        `envconfig:"FILE_STORAGE_DIR" default:"files"`
+	FileBaseURL                string        `envconfig:"FILE_BASE_URL"`
+	StorageBucket              string        `envconfig:"STORAGE_BUCKET"`
+}
+
+// AbuseLimits returns the abuse limits configured for the application.
+func (c *Config) AbuseLimits() domain.AbuseLimits {
+	return domain.AbuseLimits{
+		NewAccountAge:         c.AbuseNewAccountAge,
+		MeetupsPerDay:         c.AbuseMeetupsPerDay,
+		MeetupsPerDayNew:      c.AbuseMeetupsPerDayNew,
+		InvitationsPerHour:    c.AbuseInvitationsPerHour,
+		InvitationsPerHourNew: c.AbuseInvitationsPerHourNew,
+		JoinsPerHour:          c.AbuseJoinsPerHour,
+		JoinsPerHourNew:       c.AbuseJoinsPerHourNew,
+		BurstLimit:            c.AbuseBurstLimit,
+		FlagScore:             c.AbuseFlagScore,
+		BlockScore:            c.AbuseBlockScore,
+	}
 }
 
 // LoadConfig loads the configuration from the environment.
@@ -0,0 +1,83 @@
+package conversation
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"time"
+)
+
+type conversationRepository struct {
+	db *gorm.DB
+}
+
+// NewConversationRepository creates a new conversation repository instance.
+func NewConversationRepository(db *gorm.DB) domain.ConversationRepository {
+	return &conversationRepository{
+		db: db,
+	}
+}
+
+func (r *conversationRepository) CreateConversation(c *domain.Conversation) error {
+	err := r.db.Create(c).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to create conversation", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *conversationRepository) GetConversationByID(id string) (*domain.Conversation, error) {
+	c := &domain.Conversation{}
+	err := r.db.Preload("Members").Where("id = ?", id).First(c).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fiber.ErrNotFound
+		}
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get conversation by id", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return c, nil
+}
+
+func (r *conversationRepository) GetConversationByKey(key string) (*domain.Conversation, error) {
+	c := &domain.Conversation{}
+	err := r.db.Preload("Members").Where("key = ?", key).First(c).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fiber.ErrNotFound
+		}
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get conversation by key", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return c, nil
+}
+
+func (r *conversationRepository) GetConversationsByUserID(userID string) ([]*domain.Conversation, error) {
+	var conversations []*domain.Conversation
+	err := r.db.Preload("Members").
+		Where("id IN (?)", r.db.Model(&domain.ConversationMember{}).Select("conversation_id").Where("user_id = ?", userID)).
+		Order("last_message_at DESC").
+		Find(&conversations).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get conversations by user id", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return conversations, nil
+}
+
+func (r *conversationRepository) UpdateLastMessageAt(id string, t time.Time) error {
+	err := r.db.Model(&domain.Conversation{}).Where("id = ?", id).Update("last_message_at", t).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to update last message at", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
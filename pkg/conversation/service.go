@@ -0,0 +1,244 @@
+package conversation
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"strings"
+	"time"
+)
+
+type conversationService struct {
+	conversationRepository domain.ConversationRepository
+	messageRepository      domain.MessageRepository
+	readMarkerRepository   domain.ReadMarkerRepository
+	userRepository         domain.UserRepository
+	blockRepository        domain.BlockRepository
+	hub                    domain.Hub
+}
+
+// NewConversationService creates a new conversation service instance.
+func NewConversationService(conversationRepository domain.ConversationRepository, messageRepository domain.MessageRepository, readMarkerRepository domain.ReadMarkerRepository, userRepository domain.UserRepository, blockRepository domain.BlockRepository, hub domain.Hub) domain.ConversationService {
+	return &conversationService{
+		conversationRepository: conversationRepository,
+		messageRepository:      messageRepository,
+		readMarkerRepository:   readMarkerRepository,
+		userRepository:         userRepository,
+		blockRepository:        blockRepository,
+		hub:                    hub,
+	}
+}
+
+func (s *conversationService) StartConversation(uid string, dto *domain.StartConversationDTO) (*domain.Conversation, error) {
+	u, err := s.userRepository.GetUserByUsername(dto.Username)
+	if err != nil {
+		return nil, err
+	}
+	if u.ID == uid {
+		return nil, domain.ErrCannotMessageSelf
+	}
+	err = s.checkNotBlocked(uid, u.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := s.conversationRepository.GetConversationByKey(domain.ConversationKey(uid, u.ID))
+	if err != fiber.ErrNotFound {
+		return c, err
+	}
+
+	now := time.Now()
+	c = &domain.Conversation{
+		ID:  uuid.NewString(),
+		Key: domain.ConversationKey(uid, u.ID),
+		Members: []*domain.ConversationMember{
+			{UserID: uid},
+			{UserID: u.ID},
+		},
+		LastMessageAt: now,
+		CreatedAt:     now,
+	}
+	err = s.conversationRepository.CreateConversation(c)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// GetConversations returns the conversations of the user with their last message and unread count.
+// Both are fetched for all conversations at once, so the number of queries doesn't grow with the inbox.
+func (s *conversationService) GetConversations(uid string) ([]*domain.ConversationPreview, error) {
+	conversations, err := s.conversationRepository.GetConversationsByUserID(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(conversations))
+	for _, c := range conversations {
+		ids = append(ids, c.ID)
+	}
+	lastMessages, err := s.messageRepository.GetLastConversationMessages(ids)
+	if err != nil {
+		return nil, err
+	}
+	unreadCounts, err := s.messageRepository.CountUnreadConversationMessages(uid, ids)
+	if err != nil {
+		return nil, err
+	}
+	lastMessageByID := make(map[string]*domain.Message, len(lastMessages))
+	for _, m := range lastMessages {
+		lastMessageByID[m.ConversationID] = m
+	}
+
+	previews := make([]*domain.ConversationPreview, 0, len(conversations))
+	for _, c := range conversations {
+		previews = append(previews, &domain.ConversationPreview{
+			Conversation: c,
+			LastMessage:  lastMessageByID[c.ID],
+			UnreadCount:  unreadCounts[c.ID],
+		})
+	}
+	return previews, nil
+}
+
+func (s *conversationService) GetConversationMessages(uid string, conversationID string, before string, limit int) ([]*domain.Message, error) {
+	_, err := s.getConversation(uid, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > domain.MessagesMaxLimit {
+		limit = domain.MessagesDefaultLimit
+	}
+	return s.messageRepository.GetMessagesByConversationID(conversationID, before, limit)
+}
+
+func (s *conversationService) CreateConversationMessage(uid string, conversationID string, dto *domain.CreateMessageDTO) (*domain.Message, error) {
+	content := strings.TrimSpace(dto.Content)
+	if len(content) == 0 || len(content) > domain.MessageContentMaxLength {
+		return nil, domain.ErrInvalidMessageContent
+	}
+	c, err := s.getConversation(uid, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	// Existing conversations stay readable, but no new messages can be sent once one of the members blocked the other.
+	for _, cm := range c.Members {
+		if cm.UserID != uid {
+			err = s.checkNotBlocked(uid, cm.UserID)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	m := &domain.Message{
+		ID:             uuid.NewString(),
+		ConversationID: conversationID,
+		AuthorID:       uid,
+		Content:        content,
+		CreatedAt:      time.Now(),
+	}
+	err = s.messageRepository.CreateMessage(m)
+	if err != nil {
+		return nil, err
+	}
+	err = s.conversationRepository.UpdateLastMessageAt(conversationID, m.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+	err = s.readMarkerRepository.SaveReadMarker(&domain.ReadMarker{
+		ChatID:    conversationID,
+		UserID:    uid,
+		MessageID: m.ID,
+		ReadUntil: m.CreatedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, cm := range c.Members {
+		s.hub.Publish(domain.UserTopic(cm.UserID), &domain.RealtimeEvent{
+			Type: domain.RealtimeEventMessageCreated,
+			Data: m,
+		})
+	}
+	return m, nil
+}
+
+func (s *conversationService) GetConversationReadMarkers(uid string, conversationID string) ([]*domain.ReadMarker, error) {
+	_, err := s.getConversation(uid, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	return s.readMarkerRepository.GetReadMarkersByChatID(conversationID)
+}
+
+// MarkConversationRead marks the conversation as read up to its latest message. Read markers only ever move forward.
+func (s *conversationService) MarkConversationRead(uid string, conversationID string) (*domain.ReadMarker, error) {
+	c, err := s.getConversation(uid, conversationID)
+	if err != nil {
+		return nil, err
+	}
+	m, err := s.messageRepository.GetLastMessageByConversationID(conversationID)
+	if err != nil {
+		return nil, err
+	}
+	rm, err := s.readMarkerRepository.GetReadMarker(conversationID, uid)
+	if err != nil && err != fiber.ErrNotFound {
+		return nil, err
+	}
+	if rm != nil && !m.CreatedAt.After(rm.ReadUntil) {
+		return rm, nil
+	}
+
+	rm = &domain.ReadMarker{
+		ChatID:    conversationID,
+		UserID:    uid,
+		MessageID: m.ID,
+		ReadUntil: m.CreatedAt,
+	}
+	err = s.readMarkerRepository.SaveReadMarker(rm)
+	if err != nil {
+		return nil, err
+	}
+	for _, cm := range c.Members {
+		s.hub.Publish(domain.UserTopic(cm.UserID), &domain.RealtimeEvent{
+			Type: domain.RealtimeEventReadMarkerUpdated,
+			Data: rm,
+		})
+	}
+	return rm, nil
+}
+
+// getConversation returns the conversation if the user is one of its members.
+func (s *conversationService) getConversation(uid string, conversationID string) (*domain.Conversation, error) {
+	c, err := s.conversationRepository.GetConversationByID(conversationID)
+	if err != nil {
+		return nil, err
+	}
+	if member(c, uid) == nil {
+		return nil, domain.ErrNotConversationMember
+	}
+	return c, nil
+}
+
+// checkNotBlocked returns ErrUserBlocked if one of the users blocked the other.
+func (s *conversationService) checkNotBlocked(uid string, otherUserID string) error {
+	blocked, err := s.blockRepository.IsBlocked(uid, otherUserID)
+	if err != nil {
+		return err
+	}
+	if blocked {
+		return domain.ErrUserBlocked
+	}
+	return nil
+}
+
+func member(c *domain.Conversation, uid string) *domain.ConversationMember {
+	for _, m := range c.Members {
+		if m.UserID == uid {
+			return m
+		}
+	}
+	return nil
+}
@@ -0,0 +1,184 @@
+package conversation
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/UpMeetApp/server/pkg/domain/mock"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func Test_conversationService_StartConversation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockConversationRepository(ctrl)
+	userRepo := mock.NewMockUserRepository(ctrl)
+	blockRepo := mock.NewMockBlockRepository(ctrl)
+	s := NewConversationService(repo, mock.NewMockMessageRepository(ctrl), mock.NewMockReadMarkerRepository(ctrl), userRepo, blockRepo, mock.NewMockHub(ctrl))
+
+	uid := "1"
+	dto := &domain.StartConversationDTO{Username: "test"}
+
+	// User not found
+	userRepo.EXPECT().GetUserByUsername(gomock.Eq("test")).Return(nil, fiber.ErrNotFound)
+	c, err := s.StartConversation(uid, dto)
+	assert.ErrorIs(t, err, fiber.ErrNotFound)
+	assert.Nil(t, c)
+
+	// Conversation with self
+	userRepo.EXPECT().GetUserByUsername(gomock.Eq("test")).Return(&domain.User{ID: uid}, nil)
+	c, err = s.StartConversation(uid, dto)
+	assert.ErrorIs(t, err, domain.ErrCannotMessageSelf)
+	assert.Nil(t, c)
+
+	// Blocked user
+	userRepo.EXPECT().GetUserByUsername(gomock.Eq("test")).Return(&domain.User{ID: "2"}, nil)
+	blockRepo.EXPECT().IsBlocked(gomock.Eq(uid), gomock.Eq("2")).Return(true, nil)
+	c, err = s.StartConversation(uid, dto)
+	assert.ErrorIs(t, err, domain.ErrUserBlocked)
+	assert.Nil(t, c)
+
+	// Existing conversation is returned
+	existing := &domain.Conversation{ID: "c1"}
+	userRepo.EXPECT().GetUserByUsername(gomock.Eq("test")).Return(&domain.User{ID: "2"}, nil)
+	blockRepo.EXPECT().IsBlocked(gomock.Eq(uid), gomock.Eq("2")).Return(false, nil)
+	repo.EXPECT().GetConversationByKey(gomock.Eq(domain.ConversationKey("2", uid))).Return(existing, nil)
+	c, err = s.StartConversation(uid, dto)
+	assert.NoError(t, err)
+	assert.Equal(t, existing, c)
+
+	// New conversation is created
+	userRepo.EXPECT().GetUserByUsername(gomock.Eq("test")).Return(&domain.User{ID: "2"}, nil)
+	blockRepo.EXPECT().IsBlocked(gomock.Eq(uid), gomock.Eq("2")).Return(false, nil)
+	repo.EXPECT().GetConversationByKey(gomock.Eq(domain.ConversationKey(uid, "2"))).Return(nil, fiber.ErrNotFound)
+	repo.EXPECT().CreateConversation(gomock.Any()).Return(nil)
+	c, err = s.StartConversation(uid, dto)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, c.ID)
+	assert.Len(t, c.Members, 2)
+}
+
+func Test_conversationService_GetConversations(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockConversationRepository(ctrl)
+	messageRepo := mock.NewMockMessageRepository(ctrl)
+	readMarkerRepo := mock.NewMockReadMarkerRepository(ctrl)
+	s := NewConversationService(repo, messageRepo, readMarkerRepo, mock.NewMockUserRepository(ctrl), mock.NewMockBlockRepository(ctrl), mock.NewMockHub(ctrl))
+
+	uid := "1"
+	c1 := &domain.Conversation{ID: "c1", Members: []*domain.ConversationMember{{UserID: uid}, {UserID: "2"}}}
+	c2 := &domain.Conversation{ID: "c2", Members: []*domain.ConversationMember{{UserID: uid}, {UserID: "3"}}}
+	last := &domain.Message{ID: "m1", ConversationID: "c1"}
+
+	repo.EXPECT().GetConversationsByUserID(gomock.Eq(uid)).Return([]*domain.Conversation{c1, c2}, nil)
+	messageRepo.EXPECT().GetLastConversationMessages(gomock.Eq([]string{"c1", "c2"})).Return([]*domain.Message{last}, nil)
+	messageRepo.EXPECT().CountUnreadConversationMessages(gomock.Eq(uid), gomock.Eq([]string{"c1", "c2"})).Return(map[string]int64{"c1": 2}, nil)
+	p, err := s.GetConversations(uid)
+	assert.NoError(t, err)
+	assert.Len(t, p, 2)
+	assert.Equal(t, last, p[0].LastMessage)
+	assert.Equal(t, int64(2), p[0].UnreadCount)
+	assert.Nil(t, p[1].LastMessage)
+	assert.Zero(t, p[1].UnreadCount)
+}
+
+func Test_conversationService_MarkConversationRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockConversationRepository(ctrl)
+	messageRepo := mock.NewMockMessageRepository(ctrl)
+	readMarkerRepo := mock.NewMockReadMarkerRepository(ctrl)
+	hub := mock.NewMockHub(ctrl)
+	s := NewConversationService(repo, messageRepo, readMarkerRepo, mock.NewMockUserRepository(ctrl), mock.NewMockBlockRepository(ctrl), hub)
+
+	uid := "1"
+	c := &domain.Conversation{ID: "c1", Members: []*domain.ConversationMember{{UserID: uid}, {UserID: "2"}}}
+	last := &domain.Message{ID: "m2", ConversationID: "c1", CreatedAt: time.Now()}
+
+	// Not a member
+	repo.EXPECT().GetConversationByID(gomock.Eq("c1")).Return(&domain.Conversation{ID: "c1"}, nil)
+	rm, err := s.MarkConversationRead(uid, "c1")
+	assert.ErrorIs(t, err, domain.ErrNotConversationMember)
+	assert.Nil(t, rm)
+
+	// Already read
+	read := &domain.ReadMarker{ChatID: "c1", UserID: uid, MessageID: "m2", ReadUntil: last.CreatedAt}
+	repo.EXPECT().GetConversationByID(gomock.Eq("c1")).Return(c, nil)
+	messageRepo.EXPECT().GetLastMessageByConversationID(gomock.Eq("c1")).Return(last, nil)
+	readMarkerRepo.EXPECT().GetReadMarker(gomock.Eq("c1"), gomock.Eq(uid)).Return(read, nil)
+	rm, err = s.MarkConversationRead(uid, "c1")
+	assert.NoError(t, err)
+	assert.Equal(t, read, rm)
+
+	// MarkConversationRead successful and the members are notified
+	repo.EXPECT().GetConversationByID(gomock.Eq("c1")).Return(c, nil)
+	messageRepo.EXPECT().GetLastMessageByConversationID(gomock.Eq("c1")).Return(last, nil)
+	readMarkerRepo.EXPECT().GetReadMarker(gomock.Eq("c1"), gomock.Eq(uid)).Return(&domain.ReadMarker{ReadUntil: last.CreatedAt.Add(-time.Minute)}, nil)
+	readMarkerRepo.EXPECT().SaveReadMarker(gomock.Any()).Return(nil)
+	hub.EXPECT().Publish(gomock.Eq(domain.UserTopic(uid)), gomock.Any())
+	hub.EXPECT().Publish(gomock.Eq(domain.UserTopic("2")), gomock.Any())
+	rm, err = s.MarkConversationRead(uid, "c1")
+	assert.NoError(t, err)
+	assert.Equal(t, "m2", rm.MessageID)
+	assert.Equal(t, last.CreatedAt, rm.ReadUntil)
+}
+
+func Test_conversationService_GetConversationMessages(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockConversationRepository(ctrl)
+	messageRepo := mock.NewMockMessageRepository(ctrl)
+	s := NewConversationService(repo, messageRepo, mock.NewMockReadMarkerRepository(ctrl), mock.NewMockUserRepository(ctrl), mock.NewMockBlockRepository(ctrl), mock.NewMockHub(ctrl))
+
+	uid := "1"
+	c := &domain.Conversation{ID: "c1", Members: []*domain.ConversationMember{{UserID: uid}, {UserID: "2"}}}
+
+	// Not a member
+	repo.EXPECT().GetConversationByID(gomock.Eq("c1")).Return(&domain.Conversation{ID: "c1"}, nil)
+	m, err := s.GetConversationMessages(uid, "c1", "", 0)
+	assert.ErrorIs(t, err, domain.ErrNotConversationMember)
+	assert.Nil(t, m)
+
+	// GetConversationMessages successful
+	repo.EXPECT().GetConversationByID(gomock.Eq("c1")).Return(c, nil)
+	messageRepo.EXPECT().GetMessagesByConversationID(gomock.Eq("c1"), gomock.Eq("m1"), gomock.Eq(10)).Return([]*domain.Message{}, nil)
+	m, err = s.GetConversationMessages(uid, "c1", "m1", 10)
+	assert.NoError(t, err)
+	assert.NotNil(t, m)
+}
+
+func Test_conversationService_CreateConversationMessage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockConversationRepository(ctrl)
+	messageRepo := mock.NewMockMessageRepository(ctrl)
+	readMarkerRepo := mock.NewMockReadMarkerRepository(ctrl)
+	blockRepo := mock.NewMockBlockRepository(ctrl)
+	hub := mock.NewMockHub(ctrl)
+	s := NewConversationService(repo, messageRepo, readMarkerRepo, mock.NewMockUserRepository(ctrl), blockRepo, hub)
+
+	uid := "1"
+	c := &domain.Conversation{ID: "c1", Members: []*domain.ConversationMember{{UserID: uid}, {UserID: "2"}}}
+
+	// Content too long
+	m, err := s.CreateConversationMessage(uid, "c1", &domain.CreateMessageDTO{Content: string(make([]byte, domain.MessageContentMaxLength+1))})
+	assert.ErrorIs(t, err, domain.ErrInvalidMessageContent)
+	assert.Nil(t, m)
+
+	// Blocked member can't be messaged
+	repo.EXPECT().GetConversationByID(gomock.Eq("c1")).Return(c, nil)
+	blockRepo.EXPECT().IsBlocked(gomock.Eq(uid), gomock.Eq("2")).Return(true, nil)
+	m, err = s.CreateConversationMessage(uid, "c1", &domain.CreateMessageDTO{Content: "hi"})
+	assert.ErrorIs(t, err, domain.ErrUserBlocked)
+	assert.Nil(t, m)
+
+	// Message is delivered to both members
+	repo.EXPECT().GetConversationByID(gomock.Eq("c1")).Return(c, nil)
+	blockRepo.EXPECT().IsBlocked(gomock.Eq(uid), gomock.Eq("2")).Return(false, nil)
+	messageRepo.EXPECT().CreateMessage(gomock.Any()).Return(nil)
+	repo.EXPECT().UpdateLastMessageAt(gomock.Eq("c1"), gomock.Any()).Return(nil)
+	readMarkerRepo.EXPECT().SaveReadMarker(gomock.Any()).Return(nil)
+	hub.EXPECT().Publish(gomock.Eq(domain.UserTopic(uid)), gomock.Any())
+	hub.EXPECT().Publish(gomock.Eq(domain.UserTopic("2")), gomock.Any())
+	m, err = s.CreateConversationMessage(uid, "c1", &domain.CreateMessageDTO{Content: "hi"})
+	assert.NoError(t, err)
+	assert.Equal(t, "c1", m.ConversationID)
+}
@@ -0,0 +1,68 @@
+package device
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type deviceRepository struct {
+	db *gorm.DB
+}
+
+// NewDeviceRepository creates a new device repository instance.
+func NewDeviceRepository(db *gorm.DB) domain.DeviceRepository {
+	return &deviceRepository{
+		db: db,
+	}
+}
+
+func (r *deviceRepository) SaveDevice(d *domain.Device) error {
+	err := r.db.Save(d).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to save device", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *deviceRepository) GetDeviceByToken(token string) (*domain.Device, error) {
+	d := &domain.Device{}
+	err := r.db.Where("token = ?", token).First(d).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fiber.ErrNotFound
+		}
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get device by token", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return d, nil
+}
+
+func (r *deviceRepository) GetDevicesByUserID(userID string) ([]*domain.Device, error) {
+	var devices []*domain.Device
+	err := r.db.Where("user_id = ?", userID).Order("last_seen_at DESC").Find(&devices).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get devices by user id", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return devices, nil
+}
+
+func (r *deviceRepository) DeleteDevices(tokens ...string) error {
+	if len(tokens) == 0 {
+		return nil
+	}
+	err := r.db.Where("token IN ?", tokens).Delete(&domain.Device{}).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to delete devices", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package device
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+	"time"
+)
+
+type deviceService struct {
+	deviceRepository domain.DeviceRepository
+}
+
+// NewDeviceService creates a new device service instance.
+func NewDeviceService(deviceRepository domain.DeviceRepository) domain.DeviceService {
+	return &deviceService{
+		deviceRepository: deviceRepository,
+	}
+}
+
+func (s *deviceService) RegisterDevice(uid string, dto *domain.RegisterDeviceDTO) (*domain.Device, error) {
+	if len(dto.Token) == 0 || len(dto.Token) > domain.DeviceTokenMaxLength {
+		return nil, domain.ErrInvalidDeviceToken
+	}
+	switch dto.Platform {
+	case domain.DevicePlatformAndroid, domain.DevicePlatformIOS, domain.DevicePlatformWeb:
+	default:
+		return nil, domain.ErrInvalidDevicePlatform
+	}
+	if len(dto.AppVersion) > domain.DeviceAppVersionMaxLength {
+		return nil, domain.ErrInvalidAppVersion
+	}
+
+	now := time.Now()
+	d, err := s.deviceRepository.GetDeviceByToken(dto.Token)
+	if err != nil {
+		if err != fiber.ErrNotFound {
+			return nil, err
+		}
+		d = &domain.Device{
+			Token:     dto.Token,
+			CreatedAt: now,
+		}
+	}
+
+	// Registering a known token again refreshes it, a different user signed in on the device takes it over.
+	d.UserID = uid
+	d.Platform = dto.Platform
+	d.AppVersion = dto.AppVersion
+	d.LastSeenAt = now
+	err = s.deviceRepository.SaveDevice(d)
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (s *deviceService) GetDevices(uid string) ([]*domain.Device, error) {
+	return s.deviceRepository.GetDevicesByUserID(uid)
+}
+
+func (s *deviceService) UnregisterDevice(uid string, token string) error {
+	d, err := s.deviceRepository.GetDeviceByToken(token)
+	if err != nil {
+		return err
+	}
+	if d.UserID != uid {
+		return fiber.ErrNotFound
+	}
+	return s.deviceRepository.DeleteDevices(token)
+}
@@ -0,0 +1,73 @@
+package device
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/UpMeetApp/server/pkg/domain/mock"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_deviceService_RegisterDevice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockDeviceRepository(ctrl)
+	s := NewDeviceService(repo)
+
+	uid := "1"
+
+	// Invalid token
+	d, err := s.RegisterDevice(uid, &domain.RegisterDeviceDTO{Token: "", Platform: domain.DevicePlatformAndroid})
+	assert.ErrorIs(t, err, domain.ErrInvalidDeviceToken)
+	assert.Nil(t, d)
+
+	// Invalid platform
+	d, err = s.RegisterDevice(uid, &domain.RegisterDeviceDTO{Token: "t1", Platform: "windows"})
+	assert.ErrorIs(t, err, domain.ErrInvalidDevicePlatform)
+	assert.Nil(t, d)
+
+	// Invalid app version
+	d, err = s.RegisterDevice(uid, &domain.RegisterDeviceDTO{Token: "t1", Platform: domain.DevicePlatformIOS, AppVersion: strings.Repeat("1", domain.DeviceAppVersionMaxLength+1)})
+	assert.ErrorIs(t, err, domain.ErrInvalidAppVersion)
+	assert.Nil(t, d)
+
+	// New device
+	repo.EXPECT().GetDeviceByToken(gomock.Eq("t1")).Return(nil, fiber.ErrNotFound)
+	repo.EXPECT().SaveDevice(gomock.Any()).Return(nil)
+	d, err = s.RegisterDevice(uid, &domain.RegisterDeviceDTO{Token: "t1", Platform: domain.DevicePlatformIOS, AppVersion: "1.2.0"})
+	assert.NoError(t, err)
+	assert.Equal(t, uid, d.UserID)
+	assert.Equal(t, "1.2.0", d.AppVersion)
+	assert.False(t, d.CreatedAt.IsZero())
+
+	// Known device taken over by another user
+	createdAt := time.Now().Add(-time.Hour)
+	repo.EXPECT().GetDeviceByToken(gomock.Eq("t1")).Return(&domain.Device{Token: "t1", UserID: "2", CreatedAt: createdAt, LastSeenAt: createdAt}, nil)
+	repo.EXPECT().SaveDevice(gomock.Any()).Return(nil)
+	d, err = s.RegisterDevice(uid, &domain.RegisterDeviceDTO{Token: "t1", Platform: domain.DevicePlatformAndroid})
+	assert.NoError(t, err)
+	assert.Equal(t, uid, d.UserID)
+	assert.Equal(t, createdAt, d.CreatedAt)
+	assert.True(t, d.LastSeenAt.After(createdAt))
+}
+
+func Test_deviceService_UnregisterDevice(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockDeviceRepository(ctrl)
+	s := NewDeviceService(repo)
+
+	uid := "1"
+
+	// Device of another user
+	repo.EXPECT().GetDeviceByToken(gomock.Eq("t1")).Return(&domain.Device{Token: "t1", UserID: "2"}, nil)
+	err := s.UnregisterDevice(uid, "t1")
+	assert.ErrorIs(t, err, fiber.ErrNotFound)
+
+	// UnregisterDevice successful
+	repo.EXPECT().GetDeviceByToken(gomock.Eq("t1")).Return(&domain.Device{Token: "t1", UserID: uid}, nil)
+	repo.EXPECT().DeleteDevices(gomock.Eq("t1")).Return(nil)
+	err = s.UnregisterDevice(uid, "t1")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,108 @@
+package domain
+
+import "time"
+
+// UserAction is an action of a user that is limited by the abuse checks. Only allowed actions are recorded.
+type UserAction struct {
+	ID        string    `gorm:"primaryKey"`
+	UserID    string    `gorm:"index:idx_user_action_user_action_created"`
+	Action    string    `gorm:"index:idx_user_action_user_action_created"`
+	CreatedAt time.Time `gorm:"index:idx_user_action_user_action_created"`
+}
+
+// AbuseSignal records a flagged or blocked action of a user for moderators.
+type AbuseSignal struct {
+	ID        string    `json:"id" gorm:"primaryKey"`
+	UserID    string    `json:"user_id" gorm:"index"`
+	Action    string    `json:"action"`
+	Score     int       `json:"score"`
+	Decision  string    `json:"decision"`
+	Reasons   string    `json:"reasons"`
+	CreatedAt time.Time `json:"created_at" gorm:"index"`
+}
+
+const (
+	// AbuseActionMeetupCreate is the creation of a meetup.
+	AbuseActionMeetupCreate = "meetup.create"
+	// AbuseActionMeetupJoin is joining a meetup.
+	AbuseActionMeetupJoin = "meetup.join"
+	// AbuseActionInvitationCreate is inviting a user to a meetup.
+	AbuseActionInvitationCreate = "invitation.create"
+)
+
+const (
+	// AbuseDecisionAllow lets the action through without a record.
+	AbuseDecisionAllow = "allow"
+	// AbuseDecisionFlag lets the action through, but records it for moderators.
+	AbuseDecisionFlag = "flag"
+	// AbuseDecisionBlock rejects the action and records it for moderators.
+	AbuseDecisionBlock = "block"
+)
+
+const (
+	// UserActionRetention is how long user actions are kept for the abuse checks, it has to cover the longest quota window.
+	UserActionRetention = 48 * time.Hour
+	// UserActionCleanupInterval is the interval in which expired user actions are deleted.
+	UserActionCleanupInterval = time.Hour
+	// AbuseSignalsDefaultLimit is the default number of abuse signals returned per page.
+	AbuseSignalsDefaultLimit = 50
+	// AbuseSignalsMaxLimit is the maximum number of abuse signals returned per page.
+	AbuseSignalsMaxLimit = 100
+)
+
+// AbuseLimits configures the abuse checks. Accounts younger than NewAccountAge get the lower *New limits.
+// Meetup creation is limited per day, invitations and joins per hour. More than BurstLimit actions of a kind within a minute are flagged.
+// An action is flagged from a total score of FlagScore and blocked from BlockScore.
+type AbuseLimits struct {
+	NewAccountAge         time.Duration
+	MeetupsPerDay         int
+	MeetupsPerDayNew      int
+	InvitationsPerHour    int
+	InvitationsPerHourNew int
+	JoinsPerHour          int
+	JoinsPerHourNew       int
+	BurstLimit            int
+	FlagScore             int
+	BlockScore            int
+}
+
+// AbuseCheck is an action of a user to be scored by the abuse checks.
+// The counts are the numbers of allowed actions of the same kind by the user in the last minute, hour and day.
+type AbuseCheck struct {
+	UserID      string
+	Action      string
+	AccountAge  time.Duration
+	MinuteCount int64
+	HourCount   int64
+	DayCount    int64
+}
+
+// AbuseScorer scores an action of a user, the scores of all scorers are added up.
+// The reason explains a non-zero score to moderators.
+type AbuseScorer func(c *AbuseCheck) (score int, reason string)
+
+// AbuseSignalFilter filters the abuse signals. Empty fields don't filter.
+type AbuseSignalFilter struct {
+	UserID   string
+	Decision string
+	Before   string
+	Limit    int
+}
+
+type AbuseService interface {
+	RegisterScorer(scorer AbuseScorer)
+	Check(uid string, action string) error
+	GetAbuseSignals(filter *AbuseSignalFilter) ([]*AbuseSignal, error)
+	Start()
+}
+
+type UserActionRepository interface {
+	CreateUserAction(a *UserAction) error
+	CountUserActions(userID string, action string, since time.Time) (int64, error)
+	DeleteUserActionsBefore(before time.Time) error
+}
+
+type AbuseSignalRepository interface {
+	CreateAbuseSignal(s *AbuseSignal) error
+	GetAbuseSignals(filter *AbuseSignalFilter) ([]*AbuseSignal, error)
+}
@@ -0,0 +1,38 @@
+package domain
+
+import "time"
+
+// Attendance records whether a participant actually showed up to a meetup. It is marked by the meetup host.
+type Attendance struct {
+	MeetupID  string    `json:"meetup_id" gorm:"primaryKey"`
+	UserID    string    `json:"user_id" gorm:"primaryKey;index"`
+	Attended  bool      `json:"attended"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// AttendanceStats summarizes the attendance history of a user.
+// Only meetups where the host took attendance are counted, participants the host didn't mark count as absent.
+type AttendanceStats struct {
+	Joined      int64   `json:"joined"`
+	Attended    int64   `json:"attended"`
+	Reliability float64 `json:"reliability"`
+}
+
+// MarkAttendanceDTO is the data transfer object for marking the attendance of meetup participants.
+// Attendance maps user ids to whether the user attended the meetup.
+type MarkAttendanceDTO struct {
+	Attendance map[string]bool `json:"attendance"`
+}
+
+type AttendanceService interface {
+	GetMeetupAttendance(uid string, meetupID string) ([]*Attendance, error)
+	MarkAttendance(uid string, meetupID string, dto *MarkAttendanceDTO) ([]*Attendance, error)
+	GetAttendanceStats(userID string) (*AttendanceStats, error)
+}
+
+type AttendanceRepository interface {
+	SaveAttendance(a *Attendance) error
+	GetAttendance(meetupID string, userID string) (*Attendance, error)
+	GetAttendanceByMeetupID(meetupID string) ([]*Attendance, error)
+	GetAttendanceStatsByUserID(userID string) (*AttendanceStats, error)
+}
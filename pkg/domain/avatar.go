@@ -0,0 +1,45 @@
+package domain
+
+// Avatar is the profile picture of a user, stored in its full size and as thumbnails.
+// The URLs are stable, a new avatar is stored under new URLs and the files of the old one are deleted.
+type Avatar struct {
+	URL        string            `json:"url"`
+	Thumbnails map[string]string `json:"thumbnails"`
+}
+
+const (
+	// AvatarMaxSize is the maximum size of an uploaded avatar image in bytes.
+	AvatarMaxSize = 10 << 20
+	// AvatarMinDimension is the minimum width and height of an avatar image in pixels.
+	AvatarMinDimension = 64
+	// AvatarMaxDimension is the maximum width and height of an avatar image in pixels, larger images aren't decoded at all.
+	AvatarMaxDimension = 8192
+	// AvatarMaxPixels is the maximum number of pixels of an avatar image, which bounds the memory needed to decode it.
+	AvatarMaxPixels = 25_000_000
+	// AvatarSize is the width and height of the stored avatar, smaller images keep their size.
+	AvatarSize = 1024
+	// AvatarContentType is the content type of the stored avatar and its thumbnails.
+	AvatarContentType = "image/jpeg"
+)
+
+// AvatarTypes contains the content types of accepted avatar images.
+var AvatarTypes = []string{
+	"image/jpeg",
+	"image/png",
+	"image/webp",
+}
+
+// AvatarThumbnailSizes contains the width and height of the thumbnails generated for every avatar.
+var AvatarThumbnailSizes = []int{256, 64}
+
+// FileStorage stores public files like avatars under a key and serves them under a URL derived from the key.
+type FileStorage interface {
+	Put(key string, contentType string, data []byte) error
+	Delete(key string) error
+	URL(key string) string
+}
+
+type AvatarService interface {
+	UpdateAvatar(uid string, data []byte) (*Avatar, error)
+	DeleteAvatarFiles(uid string, avatarID string) error
+}
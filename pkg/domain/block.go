@@ -0,0 +1,39 @@
+package domain
+
+import "time"
+
+// Block is a user blocking another user.
+// Blocking works both ways, neither of the two users can find, view or contact the other one.
+type Block struct {
+	UserID        string    `json:"user_id" gorm:"primaryKey"`
+	BlockedUserID string    `json:"blocked_user_id" gorm:"primaryKey;index"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// UserPreview is the public summary of a user shown in lists.
+type UserPreview struct {
+	ID             string `json:"id"`
+	Username       string `json:"username"`
+	Name           string `json:"name"`
+	ProfilePicture string `json:"profile_picture"`
+}
+
+// BlockedUser is a user on the block list of another user.
+type BlockedUser struct {
+	UserPreview
+	BlockedAt time.Time `json:"blocked_at"`
+}
+
+type BlockService interface {
+	GetBlockedUsers(uid string) ([]*BlockedUser, error)
+	BlockUser(uid string, username string) error
+	UnblockUser(uid string, username string) error
+}
+
+type BlockRepository interface {
+	CreateBlock(b *Block) error
+	DeleteBlock(userID string, blockedUserID string) error
+	GetBlockedUsers(userID string) ([]*BlockedUser, error)
+	IsBlocked(userID string, otherUserID string) (bool, error)
+	GetBlockedUserIDs(userID string) ([]string, error)
+}
@@ -0,0 +1,61 @@
+package domain
+
+import "time"
+
+// Conversation is a direct conversation between two users.
+type Conversation struct {
+	ID            string                `json:"id" gorm:"primaryKey"`
+	Key           string                `json:"-" gorm:"uniqueIndex"`
+	Members       []*ConversationMember `json:"members" gorm:"foreignKey:ConversationID"`
+	LastMessageAt time.Time             `json:"last_message_at" gorm:"index"`
+	CreatedAt     time.Time             `json:"created_at"`
+}
+
+// ConversationMember is a member of a conversation.
+type ConversationMember struct {
+	ConversationID string `json:"-" gorm:"primaryKey"`
+	UserID         string `json:"user_id" gorm:"primaryKey;index"`
+}
+
+// ConversationPreview is a conversation as shown in the conversation list of a user.
+type ConversationPreview struct {
+	*Conversation
+	LastMessage *Message `json:"last_message,omitempty"`
+	UnreadCount int64    `json:"unread_count"`
+}
+
+// ConversationKey returns the key identifying the conversation between two users, regardless of who started it.
+func ConversationKey(uid1 string, uid2 string) string {
+	if uid1 > uid2 {
+		uid1, uid2 = uid2, uid1
+	}
+	return uid1 + ":" + uid2
+}
+
+// StartConversationDTO is the data transfer object for starting a conversation.
+type StartConversationDTO struct {
+	Username string `json:"username"`
+}
+
+// CreateConversationMessageDTO is the data transfer object for creating a message in a conversation over the real-time channel.
+type CreateConversationMessageDTO struct {
+	ConversationID string `json:"conversation_id"`
+	CreateMessageDTO
+}
+
+type ConversationService interface {
+	StartConversation(uid string, dto *StartConversationDTO) (*Conversation, error)
+	GetConversations(uid string) ([]*ConversationPreview, error)
+	GetConversationMessages(uid string, conversationID string, before string, limit int) ([]*Message, error)
+	CreateConversationMessage(uid string, conversationID string, dto *CreateMessageDTO) (*Message, error)
+	GetConversationReadMarkers(uid string, conversationID string) ([]*ReadMarker, error)
+	MarkConversationRead(uid string, conversationID string) (*ReadMarker, error)
+}
+
+type ConversationRepository interface {
+	CreateConversation(c *Conversation) error
+	GetConversationByID(id string) (*Conversation, error)
+	GetConversationByKey(key string) (*Conversation, error)
+	GetConversationsByUserID(userID string) ([]*Conversation, error)
+	UpdateLastMessageAt(id string, t time.Time) error
+}
@@ -0,0 +1,63 @@
+package domain
+
+import "time"
+
+// Device is a device of a user that receives push notifications.
+// The push token is unique, a token registered again by another user moves to that user.
+type Device struct {
+	Token      string    `json:"token" gorm:"primaryKey"`
+	UserID     string    `json:"user_id" gorm:"index"`
+	Platform   string    `json:"platform"`
+	AppVersion string    `json:"app_version"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+const (
+	// DevicePlatformAndroid is the platform of Android devices.
+	DevicePlatformAndroid = "android"
+	// DevicePlatformIOS is the platform of iOS devices.
+	DevicePlatformIOS = "ios"
+	// DevicePlatformWeb is the platform of browsers using web push.
+	DevicePlatformWeb = "web"
+)
+
+const (
+	// DeviceTokenMaxLength is the maximum length of a devices' push token.
+	DeviceTokenMaxLength = 4096
+	// DeviceAppVersionMaxLength is the maximum length of a devices' app version.
+	DeviceAppVersionMaxLength = 32
+)
+
+// RegisterDeviceDTO is the data transfer object for registering a device for push notifications.
+type RegisterDeviceDTO struct {
+	Token      string `json:"token"`
+	Platform   string `json:"platform"`
+	AppVersion string `json:"app_version"`
+}
+
+// PushMessage is a push notification sent to the devices of a user.
+type PushMessage struct {
+	Title string
+	Body  string
+	Data  map[string]string
+}
+
+// PushSender delivers push messages to devices.
+// It returns the tokens the push provider reported as invalid, they should not be used anymore.
+type PushSender interface {
+	Send(tokens []string, msg *PushMessage) (invalidTokens []string, err error)
+}
+
+type DeviceService interface {
+	RegisterDevice(uid string, dto *RegisterDeviceDTO) (*Device, error)
+	GetDevices(uid string) ([]*Device, error)
+	UnregisterDevice(uid string, token string) error
+}
+
+type DeviceRepository interface {
+	SaveDevice(d *Device) error
+	GetDeviceByToken(token string) (*Device, error)
+	GetDevicesByUserID(userID string) ([]*Device, error)
+	DeleteDevices(tokens ...string) error
+}
@@ -0,0 +1,36 @@
+package domain
+
+// Email is a rendered email with a plain text and an HTML body.
+type Email struct {
+	To      string
+	Subject string
+	Text    string
+	HTML    string
+}
+
+// EmailData is the data email templates are rendered with.
+// URL is a link the email asks its recipient to open.
+type EmailData struct {
+	User    *User
+	Meetup  *Meetup
+	Meetups []*Meetup
+	URL     string
+}
+
+const (
+	// EmailTemplateMeetupReminder reminds a participant of an upcoming meetup.
+	EmailTemplateMeetupReminder = "meetup_reminder"
+	// EmailTemplateMeetupCancelled informs a participant that a meetup was cancelled.
+	EmailTemplateMeetupCancelled = "meetup_cancelled"
+	// EmailTemplateInvitation informs a user that they were invited to a meetup.
+	EmailTemplateInvitation = "invitation"
+	// EmailTemplateWeeklyDigest lists the upcoming meetups of a user.
+	EmailTemplateWeeklyDigest = "weekly_digest"
+	// EmailTemplateEmailVerification asks a user to verify their new email address.
+	EmailTemplateEmailVerification = "email_verification"
+)
+
+// EmailSender delivers emails.
+type EmailSender interface {
+	Send(e *Email) error
+}
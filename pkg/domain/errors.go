@@ -13,6 +13,202 @@ var (
 	ErrUsernameTaken = fiber.NewError(fiber.StatusBadRequest, "username-taken")
 	// ErrInvalidBio is returned when the provided bio is invalid (too long).
 	ErrInvalidBio = fiber.NewError(fiber.StatusBadRequest, "invalid-bio")
-	// ErrInvalidAge is returned when the provided age is invalid (too high).
-	ErrInvalidAge = fiber.NewError(fiber.StatusBadRequest, "invalid-age")
+	// ErrInvalidEmail is returned when the provided email address is invalid.
+	ErrInvalidEmail = fiber.NewError(fiber.StatusBadRequest, "invalid-email")
+	// ErrEmailTaken is returned when the email address is already used by another user.
+	ErrEmailTaken = fiber.NewError(fiber.StatusBadRequest, "email-taken")
+	// ErrInvalidEmailVerificationToken is returned when the email verification token is unknown, expired or belongs to another user.
+	ErrInvalidEmailVerificationToken = fiber.NewError(fiber.StatusBadRequest, "invalid-email-verification-token")
+)
+
+var (
+	// ErrInvalidMeetupName is returned when the provided meetup name is invalid (too short or too long).
+	ErrInvalidMeetupName = fiber.NewError(fiber.StatusBadRequest, "invalid-meetup-name")
+	// ErrInvalidMeetupDescription is returned when the provided meetup description is invalid (too long).
+	ErrInvalidMeetupDescription = fiber.NewError(fiber.StatusBadRequest, "invalid-meetup-description")
+	// ErrInvalidMinAge is returned when the provided minimum age of a meetup is invalid.
+	ErrInvalidMinAge = fiber.NewError(fiber.StatusBadRequest, "invalid-min-age")
+	// ErrInvalidMeetupTime is returned when the provided meetup start or end time is invalid (missing or ending before it starts).
+	ErrInvalidMeetupTime = fiber.NewError(fiber.StatusBadRequest, "invalid-meetup-time")
+	// ErrNotMeetupOwner is returned when a user tries to perform an action only the meetup owner is allowed to.
+	ErrNotMeetupOwner = fiber.NewError(fiber.StatusForbidden, "not-meetup-owner")
+	// ErrMeetupInviteOnly is returned when a user tries to join an invite only meetup without an invitation.
+	ErrMeetupInviteOnly = fiber.NewError(fiber.StatusForbidden, "meetup-invite-only")
+	// ErrMeetupAgeRestricted is returned when a user does not meet the minimum age of a meetup.
+	ErrMeetupAgeRestricted = fiber.NewError(fiber.StatusForbidden, "meetup-age-restricted")
+	// ErrAlreadyParticipant is returned when the user already participates in the meetup.
+	ErrAlreadyParticipant = fiber.NewError(fiber.StatusBadRequest, "already-participant")
+	// ErrNotParticipant is returned when the user does not participate in the meetup.
+	ErrNotParticipant = fiber.NewError(fiber.StatusBadRequest, "not-participant")
+	// ErrOwnerCannotLeave is returned when the meetup owner tries to leave their own meetup.
+	ErrOwnerCannotLeave = fiber.NewError(fiber.StatusBadRequest, "owner-cannot-leave")
+)
+
+var (
+	// ErrInvalidRating is returned when the provided rating is out of range.
+	ErrInvalidRating = fiber.NewError(fiber.StatusBadRequest, "invalid-rating")
+	// ErrInvalidReviewText is returned when the provided review text is invalid (too long).
+	ErrInvalidReviewText = fiber.NewError(fiber.StatusBadRequest, "invalid-review-text")
+	// ErrMeetupNotEnded is returned when a user tries to review a meetup that has not ended yet.
+	ErrMeetupNotEnded = fiber.NewError(fiber.StatusBadRequest, "meetup-not-ended")
+	// ErrMeetupNotStarted is returned when a host tries to mark the attendance of a meetup that has not started yet.
+	ErrMeetupNotStarted = fiber.NewError(fiber.StatusBadRequest, "meetup-not-started")
+	// ErrReviewNotAllowed is returned when the user is not a confirmed participant of the meetup.
+	ErrReviewNotAllowed = fiber.NewError(fiber.StatusForbidden, "review-not-allowed")
+	// ErrAlreadyReviewed is returned when the user already reviewed the meetup.
+	ErrAlreadyReviewed = fiber.NewError(fiber.StatusBadRequest, "already-reviewed")
+)
+
+var (
+	// ErrInvalidMessageContent is returned when the provided message content is invalid (empty or too long).
+	ErrInvalidMessageContent = fiber.NewError(fiber.StatusBadRequest, "invalid-message-content")
+	// ErrUnknownCommand is returned when a client sends an unknown real-time command.
+	ErrUnknownCommand = fiber.NewError(fiber.StatusBadRequest, "unknown-command")
+)
+
+var (
+	// ErrCannotMessageSelf is returned when a user tries to start a conversation with themselves.
+	ErrCannotMessageSelf = fiber.NewError(fiber.StatusBadRequest, "cannot-message-self")
+	// ErrNotConversationMember is returned when the user is not a member of the conversation.
+	ErrNotConversationMember = fiber.NewError(fiber.StatusForbidden, "not-conversation-member")
+)
+
+var (
+	// ErrNotMessageAuthor is returned when a user tries to change a message they did not send.
+	ErrNotMessageAuthor = fiber.NewError(fiber.StatusForbidden, "not-message-author")
+	// ErrMessageEditWindowExpired is returned when a message is edited after the edit window passed.
+	ErrMessageEditWindowExpired = fiber.NewError(fiber.StatusBadRequest, "message-edit-window-expired")
+	// ErrMessageDeleted is returned when a user tries to interact with a deleted message.
+	ErrMessageDeleted = fiber.NewError(fiber.StatusBadRequest, "message-deleted")
+	// ErrInvalidEmoji is returned when the provided reaction emoji is invalid (empty, too long or containing whitespace).
+	ErrInvalidEmoji = fiber.NewError(fiber.StatusBadRequest, "invalid-emoji")
+)
+
+var (
+	// ErrAlreadyInvited is returned when the user was already invited to the meetup.
+	ErrAlreadyInvited = fiber.NewError(fiber.StatusBadRequest, "already-invited")
+)
+
+var (
+	// ErrNotNotificationRecipient is returned when a user tries to access a notification addressed to someone else.
+	ErrNotNotificationRecipient = fiber.NewError(fiber.StatusForbidden, "not-notification-recipient")
+	// ErrInvalidTimezone is returned when the provided timezone is not a known IANA timezone.
+	ErrInvalidTimezone = fiber.NewError(fiber.StatusBadRequest, "invalid-timezone")
+	// ErrInvalidQuietHours is returned when the provided quiet hours are invalid (not formatted as HH:MM or empty).
+	ErrInvalidQuietHours = fiber.NewError(fiber.StatusBadRequest, "invalid-quiet-hours")
+	// ErrInvalidNotificationType is returned when a preference is set for an unknown notification type.
+	ErrInvalidNotificationType = fiber.NewError(fiber.StatusBadRequest, "invalid-notification-type")
+)
+
+var (
+	// ErrInvalidDeviceToken is returned when the provided push token is invalid (empty or too long).
+	ErrInvalidDeviceToken = fiber.NewError(fiber.StatusBadRequest, "invalid-device-token")
+	// ErrInvalidDevicePlatform is returned when the provided device platform is unknown.
+	ErrInvalidDevicePlatform = fiber.NewError(fiber.StatusBadRequest, "invalid-device-platform")
+	// ErrInvalidAppVersion is returned when the provided app version is invalid (too long).
+	ErrInvalidAppVersion = fiber.NewError(fiber.StatusBadRequest, "invalid-app-version")
+)
+
+var (
+	// ErrInvalidWebhookURL is returned when the provided webhook URL is invalid (not an absolute http(s) URL, too long or not resolving to public addresses).
+	ErrInvalidWebhookURL = fiber.NewError(fiber.StatusBadRequest, "invalid-webhook-url")
+	// ErrInvalidWebhookEvents is returned when the provided webhook event filter is empty or contains unknown event types.
+	ErrInvalidWebhookEvents = fiber.NewError(fiber.StatusBadRequest, "invalid-webhook-events")
+	// ErrTooManyWebhooks is returned when the meetup already has the maximum number of webhooks.
+	ErrTooManyWebhooks = fiber.NewError(fiber.StatusBadRequest, "too-many-webhooks")
+)
+
+var (
+	// ErrCannotBlockSelf is returned when a user tries to block themselves.
+	ErrCannotBlockSelf = fiber.NewError(fiber.StatusBadRequest, "cannot-block-self")
+	// ErrUserBlocked is returned when a user tries to contact a user who blocked them or whom they blocked.
+	ErrUserBlocked = fiber.NewError(fiber.StatusForbidden, "user-blocked")
+	// ErrInvalidSearchQuery is returned when the provided search query is too short.
+	ErrInvalidSearchQuery = fiber.NewError(fiber.StatusBadRequest, "invalid-search-query")
+)
+
+var (
+	// ErrInvalidReportTarget is returned when the reported target type is unknown.
+	ErrInvalidReportTarget = fiber.NewError(fiber.StatusBadRequest, "invalid-report-target")
+	// ErrInvalidReportReason is returned when the report reason is unknown.
+	ErrInvalidReportReason = fiber.NewError(fiber.StatusBadRequest, "invalid-report-reason")
+	// ErrInvalidReportDetails is returned when the report details are too long or missing for the reason other.
+	ErrInvalidReportDetails = fiber.NewError(fiber.StatusBadRequest, "invalid-report-details")
+	// ErrCannotReportSelf is returned when a user tries to report themselves or their own content.
+	ErrCannotReportSelf = fiber.NewError(fiber.StatusBadRequest, "cannot-report-self")
+	// ErrAlreadyReported is returned when the user already has an open report of the target.
+	ErrAlreadyReported = fiber.NewError(fiber.StatusBadRequest, "already-reported")
+	// ErrInvalidModerationAction is returned when the resolution action is unknown or can't be applied to the reported target.
+	ErrInvalidModerationAction = fiber.NewError(fiber.StatusBadRequest, "invalid-moderation-action")
+	// ErrInvalidModerationNote is returned when the moderators' note is too long.
+	ErrInvalidModerationNote = fiber.NewError(fiber.StatusBadRequest, "invalid-moderation-note")
+	// ErrInvalidSuspensionDuration is returned when the suspension length is negative or too long.
+	ErrInvalidSuspensionDuration = fiber.NewError(fiber.StatusBadRequest, "invalid-suspension-duration")
+	// ErrReportResolved is returned when a moderator tries to change a report that is already resolved.
+	ErrReportResolved = fiber.NewError(fiber.StatusBadRequest, "report-resolved")
+)
+
+var (
+	// ErrInvalidUserStatus is returned when the provided user status is unknown.
+	ErrInvalidUserStatus = fiber.NewError(fiber.StatusBadRequest, "invalid-user-status")
+	// ErrInvalidStatusReason is returned when the reason of a suspension or ban is missing or too long.
+	ErrInvalidStatusReason = fiber.NewError(fiber.StatusBadRequest, "invalid-status-reason")
+)
+
+var (
+	// ErrInappropriateContent is returned when a username, name, bio, meetup text or review contains a blocked word.
+	ErrInappropriateContent = fiber.NewError(fiber.StatusBadRequest, "inappropriate-content")
+	// ErrReservedUsername is returned when the provided username is reserved, e.g. admin or support.
+	ErrReservedUsername = fiber.NewError(fiber.StatusBadRequest, "reserved-username")
+)
+
+var (
+	// ErrActionLimitExceeded is returned when an action of a user is blocked by the abuse checks, e.g. because of too many meetups created in a day.
+	ErrActionLimitExceeded = fiber.NewError(fiber.StatusTooManyRequests, "action-limit-exceeded")
+)
+
+var (
+	// ErrNotAppealable is returned when the moderation action can't be appealed, e.g. because it was a warning.
+	ErrNotAppealable = fiber.NewError(fiber.StatusBadRequest, "not-appealable")
+	// ErrInvalidAppealText is returned when the text of an appeal is missing or too long.
+	ErrInvalidAppealText = fiber.NewError(fiber.StatusBadRequest, "invalid-appeal-text")
+	// ErrAppealWindowClosed is returned when the moderation action is older than the appeal window.
+	ErrAppealWindowClosed = fiber.NewError(fiber.StatusBadRequest, "appeal-window-closed")
+	// ErrAlreadyAppealed is returned when the moderation action was already appealed.
+	ErrAlreadyAppealed = fiber.NewError(fiber.StatusBadRequest, "already-appealed")
+	// ErrInvalidAppealStatus is returned when an appeal is resolved with a status other than upheld or overturned.
+	ErrInvalidAppealStatus = fiber.NewError(fiber.StatusBadRequest, "invalid-appeal-status")
+	// ErrAppealResolved is returned when a moderator tries to resolve an appeal that is already resolved.
+	ErrAppealResolved = fiber.NewError(fiber.StatusBadRequest, "appeal-resolved")
+)
+
+var (
+	// ErrRateLimited is returned when a client sent more requests than the budget of the route allows.
+	ErrRateLimited = fiber.NewError(fiber.StatusTooManyRequests, "rate-limited")
+)
+
+var (
+	// ErrInvalidBirthdate is returned when the date of birth can't be parsed, lies in the future or is older than UserMaxAge.
+	ErrInvalidBirthdate = fiber.NewError(fiber.StatusBadRequest, "invalid-birthdate")
+	// ErrInvalidDocument is returned when the document image is missing, too large or not a JPEG, PNG or WebP image.
+	ErrInvalidDocument = fiber.NewError(fiber.StatusBadRequest, "invalid-document")
+	// ErrAgeVerificationPending is returned when the user already has an age verification waiting for review.
+	ErrAgeVerificationPending = fiber.NewError(fiber.StatusBadRequest, "age-verification-pending")
+	// ErrAgeAlreadyVerified is returned when the user requests an age verification or changes their birthdate although their age is verified.
+	ErrAgeAlreadyVerified = fiber.NewError(fiber.StatusBadRequest, "age-already-verified")
+	// ErrInvalidAgeVerificationStatus is returned when an age verification is reviewed with a status other than approved or rejected.
+	ErrInvalidAgeVerificationStatus = fiber.NewError(fiber.StatusBadRequest, "invalid-age-verification-status")
+	// ErrAgeVerificationReviewed is returned when an admin tries to review an age verification that was already reviewed.
+	ErrAgeVerificationReviewed = fiber.NewError(fiber.StatusBadRequest, "age-verification-reviewed")
+	// ErrAgeVerificationRequired is returned when the meetup requires a verified age and the user's age isn't verified.
+	ErrAgeVerificationRequired = fiber.NewError(fiber.StatusForbidden, "age-verification-required")
+)
+
+var (
+	// ErrInvalidAvatar is returned when the avatar is missing or not a JPEG, PNG or WebP image that can be decoded.
+	ErrInvalidAvatar = fiber.NewError(fiber.StatusBadRequest, "invalid-avatar")
+	// ErrAvatarTooLarge is returned when the avatar image is larger than AvatarMaxSize.
+	ErrAvatarTooLarge = fiber.NewError(fiber.StatusRequestEntityTooLarge, "avatar-too-large")
+	// ErrInvalidAvatarDimensions is returned when the width or height of the avatar image is below AvatarMinDimension or above AvatarMaxDimension, or it has more than AvatarMaxPixels.
+	ErrInvalidAvatarDimensions = fiber.NewError(fiber.StatusBadRequest, "invalid-avatar-dimensions")
 )
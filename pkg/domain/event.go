@@ -0,0 +1,84 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Event is a domain event describing a change of an entity.
+// Events are written to the outbox in the same transaction as the change, so a change is never stored without its event.
+// The relay delivers them at least once, subscribers have to handle duplicates.
+type Event struct {
+	ID           string          `json:"id" gorm:"primaryKey"`
+	Type         string          `json:"type"`
+	AggregateID  string          `json:"aggregate_id" gorm:"index"`
+	Payload      json.RawMessage `json:"payload" gorm:"type:jsonb"`
+	Attempts     int             `json:"-"`
+	LastError    string          `json:"-"`
+	LockedUntil  *time.Time      `json:"-"`
+	DispatchedAt *time.Time      `json:"-" gorm:"index"`
+	FailedAt     *time.Time      `json:"-" gorm:"index"`
+	CreatedAt    time.Time       `json:"created_at" gorm:"index:idx_event_pending,where:dispatched_at IS NULL"`
+}
+
+const (
+	// EventTypeAll subscribes to events of every type.
+	EventTypeAll = "*"
+	// EventTypeUserCreated is emitted when a user signs up, the payload is the user.
+	EventTypeUserCreated = "user.created"
+	// EventTypeUserUpdated is emitted when a user changes, the payload is the user.
+	EventTypeUserUpdated = "user.updated"
+	// EventTypeUserDeleted is emitted when a user is deleted, the payload is a DeletedEvent.
+	EventTypeUserDeleted = "user.deleted"
+	// EventTypeMeetupCreated is emitted when a meetup is created, the payload is the meetup.
+	EventTypeMeetupCreated = "meetup.created"
+	// EventTypeMeetupUpdated is emitted when a meetup changes, the payload is the meetup.
+	EventTypeMeetupUpdated = "meetup.updated"
+	// EventTypeMeetupDeleted is emitted when a meetup is deleted, the payload is a DeletedEvent.
+	EventTypeMeetupDeleted = "meetup.deleted"
+	// EventTypeParticipantAdded is emitted when a user joins a meetup, the payload is a ParticipantEvent.
+	EventTypeParticipantAdded = "meetup.participant_added"
+	// EventTypeParticipantRemoved is emitted when a user leaves a meetup, the payload is a ParticipantEvent.
+	EventTypeParticipantRemoved = "meetup.participant_removed"
+)
+
+const (
+	// OutboxBatchSize is the maximum number of events claimed by the relay at once.
+	OutboxBatchSize = 100
+	// OutboxPollInterval is how often the relay looks for new events.
+	OutboxPollInterval = time.Second
+	// OutboxLease is how long a claimed event is reserved for a relay, afterwards it is delivered again.
+	OutboxLease = time.Minute
+	// OutboxMaxAttempts is the number of times delivering an event is attempted before it is given up and marked as failed.
+	OutboxMaxAttempts = 10
+	// OutboxRetention is how long dispatched events are kept.
+	OutboxRetention = 7 * 24 * time.Hour
+	// OutboxFailedRetention is how long failed events are kept for inspection.
+	OutboxFailedRetention = 30 * 24 * time.Hour
+)
+
+// DeletedEvent is the payload of events about deleted entities.
+type DeletedEvent struct {
+	ID string `json:"id"`
+}
+
+// EventHandler handles a dispatched event, returning an error delivers the event again later.
+type EventHandler func(e *Event) error
+
+type EventPublisher interface {
+	Publish(subject string, data []byte) error
+	Close()
+}
+
+type EventRelay interface {
+	Subscribe(eventType string, handler EventHandler)
+	DispatchPendingEvents() error
+	Start()
+}
+
+type OutboxRepository interface {
+	ClaimEvents(now time.Time, lockedUntil time.Time, limit int) ([]*Event, error)
+	UpdateEvent(e *Event) error
+	DeleteDispatchedEvents(before time.Time) error
+	DeleteFailedEvents(before time.Time) error
+}
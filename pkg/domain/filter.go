@@ -0,0 +1,18 @@
+package domain
+
+import "time"
+
+const (
+	// ContentFilterReloadInterval is the interval in which the word lists of the content filter are checked for changes.
+	ContentFilterReloadInterval = 30 * time.Second
+	// ContentFilterSubstringMinLength is the minimum length of a blocked word to be matched inside of usernames.
+	// Shorter words are only matched as a whole, so usernames like "classic" aren't rejected for containing "ass".
+	ContentFilterSubstringMinLength = 4
+)
+
+type ContentFilter interface {
+	CheckUsername(username string) error
+	CheckText(text string) error
+	Reload() error
+	Start()
+}
@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// Invitation invites a user to join a meetup. Invitations are required to join invite only meetups.
+type Invitation struct {
+	MeetupID  string    `json:"meetup_id" gorm:"primaryKey"`
+	UserID    string    `json:"user_id" gorm:"primaryKey;index"`
+	InviterID string    `json:"inviter_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// CreateInvitationDTO is the data transfer object for inviting a user to a meetup.
+type CreateInvitationDTO struct {
+	Username string `json:"username"`
+}
+
+type InvitationService interface {
+	CreateInvitation(uid string, meetupID string, dto *CreateInvitationDTO) (*Invitation, error)
+	GetInvitations(uid string) ([]*Invitation, error)
+	DeclineInvitation(uid string, meetupID string) error
+}
+
+type InvitationRepository interface {
+	CreateInvitation(i *Invitation) error
+	GetInvitation(meetupID string, userID string) (*Invitation, error)
+	GetInvitationsByUserID(userID string) ([]*Invitation, error)
+	DeleteInvitation(meetupID string, userID string) error
+}
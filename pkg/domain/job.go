@@ -0,0 +1,83 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Job is a unit of background work persisted in the database, so it survives restarts and is shared by all servers.
+// Scheduling a job with the Key of a pending job replaces it, which is how jobs are rescheduled.
+// Only pending keys are unique, so a job can be scheduled again while the previous one with its key is still running.
+type Job struct {
+	ID          string          `json:"id" gorm:"primaryKey"`
+	Type        string          `json:"type"`
+	Key         string          `json:"key" gorm:"uniqueIndex:idx_job_pending_key,where:status = 'pending'"`
+	Payload     json.RawMessage `json:"payload" gorm:"type:jsonb"`
+	Status      string          `json:"status" gorm:"index:idx_job_status_run_at"`
+	RunAt       time.Time       `json:"run_at" gorm:"index:idx_job_status_run_at"`
+	Attempts    int             `json:"attempts"`
+	MaxAttempts int             `json:"max_attempts"`
+	LastError   string          `json:"last_error,omitempty"`
+	LockedAt    *time.Time      `json:"locked_at,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+}
+
+const (
+	// JobStatusPending is the status of jobs waiting for their RunAt time.
+	JobStatusPending = "pending"
+	// JobStatusRunning is the status of jobs claimed by a server.
+	// A job still running after JobLease is assumed to be abandoned by a crashed server and claimed again.
+	JobStatusRunning = "running"
+	// JobStatusFailed is the status of jobs that failed on their last attempt, they are kept for inspection.
+	JobStatusFailed = "failed"
+)
+
+const (
+	// JobTypeMeetupReminder reminds the participants of a meetup shortly before it starts.
+	JobTypeMeetupReminder = "meetup.reminder"
+)
+
+const (
+	// JobMaxAttempts is the number of times a job is run before it is marked as failed.
+	JobMaxAttempts = 5
+	// JobRetryBaseDelay is the delay before the first retry of a failed job, it doubles with every further attempt.
+	JobRetryBaseDelay = 30 * time.Second
+	// JobRetryMaxDelay is the maximum delay between two attempts of a job.
+	JobRetryMaxDelay = time.Hour
+	// JobPollInterval is how often each server looks for due jobs.
+	JobPollInterval = 5 * time.Second
+	// JobBatchSize is the maximum number of jobs claimed at once.
+	JobBatchSize = 10
+	// JobLease is how long a claimed job may run, afterwards it is claimed again as its server is assumed to have crashed.
+	// Jobs must finish well within the lease, otherwise they run twice.
+	JobLease = 30 * time.Minute
+)
+
+// MeetupReminderJob is the payload of meetup reminder jobs.
+type MeetupReminderJob struct {
+	MeetupID string `json:"meetup_id"`
+}
+
+// MeetupReminderJobKey returns the job key of a meetups' reminder.
+func MeetupReminderJobKey(meetupID string) string {
+	return JobTypeMeetupReminder + ":" + meetupID
+}
+
+// JobHandler runs a job, returning an error schedules a retry.
+type JobHandler func(j *Job) error
+
+type JobScheduler interface {
+	Register(jobType string, handler JobHandler)
+	Schedule(jobType string, key string, runAt time.Time, payload interface{}) error
+	Cancel(key string) error
+	RunDueJobs() error
+	Start()
+}
+
+type JobRepository interface {
+	SaveJob(j *Job) error
+	ClaimDueJobs(now time.Time, lockedBefore time.Time, limit int) ([]*Job, error)
+	UpdateJob(j *Job) error
+	DeleteJob(id string) error
+	DeleteJobByKey(key string) error
+}
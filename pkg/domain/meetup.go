@@ -3,17 +3,21 @@ package domain
 import "time"
 
 // Meetup represents a UpMeet meetup.
+// With RequireVerifiedAge only users whose verified age is at least MinAge can join.
 type Meetup struct {
-	ID             string         `json:"id" gorm:"primaryKey"`
-	Name           string         `json:"name"`
-	Description    string         `json:"description,omitempty"`
-	InviteOnly     bool           `json:"invite_only" gorm:"default:false"`
-	MinAge         int            `json:"min_age" gorm:"default:-1"`
-	MeetupLocation MeetupLocation `json:"location,omitempty" gorm:"embedded;embeddedPrefix:location_"`
-	OwnerID        string         `json:"owner_id"`
-	Owner          User           `json:"-" gorm:"foreignKey:OwnerID"`
-	Participants   []User         `gorm:"many2many:participants;"`
-	CreatedAt      time.Time      `json:"created_at"`
+	ID                 string         `json:"id" gorm:"primaryKey"`
+	Name               string         `json:"name"`
+	Description        string         `json:"description,omitempty"`
+	InviteOnly         bool           `json:"invite_only" gorm:"default:false"`
+	MinAge             int            `json:"min_age" gorm:"default:-1"`
+	RequireVerifiedAge bool           `json:"require_verified_age" gorm:"default:false"`
+	MeetupLocation     MeetupLocation `json:"location,omitempty" gorm:"embedded;embeddedPrefix:location_"`
+	OwnerID            string         `json:"owner_id"`
+	Owner              User           `json:"-" gorm:"foreignKey:OwnerID"`
+	Participants       []User         `json:"-" gorm:"many2many:participants;"`
+	StartsAt           time.Time      `json:"starts_at" gorm:"index"`
+	EndsAt             time.Time      `json:"ends_at"`
+	CreatedAt          time.Time      `json:"created_at"`
 }
 
 // MeetupLocation represents a meetup location.
@@ -27,29 +31,61 @@ type MeetupLocation struct {
 	StreetNumber string `json:"street_number,omitempty"`
 }
 
+const (
+	// MeetupNameMinLength is the minimum length of a meetups' name.
+	MeetupNameMinLength = 3
+	// MeetupNameMaxLength is the maximum length of a meetups' name.
+	MeetupNameMaxLength = 64
+	// MeetupDescriptionMaxLength is the maximum length of a meetups' description.
+	MeetupDescriptionMaxLength = 1024
+	// MeetupNoMinAge is the MinAge value of meetups without an age restriction.
+	MeetupNoMinAge = -1
+	// MeetupsDefaultLimit is the default number of meetups returned per page.
+	MeetupsDefaultLimit = 20
+	// MeetupsMaxLimit is the maximum number of meetups returned per page.
+	MeetupsMaxLimit = 50
+)
+
+// ParticipantEvent is the data of real-time events about meetup participants.
+type ParticipantEvent struct {
+	MeetupID string `json:"meetup_id"`
+	UserID   string `json:"user_id"`
+}
+
 // CreateMeetupDTO represents a meetup creation data transfer object.
 type CreateMeetupDTO struct {
-	Name           string         `json:"name"`
-	Description    string         `json:"description,omitempty"`
-	InviteOnly     bool           `json:"invite_only"`
-	MinAge         int            `json:"min_age"`
-	MeetupLocation MeetupLocation `json:"location,omitempty"`
+	Name               string         `json:"name"`
+	Description        string         `json:"description,omitempty"`
+	InviteOnly         bool           `json:"invite_only"`
+	MinAge             int            `json:"min_age"`
+	RequireVerifiedAge bool           `json:"require_verified_age"`
+	MeetupLocation     MeetupLocation `json:"location,omitempty"`
+	StartsAt           time.Time      `json:"starts_at"`
+	EndsAt             time.Time      `json:"ends_at"`
 }
 
 // UpdateMeetupDTO represents a meetup update data transfer object.
 type UpdateMeetupDTO struct {
-	Name           string         `json:"name"`
-	Description    string         `json:"description,omitempty"`
-	InviteOnly     bool           `json:"invite_only"`
-	MinAge         int            `json:"min_age"`
-	MeetupLocation MeetupLocation `json:"location,omitempty"`
+	Name               string         `json:"name"`
+	Description        string         `json:"description,omitempty"`
+	InviteOnly         bool           `json:"invite_only"`
+	MinAge             int            `json:"min_age"`
+	RequireVerifiedAge *bool          `json:"require_verified_age,omitempty"`
+	MeetupLocation     MeetupLocation `json:"location,omitempty"`
+	StartsAt           time.Time      `json:"starts_at"`
+	EndsAt             time.Time      `json:"ends_at"`
 }
 
 type MeetupService interface {
 	CreateMeetup(uid string, dto *CreateMeetupDTO) (*Meetup, error)
 	GetMeetupByID(uid string, id string) (*Meetup, error)
-	UpdateMeetup(uid string, dto *UpdateMeetupDTO) (*Meetup, error)
+	DiscoverMeetups(uid string, after string, limit int) ([]*Meetup, error)
+	UpdateMeetup(uid string, id string, dto *UpdateMeetupDTO) (*Meetup, error)
 	DeleteMeetup(uid string, id string) error
+	RemoveMeetup(id string) error
+	JoinMeetup(uid string, id string) error
+	LeaveMeetup(uid string, id string) error
+	SendMeetupReminder(j *Job) error
 }
 
 type MeetupRepository interface {
@@ -57,4 +93,10 @@ type MeetupRepository interface {
 	GetMeetupByID(id string) (*Meetup, error)
 	UpdateMeetup(m *Meetup) error
 	DeleteMeetup(id string) error
+	AddParticipant(meetupID string, userID string) error
+	RemoveParticipant(meetupID string, userID string) error
+	IsParticipant(meetupID string, userID string) (bool, error)
+	GetParticipantIDs(meetupID string) ([]string, error)
+	GetUpcomingMeetupsByParticipant(userID string, from time.Time, to time.Time) ([]*Meetup, error)
+	GetDiscoverableMeetups(from time.Time, excludeOwnerIDs []string, after string, limit int) ([]*Meetup, error)
 }
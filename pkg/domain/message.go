@@ -0,0 +1,116 @@
+package domain
+
+import "time"
+
+// Message is a chat message sent to either a meetup chat or a direct conversation.
+// Deleted messages are kept as empty placeholders so the history stays consistent.
+type Message struct {
+	ID             string      `json:"id" gorm:"primaryKey"`
+	MeetupID       string      `json:"meetup_id,omitempty" gorm:"index:idx_message_meetup_created"`
+	ConversationID string      `json:"conversation_id,omitempty" gorm:"index:idx_message_conversation_created"`
+	AuthorID       string      `json:"author_id"`
+	Content        string      `json:"content"`
+	Edited         bool        `json:"edited"`
+	EditedAt       *time.Time  `json:"edited_at,omitempty"`
+	Deleted        bool        `json:"deleted"`
+	DeletedAt      *time.Time  `json:"deleted_at,omitempty"`
+	Reactions      []*Reaction `json:"reactions,omitempty" gorm:"foreignKey:MessageID"`
+	CreatedAt      time.Time   `json:"created_at" gorm:"index:idx_message_meetup_created;index:idx_message_conversation_created"`
+}
+
+// ChatID returns the id of the meetup or conversation the message was sent to.
+func (m *Message) ChatID() string {
+	if len(m.MeetupID) > 0 {
+		return m.MeetupID
+	}
+	return m.ConversationID
+}
+
+// Reaction is an emoji reaction of a user to a message.
+type Reaction struct {
+	MessageID string    `json:"message_id" gorm:"primaryKey"`
+	UserID    string    `json:"user_id" gorm:"primaryKey"`
+	Emoji     string    `json:"emoji" gorm:"primaryKey"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ReadMarker marks the last message a user has read in a meetup chat or conversation.
+// ReadUntil is the creation time of that message, every newer message is unread.
+type ReadMarker struct {
+	ChatID    string    `json:"chat_id" gorm:"primaryKey"`
+	UserID    string    `json:"user_id" gorm:"primaryKey"`
+	MessageID string    `json:"message_id"`
+	ReadUntil time.Time `json:"read_until"`
+}
+
+const (
+	// MessageContentMaxLength is the maximum length of a messages' content.
+	MessageContentMaxLength = 2000
+	// MessageEditWindow is the time after sending in which a message can be edited.
+	MessageEditWindow = 15 * time.Minute
+	// MessagesDefaultLimit is the default number of messages returned per page.
+	MessagesDefaultLimit = 50
+	// MessagesMaxLimit is the maximum number of messages returned per page.
+	MessagesMaxLimit = 100
+	// ReactionEmojiMaxLength is the maximum length of a reactions' emoji.
+	ReactionEmojiMaxLength = 32
+)
+
+// MeetupChatTopic returns the real-time topic of a meetups' chat.
+func MeetupChatTopic(meetupID string) string {
+	return "meetup:" + meetupID + ":chat"
+}
+
+// UserTopic returns the real-time topic of events addressed to a single user.
+func UserTopic(uid string) string {
+	return "user:" + uid
+}
+
+// CreateMessageDTO is the data transfer object for creating a message.
+type CreateMessageDTO struct {
+	Content string `json:"content"`
+}
+
+// UpdateMessageDTO is the data transfer object for editing a message.
+type UpdateMessageDTO struct {
+	Content string `json:"content"`
+}
+
+// MessageCommandDTO is the data transfer object of real-time commands targeting an existing message.
+type MessageCommandDTO struct {
+	MessageID string `json:"message_id"`
+	Content   string `json:"content,omitempty"`
+	Emoji     string `json:"emoji,omitempty"`
+}
+
+type ChatService interface {
+	CheckMeetupChatAccess(uid string, meetupID string) error
+	SubscribeMeetupChat(uid string, meetupID string) (Subscription, error)
+	GetMeetupMessages(uid string, meetupID string, before string, limit int) ([]*Message, error)
+	CreateMeetupMessage(uid string, meetupID string, dto *CreateMessageDTO) (*Message, error)
+	GetMeetupReadMarkers(uid string, meetupID string) ([]*ReadMarker, error)
+	UpdateMessage(uid string, messageID string, dto *UpdateMessageDTO) (*Message, error)
+	DeleteMessage(uid string, messageID string) error
+	AddReaction(uid string, messageID string, emoji string) error
+	RemoveReaction(uid string, messageID string, emoji string) error
+	MarkRead(uid string, messageID string) (*ReadMarker, error)
+}
+
+type MessageRepository interface {
+	CreateMessage(m *Message) error
+	GetMessageByID(id string) (*Message, error)
+	GetMessagesByMeetupID(meetupID string, before string, limit int) ([]*Message, error)
+	GetMessagesByConversationID(conversationID string, before string, limit int) ([]*Message, error)
+	GetLastMessageByConversationID(conversationID string) (*Message, error)
+	GetLastConversationMessages(conversationIDs []string) ([]*Message, error)
+	CountUnreadConversationMessages(userID string, conversationIDs []string) (map[string]int64, error)
+	UpdateMessage(m *Message) error
+	AddReaction(r *Reaction) error
+	RemoveReaction(messageID string, userID string, emoji string) error
+}
+
+type ReadMarkerRepository interface {
+	SaveReadMarker(rm *ReadMarker) error
+	GetReadMarker(chatID string, userID string) (*ReadMarker, error)
+	GetReadMarkersByChatID(chatID string) ([]*ReadMarker, error)
+}
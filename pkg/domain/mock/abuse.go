@@ -0,0 +1,207 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: D:\upmeet.app\server\pkg\domain\abuse.go
+
+// Package mock_domain is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/UpMeetApp/server/pkg/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAbuseService is a mock of AbuseService interface.
+type MockAbuseService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAbuseServiceMockRecorder
+}
+
+// MockAbuseServiceMockRecorder is the mock recorder for MockAbuseService.
+type MockAbuseServiceMockRecorder struct {
+	mock *MockAbuseService
+}
+
+// NewMockAbuseService creates a new mock instance.
+func NewMockAbuseService(ctrl *gomock.Controller) *MockAbuseService {
+	mock := &MockAbuseService{ctrl: ctrl}
+	mock.recorder = &MockAbuseServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAbuseService) EXPECT() *MockAbuseServiceMockRecorder {
+	return m.recorder
+}
+
+// Check mocks base method.
+func (m *MockAbuseService) Check(uid, action string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Check", uid, action)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Check indicates an expected call of Check.
+func (mr *MockAbuseServiceMockRecorder) Check(uid, action interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Check", reflect.TypeOf((*MockAbuseService)(nil).Check), uid, action)
+}
+
+// GetAbuseSignals mocks base method.
+func (m *MockAbuseService) GetAbuseSignals(filter *domain.AbuseSignalFilter) ([]*domain.AbuseSignal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAbuseSignals", filter)
+	ret0, _ := ret[0].([]*domain.AbuseSignal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAbuseSignals indicates an expected call of GetAbuseSignals.
+func (mr *MockAbuseServiceMockRecorder) GetAbuseSignals(filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAbuseSignals", reflect.TypeOf((*MockAbuseService)(nil).GetAbuseSignals), filter)
+}
+
+// RegisterScorer mocks base method.
+func (m *MockAbuseService) RegisterScorer(scorer domain.AbuseScorer) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "RegisterScorer", scorer)
+}
+
+// RegisterScorer indicates an expected call of RegisterScorer.
+func (mr *MockAbuseServiceMockRecorder) RegisterScorer(scorer interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterScorer", reflect.TypeOf((*MockAbuseService)(nil).RegisterScorer), scorer)
+}
+
+// Start mocks base method.
+func (m *MockAbuseService) Start() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start")
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockAbuseServiceMockRecorder) Start() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockAbuseService)(nil).Start))
+}
+
+// MockUserActionRepository is a mock of UserActionRepository interface.
+type MockUserActionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockUserActionRepositoryMockRecorder
+}
+
+// MockUserActionRepositoryMockRecorder is the mock recorder for MockUserActionRepository.
+type MockUserActionRepositoryMockRecorder struct {
+	mock *MockUserActionRepository
+}
+
+// NewMockUserActionRepository creates a new mock instance.
+func NewMockUserActionRepository(ctrl *gomock.Controller) *MockUserActionRepository {
+	mock := &MockUserActionRepository{ctrl: ctrl}
+	mock.recorder = &MockUserActionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUserActionRepository) EXPECT() *MockUserActionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CountUserActions mocks base method.
+func (m *MockUserActionRepository) CountUserActions(userID, action string, since time.Time) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUserActions", userID, action, since)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUserActions indicates an expected call of CountUserActions.
+func (mr *MockUserActionRepositoryMockRecorder) CountUserActions(userID, action, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUserActions", reflect.TypeOf((*MockUserActionRepository)(nil).CountUserActions), userID, action, since)
+}
+
+// CreateUserAction mocks base method.
+func (m *MockUserActionRepository) CreateUserAction(a *domain.UserAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserAction", a)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateUserAction indicates an expected call of CreateUserAction.
+func (mr *MockUserActionRepositoryMockRecorder) CreateUserAction(a interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserAction", reflect.TypeOf((*MockUserActionRepository)(nil).CreateUserAction), a)
+}
+
+// DeleteUserActionsBefore mocks base method.
+func (m *MockUserActionRepository) DeleteUserActionsBefore(before time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserActionsBefore", before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUserActionsBefore indicates an expected call of DeleteUserActionsBefore.
+func (mr *MockUserActionRepositoryMockRecorder) DeleteUserActionsBefore(before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserActionsBefore", reflect.TypeOf((*MockUserActionRepository)(nil).DeleteUserActionsBefore), before)
+}
+
+// MockAbuseSignalRepository is a mock of AbuseSignalRepository interface.
+type MockAbuseSignalRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAbuseSignalRepositoryMockRecorder
+}
+
+// MockAbuseSignalRepositoryMockRecorder is the mock recorder for MockAbuseSignalRepository.
+type MockAbuseSignalRepositoryMockRecorder struct {
+	mock *MockAbuseSignalRepository
+}
+
+// NewMockAbuseSignalRepository creates a new mock instance.
+func NewMockAbuseSignalRepository(ctrl *gomock.Controller) *MockAbuseSignalRepository {
+	mock := &MockAbuseSignalRepository{ctrl: ctrl}
+	mock.recorder = &MockAbuseSignalRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAbuseSignalRepository) EXPECT() *MockAbuseSignalRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateAbuseSignal mocks base method.
+func (m *MockAbuseSignalRepository) CreateAbuseSignal(s *domain.AbuseSignal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAbuseSignal", s)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAbuseSignal indicates an expected call of CreateAbuseSignal.
+func (mr *MockAbuseSignalRepositoryMockRecorder) CreateAbuseSignal(s interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAbuseSignal", reflect.TypeOf((*MockAbuseSignalRepository)(nil).CreateAbuseSignal), s)
+}
+
+// GetAbuseSignals mocks base method.
+func (m *MockAbuseSignalRepository) GetAbuseSignals(filter *domain.AbuseSignalFilter) ([]*domain.AbuseSignal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAbuseSignals", filter)
+	ret0, _ := ret[0].([]*domain.AbuseSignal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAbuseSignals indicates an expected call of GetAbuseSignals.
+func (mr *MockAbuseSignalRepositoryMockRecorder) GetAbuseSignals(filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAbuseSignals", reflect.TypeOf((*MockAbuseSignalRepository)(nil).GetAbuseSignals), filter)
+}
@@ -0,0 +1,162 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: D:\upmeet.app\server\pkg\domain\attendance.go
+
+// Package mock_domain is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	domain "github.com/UpMeetApp/server/pkg/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAttendanceService is a mock of AttendanceService interface.
+type MockAttendanceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttendanceServiceMockRecorder
+}
+
+// MockAttendanceServiceMockRecorder is the mock recorder for MockAttendanceService.
+type MockAttendanceServiceMockRecorder struct {
+	mock *MockAttendanceService
+}
+
+// NewMockAttendanceService creates a new mock instance.
+func NewMockAttendanceService(ctrl *gomock.Controller) *MockAttendanceService {
+	mock := &MockAttendanceService{ctrl: ctrl}
+	mock.recorder = &MockAttendanceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttendanceService) EXPECT() *MockAttendanceServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAttendanceStats mocks base method.
+func (m *MockAttendanceService) GetAttendanceStats(userID string) (*domain.AttendanceStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendanceStats", userID)
+	ret0, _ := ret[0].(*domain.AttendanceStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendanceStats indicates an expected call of GetAttendanceStats.
+func (mr *MockAttendanceServiceMockRecorder) GetAttendanceStats(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendanceStats", reflect.TypeOf((*MockAttendanceService)(nil).GetAttendanceStats), userID)
+}
+
+// GetMeetupAttendance mocks base method.
+func (m *MockAttendanceService) GetMeetupAttendance(uid, meetupID string) ([]*domain.Attendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMeetupAttendance", uid, meetupID)
+	ret0, _ := ret[0].([]*domain.Attendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMeetupAttendance indicates an expected call of GetMeetupAttendance.
+func (mr *MockAttendanceServiceMockRecorder) GetMeetupAttendance(uid, meetupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMeetupAttendance", reflect.TypeOf((*MockAttendanceService)(nil).GetMeetupAttendance), uid, meetupID)
+}
+
+// MarkAttendance mocks base method.
+func (m *MockAttendanceService) MarkAttendance(uid, meetupID string, dto *domain.MarkAttendanceDTO) ([]*domain.Attendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAttendance", uid, meetupID, dto)
+	ret0, _ := ret[0].([]*domain.Attendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkAttendance indicates an expected call of MarkAttendance.
+func (mr *MockAttendanceServiceMockRecorder) MarkAttendance(uid, meetupID, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAttendance", reflect.TypeOf((*MockAttendanceService)(nil).MarkAttendance), uid, meetupID, dto)
+}
+
+// MockAttendanceRepository is a mock of AttendanceRepository interface.
+type MockAttendanceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAttendanceRepositoryMockRecorder
+}
+
+// MockAttendanceRepositoryMockRecorder is the mock recorder for MockAttendanceRepository.
+type MockAttendanceRepositoryMockRecorder struct {
+	mock *MockAttendanceRepository
+}
+
+// NewMockAttendanceRepository creates a new mock instance.
+func NewMockAttendanceRepository(ctrl *gomock.Controller) *MockAttendanceRepository {
+	mock := &MockAttendanceRepository{ctrl: ctrl}
+	mock.recorder = &MockAttendanceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAttendanceRepository) EXPECT() *MockAttendanceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetAttendance mocks base method.
+func (m *MockAttendanceRepository) GetAttendance(meetupID, userID string) (*domain.Attendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendance", meetupID, userID)
+	ret0, _ := ret[0].(*domain.Attendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendance indicates an expected call of GetAttendance.
+func (mr *MockAttendanceRepositoryMockRecorder) GetAttendance(meetupID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendance", reflect.TypeOf((*MockAttendanceRepository)(nil).GetAttendance), meetupID, userID)
+}
+
+// GetAttendanceByMeetupID mocks base method.
+func (m *MockAttendanceRepository) GetAttendanceByMeetupID(meetupID string) ([]*domain.Attendance, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendanceByMeetupID", meetupID)
+	ret0, _ := ret[0].([]*domain.Attendance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendanceByMeetupID indicates an expected call of GetAttendanceByMeetupID.
+func (mr *MockAttendanceRepositoryMockRecorder) GetAttendanceByMeetupID(meetupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendanceByMeetupID", reflect.TypeOf((*MockAttendanceRepository)(nil).GetAttendanceByMeetupID), meetupID)
+}
+
+// GetAttendanceStatsByUserID mocks base method.
+func (m *MockAttendanceRepository) GetAttendanceStatsByUserID(userID string) (*domain.AttendanceStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAttendanceStatsByUserID", userID)
+	ret0, _ := ret[0].(*domain.AttendanceStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAttendanceStatsByUserID indicates an expected call of GetAttendanceStatsByUserID.
+func (mr *MockAttendanceRepositoryMockRecorder) GetAttendanceStatsByUserID(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAttendanceStatsByUserID", reflect.TypeOf((*MockAttendanceRepository)(nil).GetAttendanceStatsByUserID), userID)
+}
+
+// SaveAttendance mocks base method.
+func (m *MockAttendanceRepository) SaveAttendance(a *domain.Attendance) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveAttendance", a)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveAttendance indicates an expected call of SaveAttendance.
+func (mr *MockAttendanceRepositoryMockRecorder) SaveAttendance(a interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveAttendance", reflect.TypeOf((*MockAttendanceRepository)(nil).SaveAttendance), a)
+}
@@ -0,0 +1,174 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: D:\upmeet.app\server\pkg\domain\block.go
+
+// Package mock_domain is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	domain "github.com/UpMeetApp/server/pkg/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockBlockService is a mock of BlockService interface.
+type MockBlockService struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockServiceMockRecorder
+}
+
+// MockBlockServiceMockRecorder is the mock recorder for MockBlockService.
+type MockBlockServiceMockRecorder struct {
+	mock *MockBlockService
+}
+
+// NewMockBlockService creates a new mock instance.
+func NewMockBlockService(ctrl *gomock.Controller) *MockBlockService {
+	mock := &MockBlockService{ctrl: ctrl}
+	mock.recorder = &MockBlockServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlockService) EXPECT() *MockBlockServiceMockRecorder {
+	return m.recorder
+}
+
+// BlockUser mocks base method.
+func (m *MockBlockService) BlockUser(uid, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockUser", uid, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockUser indicates an expected call of BlockUser.
+func (mr *MockBlockServiceMockRecorder) BlockUser(uid, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockUser", reflect.TypeOf((*MockBlockService)(nil).BlockUser), uid, username)
+}
+
+// GetBlockedUsers mocks base method.
+func (m *MockBlockService) GetBlockedUsers(uid string) ([]*domain.BlockedUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedUsers", uid)
+	ret0, _ := ret[0].([]*domain.BlockedUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedUsers indicates an expected call of GetBlockedUsers.
+func (mr *MockBlockServiceMockRecorder) GetBlockedUsers(uid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedUsers", reflect.TypeOf((*MockBlockService)(nil).GetBlockedUsers), uid)
+}
+
+// UnblockUser mocks base method.
+func (m *MockBlockService) UnblockUser(uid, username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnblockUser", uid, username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnblockUser indicates an expected call of UnblockUser.
+func (mr *MockBlockServiceMockRecorder) UnblockUser(uid, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockUser", reflect.TypeOf((*MockBlockService)(nil).UnblockUser), uid, username)
+}
+
+// MockBlockRepository is a mock of BlockRepository interface.
+type MockBlockRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockBlockRepositoryMockRecorder
+}
+
+// MockBlockRepositoryMockRecorder is the mock recorder for MockBlockRepository.
+type MockBlockRepositoryMockRecorder struct {
+	mock *MockBlockRepository
+}
+
+// NewMockBlockRepository creates a new mock instance.
+func NewMockBlockRepository(ctrl *gomock.Controller) *MockBlockRepository {
+	mock := &MockBlockRepository{ctrl: ctrl}
+	mock.recorder = &MockBlockRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockBlockRepository) EXPECT() *MockBlockRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateBlock mocks base method.
+func (m *MockBlockRepository) CreateBlock(b *domain.Block) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBlock", b)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateBlock indicates an expected call of CreateBlock.
+func (mr *MockBlockRepositoryMockRecorder) CreateBlock(b interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBlock", reflect.TypeOf((*MockBlockRepository)(nil).CreateBlock), b)
+}
+
+// DeleteBlock mocks base method.
+func (m *MockBlockRepository) DeleteBlock(userID, blockedUserID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBlock", userID, blockedUserID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteBlock indicates an expected call of DeleteBlock.
+func (mr *MockBlockRepositoryMockRecorder) DeleteBlock(userID, blockedUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBlock", reflect.TypeOf((*MockBlockRepository)(nil).DeleteBlock), userID, blockedUserID)
+}
+
+// GetBlockedUserIDs mocks base method.
+func (m *MockBlockRepository) GetBlockedUserIDs(userID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedUserIDs", userID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedUserIDs indicates an expected call of GetBlockedUserIDs.
+func (mr *MockBlockRepositoryMockRecorder) GetBlockedUserIDs(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedUserIDs", reflect.TypeOf((*MockBlockRepository)(nil).GetBlockedUserIDs), userID)
+}
+
+// GetBlockedUsers mocks base method.
+func (m *MockBlockRepository) GetBlockedUsers(userID string) ([]*domain.BlockedUser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetBlockedUsers", userID)
+	ret0, _ := ret[0].([]*domain.BlockedUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetBlockedUsers indicates an expected call of GetBlockedUsers.
+func (mr *MockBlockRepositoryMockRecorder) GetBlockedUsers(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBlockedUsers", reflect.TypeOf((*MockBlockRepository)(nil).GetBlockedUsers), userID)
+}
+
+// IsBlocked mocks base method.
+func (m *MockBlockRepository) IsBlocked(userID, otherUserID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsBlocked", userID, otherUserID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsBlocked indicates an expected call of IsBlocked.
+func (mr *MockBlockRepositoryMockRecorder) IsBlocked(userID, otherUserID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsBlocked", reflect.TypeOf((*MockBlockRepository)(nil).IsBlocked), userID, otherUserID)
+}
@@ -0,0 +1,222 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: D:\upmeet.app\server\pkg\domain\conversation.go
+
+// Package mock_domain is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/UpMeetApp/server/pkg/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockConversationService is a mock of ConversationService interface.
+type MockConversationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockConversationServiceMockRecorder
+}
+
+// MockConversationServiceMockRecorder is the mock recorder for MockConversationService.
+type MockConversationServiceMockRecorder struct {
+	mock *MockConversationService
+}
+
+// NewMockConversationService creates a new mock instance.
+func NewMockConversationService(ctrl *gomock.Controller) *MockConversationService {
+	mock := &MockConversationService{ctrl: ctrl}
+	mock.recorder = &MockConversationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConversationService) EXPECT() *MockConversationServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateConversationMessage mocks base method.
+func (m *MockConversationService) CreateConversationMessage(uid, conversationID string, dto *domain.CreateMessageDTO) (*domain.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateConversationMessage", uid, conversationID, dto)
+	ret0, _ := ret[0].(*domain.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateConversationMessage indicates an expected call of CreateConversationMessage.
+func (mr *MockConversationServiceMockRecorder) CreateConversationMessage(uid, conversationID, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConversationMessage", reflect.TypeOf((*MockConversationService)(nil).CreateConversationMessage), uid, conversationID, dto)
+}
+
+// GetConversationMessages mocks base method.
+func (m *MockConversationService) GetConversationMessages(uid, conversationID, before string, limit int) ([]*domain.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversationMessages", uid, conversationID, before, limit)
+	ret0, _ := ret[0].([]*domain.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConversationMessages indicates an expected call of GetConversationMessages.
+func (mr *MockConversationServiceMockRecorder) GetConversationMessages(uid, conversationID, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversationMessages", reflect.TypeOf((*MockConversationService)(nil).GetConversationMessages), uid, conversationID, before, limit)
+}
+
+// GetConversationReadMarkers mocks base method.
+func (m *MockConversationService) GetConversationReadMarkers(uid, conversationID string) ([]*domain.ReadMarker, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversationReadMarkers", uid, conversationID)
+	ret0, _ := ret[0].([]*domain.ReadMarker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConversationReadMarkers indicates an expected call of GetConversationReadMarkers.
+func (mr *MockConversationServiceMockRecorder) GetConversationReadMarkers(uid, conversationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversationReadMarkers", reflect.TypeOf((*MockConversationService)(nil).GetConversationReadMarkers), uid, conversationID)
+}
+
+// GetConversations mocks base method.
+func (m *MockConversationService) GetConversations(uid string) ([]*domain.ConversationPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversations", uid)
+	ret0, _ := ret[0].([]*domain.ConversationPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConversations indicates an expected call of GetConversations.
+func (mr *MockConversationServiceMockRecorder) GetConversations(uid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversations", reflect.TypeOf((*MockConversationService)(nil).GetConversations), uid)
+}
+
+// MarkConversationRead mocks base method.
+func (m *MockConversationService) MarkConversationRead(uid, conversationID string) (*domain.ReadMarker, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkConversationRead", uid, conversationID)
+	ret0, _ := ret[0].(*domain.ReadMarker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkConversationRead indicates an expected call of MarkConversationRead.
+func (mr *MockConversationServiceMockRecorder) MarkConversationRead(uid, conversationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkConversationRead", reflect.TypeOf((*MockConversationService)(nil).MarkConversationRead), uid, conversationID)
+}
+
+// StartConversation mocks base method.
+func (m *MockConversationService) StartConversation(uid string, dto *domain.StartConversationDTO) (*domain.Conversation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartConversation", uid, dto)
+	ret0, _ := ret[0].(*domain.Conversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartConversation indicates an expected call of StartConversation.
+func (mr *MockConversationServiceMockRecorder) StartConversation(uid, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartConversation", reflect.TypeOf((*MockConversationService)(nil).StartConversation), uid, dto)
+}
+
+// MockConversationRepository is a mock of ConversationRepository interface.
+type MockConversationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockConversationRepositoryMockRecorder
+}
+
+// MockConversationRepositoryMockRecorder is the mock recorder for MockConversationRepository.
+type MockConversationRepositoryMockRecorder struct {
+	mock *MockConversationRepository
+}
+
+// NewMockConversationRepository creates a new mock instance.
+func NewMockConversationRepository(ctrl *gomock.Controller) *MockConversationRepository {
+	mock := &MockConversationRepository{ctrl: ctrl}
+	mock.recorder = &MockConversationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockConversationRepository) EXPECT() *MockConversationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateConversation mocks base method.
+func (m *MockConversationRepository) CreateConversation(c *domain.Conversation) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateConversation", c)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateConversation indicates an expected call of CreateConversation.
+func (mr *MockConversationRepositoryMockRecorder) CreateConversation(c interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConversation", reflect.TypeOf((*MockConversationRepository)(nil).CreateConversation), c)
+}
+
+// GetConversationByID mocks base method.
+func (m *MockConversationRepository) GetConversationByID(id string) (*domain.Conversation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversationByID", id)
+	ret0, _ := ret[0].(*domain.Conversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConversationByID indicates an expected call of GetConversationByID.
+func (mr *MockConversationRepositoryMockRecorder) GetConversationByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversationByID", reflect.TypeOf((*MockConversationRepository)(nil).GetConversationByID), id)
+}
+
+// GetConversationByKey mocks base method.
+func (m *MockConversationRepository) GetConversationByKey(key string) (*domain.Conversation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversationByKey", key)
+	ret0, _ := ret[0].(*domain.Conversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConversationByKey indicates an expected call of GetConversationByKey.
+func (mr *MockConversationRepositoryMockRecorder) GetConversationByKey(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversationByKey", reflect.TypeOf((*MockConversationRepository)(nil).GetConversationByKey), key)
+}
+
+// GetConversationsByUserID mocks base method.
+func (m *MockConversationRepository) GetConversationsByUserID(userID string) ([]*domain.Conversation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetConversationsByUserID", userID)
+	ret0, _ := ret[0].([]*domain.Conversation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetConversationsByUserID indicates an expected call of GetConversationsByUserID.
+func (mr *MockConversationRepositoryMockRecorder) GetConversationsByUserID(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConversationsByUserID", reflect.TypeOf((*MockConversationRepository)(nil).GetConversationsByUserID), userID)
+}
+
+// UpdateLastMessageAt mocks base method.
+func (m *MockConversationRepository) UpdateLastMessageAt(id string, t time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLastMessageAt", id, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateLastMessageAt indicates an expected call of UpdateLastMessageAt.
+func (mr *MockConversationRepositoryMockRecorder) UpdateLastMessageAt(id, t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLastMessageAt", reflect.TypeOf((*MockConversationRepository)(nil).UpdateLastMessageAt), id, t)
+}
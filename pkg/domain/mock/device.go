@@ -0,0 +1,202 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: D:\upmeet.app\server\pkg\domain\device.go
+
+// Package mock_domain is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	domain "github.com/UpMeetApp/server/pkg/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockPushSender is a mock of PushSender interface.
+type MockPushSender struct {
+	ctrl     *gomock.Controller
+	recorder *MockPushSenderMockRecorder
+}
+
+// MockPushSenderMockRecorder is the mock recorder for MockPushSender.
+type MockPushSenderMockRecorder struct {
+	mock *MockPushSender
+}
+
+// NewMockPushSender creates a new mock instance.
+func NewMockPushSender(ctrl *gomock.Controller) *MockPushSender {
+	mock := &MockPushSender{ctrl: ctrl}
+	mock.recorder = &MockPushSenderMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPushSender) EXPECT() *MockPushSenderMockRecorder {
+	return m.recorder
+}
+
+// Send mocks base method.
+func (m *MockPushSender) Send(tokens []string, msg *domain.PushMessage) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", tokens, msg)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockPushSenderMockRecorder) Send(tokens, msg interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockPushSender)(nil).Send), tokens, msg)
+}
+
+// MockDeviceService is a mock of DeviceService interface.
+type MockDeviceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeviceServiceMockRecorder
+}
+
+// MockDeviceServiceMockRecorder is the mock recorder for MockDeviceService.
+type MockDeviceServiceMockRecorder struct {
+	mock *MockDeviceService
+}
+
+// NewMockDeviceService creates a new mock instance.
+func NewMockDeviceService(ctrl *gomock.Controller) *MockDeviceService {
+	mock := &MockDeviceService{ctrl: ctrl}
+	mock.recorder = &MockDeviceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeviceService) EXPECT() *MockDeviceServiceMockRecorder {
+	return m.recorder
+}
+
+// GetDevices mocks base method.
+func (m *MockDeviceService) GetDevices(uid string) ([]*domain.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDevices", uid)
+	ret0, _ := ret[0].([]*domain.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDevices indicates an expected call of GetDevices.
+func (mr *MockDeviceServiceMockRecorder) GetDevices(uid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevices", reflect.TypeOf((*MockDeviceService)(nil).GetDevices), uid)
+}
+
+// RegisterDevice mocks base method.
+func (m *MockDeviceService) RegisterDevice(uid string, dto *domain.RegisterDeviceDTO) (*domain.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterDevice", uid, dto)
+	ret0, _ := ret[0].(*domain.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterDevice indicates an expected call of RegisterDevice.
+func (mr *MockDeviceServiceMockRecorder) RegisterDevice(uid, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterDevice", reflect.TypeOf((*MockDeviceService)(nil).RegisterDevice), uid, dto)
+}
+
+// UnregisterDevice mocks base method.
+func (m *MockDeviceService) UnregisterDevice(uid, token string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnregisterDevice", uid, token)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnregisterDevice indicates an expected call of UnregisterDevice.
+func (mr *MockDeviceServiceMockRecorder) UnregisterDevice(uid, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnregisterDevice", reflect.TypeOf((*MockDeviceService)(nil).UnregisterDevice), uid, token)
+}
+
+// MockDeviceRepository is a mock of DeviceRepository interface.
+type MockDeviceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDeviceRepositoryMockRecorder
+}
+
+// MockDeviceRepositoryMockRecorder is the mock recorder for MockDeviceRepository.
+type MockDeviceRepositoryMockRecorder struct {
+	mock *MockDeviceRepository
+}
+
+// NewMockDeviceRepository creates a new mock instance.
+func NewMockDeviceRepository(ctrl *gomock.Controller) *MockDeviceRepository {
+	mock := &MockDeviceRepository{ctrl: ctrl}
+	mock.recorder = &MockDeviceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDeviceRepository) EXPECT() *MockDeviceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteDevices mocks base method.
+func (m *MockDeviceRepository) DeleteDevices(tokens ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{}
+	for _, a := range tokens {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteDevices", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDevices indicates an expected call of DeleteDevices.
+func (mr *MockDeviceRepositoryMockRecorder) DeleteDevices(tokens ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDevices", reflect.TypeOf((*MockDeviceRepository)(nil).DeleteDevices), tokens...)
+}
+
+// GetDeviceByToken mocks base method.
+func (m *MockDeviceRepository) GetDeviceByToken(token string) (*domain.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeviceByToken", token)
+	ret0, _ := ret[0].(*domain.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeviceByToken indicates an expected call of GetDeviceByToken.
+func (mr *MockDeviceRepositoryMockRecorder) GetDeviceByToken(token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeviceByToken", reflect.TypeOf((*MockDeviceRepository)(nil).GetDeviceByToken), token)
+}
+
+// GetDevicesByUserID mocks base method.
+func (m *MockDeviceRepository) GetDevicesByUserID(userID string) ([]*domain.Device, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDevicesByUserID", userID)
+	ret0, _ := ret[0].([]*domain.Device)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDevicesByUserID indicates an expected call of GetDevicesByUserID.
+func (mr *MockDeviceRepositoryMockRecorder) GetDevicesByUserID(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDevicesByUserID", reflect.TypeOf((*MockDeviceRepository)(nil).GetDevicesByUserID), userID)
+}
+
+// SaveDevice mocks base method.
+func (m *MockDeviceRepository) SaveDevice(d *domain.Device) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveDevice", d)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveDevice indicates an expected call of SaveDevice.
+func (mr *MockDeviceRepositoryMockRecorder) SaveDevice(d interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveDevice", reflect.TypeOf((*MockDeviceRepository)(nil).SaveDevice), d)
+}
@@ -0,0 +1,154 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: D:\upmeet.app\server\pkg\domain\event.go
+
+// Package mock_domain is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/UpMeetApp/server/pkg/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockEventRelay is a mock of EventRelay interface.
+type MockEventRelay struct {
+	ctrl     *gomock.Controller
+	recorder *MockEventRelayMockRecorder
+}
+
+// MockEventRelayMockRecorder is the mock recorder for MockEventRelay.
+type MockEventRelayMockRecorder struct {
+	mock *MockEventRelay
+}
+
+// NewMockEventRelay creates a new mock instance.
+func NewMockEventRelay(ctrl *gomock.Controller) *MockEventRelay {
+	mock := &MockEventRelay{ctrl: ctrl}
+	mock.recorder = &MockEventRelayMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEventRelay) EXPECT() *MockEventRelayMockRecorder {
+	return m.recorder
+}
+
+// DispatchPendingEvents mocks base method.
+func (m *MockEventRelay) DispatchPendingEvents() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DispatchPendingEvents")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DispatchPendingEvents indicates an expected call of DispatchPendingEvents.
+func (mr *MockEventRelayMockRecorder) DispatchPendingEvents() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DispatchPendingEvents", reflect.TypeOf((*MockEventRelay)(nil).DispatchPendingEvents))
+}
+
+// Start mocks base method.
+func (m *MockEventRelay) Start() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start")
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockEventRelayMockRecorder) Start() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockEventRelay)(nil).Start))
+}
+
+// Subscribe mocks base method.
+func (m *MockEventRelay) Subscribe(eventType string, handler domain.EventHandler) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Subscribe", eventType, handler)
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockEventRelayMockRecorder) Subscribe(eventType, handler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockEventRelay)(nil).Subscribe), eventType, handler)
+}
+
+// MockOutboxRepository is a mock of OutboxRepository interface.
+type MockOutboxRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockOutboxRepositoryMockRecorder
+}
+
+// MockOutboxRepositoryMockRecorder is the mock recorder for MockOutboxRepository.
+type MockOutboxRepositoryMockRecorder struct {
+	mock *MockOutboxRepository
+}
+
+// NewMockOutboxRepository creates a new mock instance.
+func NewMockOutboxRepository(ctrl *gomock.Controller) *MockOutboxRepository {
+	mock := &MockOutboxRepository{ctrl: ctrl}
+	mock.recorder = &MockOutboxRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockOutboxRepository) EXPECT() *MockOutboxRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimEvents mocks base method.
+func (m *MockOutboxRepository) ClaimEvents(now, lockedUntil time.Time, limit int) ([]*domain.Event, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimEvents", now, lockedUntil, limit)
+	ret0, _ := ret[0].([]*domain.Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimEvents indicates an expected call of ClaimEvents.
+func (mr *MockOutboxRepositoryMockRecorder) ClaimEvents(now, lockedUntil, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimEvents", reflect.TypeOf((*MockOutboxRepository)(nil).ClaimEvents), now, lockedUntil, limit)
+}
+
+// DeleteDispatchedEvents mocks base method.
+func (m *MockOutboxRepository) DeleteDispatchedEvents(before time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDispatchedEvents", before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDispatchedEvents indicates an expected call of DeleteDispatchedEvents.
+func (mr *MockOutboxRepositoryMockRecorder) DeleteDispatchedEvents(before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDispatchedEvents", reflect.TypeOf((*MockOutboxRepository)(nil).DeleteDispatchedEvents), before)
+}
+
+// DeleteFailedEvents mocks base method.
+func (m *MockOutboxRepository) DeleteFailedEvents(before time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFailedEvents", before)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFailedEvents indicates an expected call of DeleteFailedEvents.
+func (mr *MockOutboxRepositoryMockRecorder) DeleteFailedEvents(before interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFailedEvents", reflect.TypeOf((*MockOutboxRepository)(nil).DeleteFailedEvents), before)
+}
+
+// UpdateEvent mocks base method.
+func (m *MockOutboxRepository) UpdateEvent(e *domain.Event) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEvent", e)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateEvent indicates an expected call of UpdateEvent.
+func (mr *MockOutboxRepositoryMockRecorder) UpdateEvent(e interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEvent", reflect.TypeOf((*MockOutboxRepository)(nil).UpdateEvent), e)
+}
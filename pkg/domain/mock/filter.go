@@ -0,0 +1,88 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: D:\upmeet.app\server\pkg\domain\filter.go
+
+// Package mock_domain is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockContentFilter is a mock of ContentFilter interface.
+type MockContentFilter struct {
+	ctrl     *gomock.Controller
+	recorder *MockContentFilterMockRecorder
+}
+
+// MockContentFilterMockRecorder is the mock recorder for MockContentFilter.
+type MockContentFilterMockRecorder struct {
+	mock *MockContentFilter
+}
+
+// NewMockContentFilter creates a new mock instance.
+func NewMockContentFilter(ctrl *gomock.Controller) *MockContentFilter {
+	mock := &MockContentFilter{ctrl: ctrl}
+	mock.recorder = &MockContentFilterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockContentFilter) EXPECT() *MockContentFilterMockRecorder {
+	return m.recorder
+}
+
+// CheckText mocks base method.
+func (m *MockContentFilter) CheckText(text string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckText", text)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckText indicates an expected call of CheckText.
+func (mr *MockContentFilterMockRecorder) CheckText(text interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckText", reflect.TypeOf((*MockContentFilter)(nil).CheckText), text)
+}
+
+// CheckUsername mocks base method.
+func (m *MockContentFilter) CheckUsername(username string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckUsername", username)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckUsername indicates an expected call of CheckUsername.
+func (mr *MockContentFilterMockRecorder) CheckUsername(username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUsername", reflect.TypeOf((*MockContentFilter)(nil).CheckUsername), username)
+}
+
+// Reload mocks base method.
+func (m *MockContentFilter) Reload() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reload")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reload indicates an expected call of Reload.
+func (mr *MockContentFilterMockRecorder) Reload() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reload", reflect.TypeOf((*MockContentFilter)(nil).Reload))
+}
+
+// Start mocks base method.
+func (m *MockContentFilter) Start() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start")
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockContentFilterMockRecorder) Start() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockContentFilter)(nil).Start))
+}
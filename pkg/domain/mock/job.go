@@ -0,0 +1,196 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: D:\upmeet.app\server\pkg\domain\job.go
+
+// Package mock_domain is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/UpMeetApp/server/pkg/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockJobScheduler is a mock of JobScheduler interface.
+type MockJobScheduler struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobSchedulerMockRecorder
+}
+
+// MockJobSchedulerMockRecorder is the mock recorder for MockJobScheduler.
+type MockJobSchedulerMockRecorder struct {
+	mock *MockJobScheduler
+}
+
+// NewMockJobScheduler creates a new mock instance.
+func NewMockJobScheduler(ctrl *gomock.Controller) *MockJobScheduler {
+	mock := &MockJobScheduler{ctrl: ctrl}
+	mock.recorder = &MockJobSchedulerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobScheduler) EXPECT() *MockJobSchedulerMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockJobScheduler) Cancel(key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockJobSchedulerMockRecorder) Cancel(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockJobScheduler)(nil).Cancel), key)
+}
+
+// Register mocks base method.
+func (m *MockJobScheduler) Register(jobType string, handler domain.JobHandler) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Register", jobType, handler)
+}
+
+// Register indicates an expected call of Register.
+func (mr *MockJobSchedulerMockRecorder) Register(jobType, handler interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Register", reflect.TypeOf((*MockJobScheduler)(nil).Register), jobType, handler)
+}
+
+// RunDueJobs mocks base method.
+func (m *MockJobScheduler) RunDueJobs() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunDueJobs")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunDueJobs indicates an expected call of RunDueJobs.
+func (mr *MockJobSchedulerMockRecorder) RunDueJobs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDueJobs", reflect.TypeOf((*MockJobScheduler)(nil).RunDueJobs))
+}
+
+// Schedule mocks base method.
+func (m *MockJobScheduler) Schedule(jobType, key string, runAt time.Time, payload interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Schedule", jobType, key, runAt, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Schedule indicates an expected call of Schedule.
+func (mr *MockJobSchedulerMockRecorder) Schedule(jobType, key, runAt, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Schedule", reflect.TypeOf((*MockJobScheduler)(nil).Schedule), jobType, key, runAt, payload)
+}
+
+// Start mocks base method.
+func (m *MockJobScheduler) Start() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Start")
+}
+
+// Start indicates an expected call of Start.
+func (mr *MockJobSchedulerMockRecorder) Start() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Start", reflect.TypeOf((*MockJobScheduler)(nil).Start))
+}
+
+// MockJobRepository is a mock of JobRepository interface.
+type MockJobRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockJobRepositoryMockRecorder
+}
+
+// MockJobRepositoryMockRecorder is the mock recorder for MockJobRepository.
+type MockJobRepositoryMockRecorder struct {
+	mock *MockJobRepository
+}
+
+// NewMockJobRepository creates a new mock instance.
+func NewMockJobRepository(ctrl *gomock.Controller) *MockJobRepository {
+	mock := &MockJobRepository{ctrl: ctrl}
+	mock.recorder = &MockJobRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockJobRepository) EXPECT() *MockJobRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDueJobs mocks base method.
+func (m *MockJobRepository) ClaimDueJobs(now, lockedBefore time.Time, limit int) ([]*domain.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueJobs", now, lockedBefore, limit)
+	ret0, _ := ret[0].([]*domain.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDueJobs indicates an expected call of ClaimDueJobs.
+func (mr *MockJobRepositoryMockRecorder) ClaimDueJobs(now, lockedBefore, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueJobs", reflect.TypeOf((*MockJobRepository)(nil).ClaimDueJobs), now, lockedBefore, limit)
+}
+
+// DeleteJob mocks base method.
+func (m *MockJobRepository) DeleteJob(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteJob", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteJob indicates an expected call of DeleteJob.
+func (mr *MockJobRepositoryMockRecorder) DeleteJob(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJob", reflect.TypeOf((*MockJobRepository)(nil).DeleteJob), id)
+}
+
+// DeleteJobByKey mocks base method.
+func (m *MockJobRepository) DeleteJobByKey(key string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteJobByKey", key)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteJobByKey indicates an expected call of DeleteJobByKey.
+func (mr *MockJobRepositoryMockRecorder) DeleteJobByKey(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJobByKey", reflect.TypeOf((*MockJobRepository)(nil).DeleteJobByKey), key)
+}
+
+// SaveJob mocks base method.
+func (m *MockJobRepository) SaveJob(j *domain.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveJob", j)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveJob indicates an expected call of SaveJob.
+func (mr *MockJobRepositoryMockRecorder) SaveJob(j interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveJob", reflect.TypeOf((*MockJobRepository)(nil).SaveJob), j)
+}
+
+// UpdateJob mocks base method.
+func (m *MockJobRepository) UpdateJob(j *domain.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateJob", j)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateJob indicates an expected call of UpdateJob.
+func (mr *MockJobRepositoryMockRecorder) UpdateJob(j interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateJob", reflect.TypeOf((*MockJobRepository)(nil).UpdateJob), j)
+}
@@ -6,6 +6,7 @@ package mock
 
 import (
 	reflect "reflect"
+	time "time"
 
 	domain "github.com/UpMeetApp/server/pkg/domain"
 	gomock "github.com/golang/mock/gomock"
@@ -63,6 +64,21 @@ func (mr *MockMeetupServiceMockRecorder) DeleteMeetup(uid, id interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMeetup", reflect.TypeOf((*MockMeetupService)(nil).DeleteMeetup), uid, id)
 }
 
+// DiscoverMeetups mocks base method.
+func (m *MockMeetupService) DiscoverMeetups(uid, after string, limit int) ([]*domain.Meetup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiscoverMeetups", uid, after, limit)
+	ret0, _ := ret[0].([]*domain.Meetup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscoverMeetups indicates an expected call of DiscoverMeetups.
+func (mr *MockMeetupServiceMockRecorder) DiscoverMeetups(uid, after, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverMeetups", reflect.TypeOf((*MockMeetupService)(nil).DiscoverMeetups), uid, after, limit)
+}
+
 // GetMeetupByID mocks base method.
 func (m *MockMeetupService) GetMeetupByID(uid, id string) (*domain.Meetup, error) {
 	m.ctrl.T.Helper()
@@ -78,19 +94,75 @@ func (mr *MockMeetupServiceMockRecorder) GetMeetupByID(uid, id interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMeetupByID", reflect.TypeOf((*MockMeetupService)(nil).GetMeetupByID), uid, id)
 }
 
+// JoinMeetup mocks base method.
+func (m *MockMeetupService) JoinMeetup(uid, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "JoinMeetup", uid, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// JoinMeetup indicates an expected call of JoinMeetup.
+func (mr *MockMeetupServiceMockRecorder) JoinMeetup(uid, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinMeetup", reflect.TypeOf((*MockMeetupService)(nil).JoinMeetup), uid, id)
+}
+
+// LeaveMeetup mocks base method.
+func (m *MockMeetupService) LeaveMeetup(uid, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveMeetup", uid, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LeaveMeetup indicates an expected call of LeaveMeetup.
+func (mr *MockMeetupServiceMockRecorder) LeaveMeetup(uid, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveMeetup", reflect.TypeOf((*MockMeetupService)(nil).LeaveMeetup), uid, id)
+}
+
+// RemoveMeetup mocks base method.
+func (m *MockMeetupService) RemoveMeetup(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveMeetup", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveMeetup indicates an expected call of RemoveMeetup.
+func (mr *MockMeetupServiceMockRecorder) RemoveMeetup(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveMeetup", reflect.TypeOf((*MockMeetupService)(nil).RemoveMeetup), id)
+}
+
+// SendMeetupReminder mocks base method.
+func (m *MockMeetupService) SendMeetupReminder(j *domain.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendMeetupReminder", j)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMeetupReminder indicates an expected call of SendMeetupReminder.
+func (mr *MockMeetupServiceMockRecorder) SendMeetupReminder(j interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMeetupReminder", reflect.TypeOf((*MockMeetupService)(nil).SendMeetupReminder), j)
+}
+
 // UpdateMeetup mocks base method.
-func (m *MockMeetupService) UpdateMeetup(uid string, dto *domain.UpdateMeetupDTO) (*domain.Meetup, error) {
+func (m *MockMeetupService) UpdateMeetup(uid, id string, dto *domain.UpdateMeetupDTO) (*domain.Meetup, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateMeetup", uid, dto)
+	ret := m.ctrl.Call(m, "UpdateMeetup", uid, id, dto)
 	ret0, _ := ret[0].(*domain.Meetup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // UpdateMeetup indicates an expected call of UpdateMeetup.
-func (mr *MockMeetupServiceMockRecorder) UpdateMeetup(uid, dto interface{}) *gomock.Call {
+func (mr *MockMeetupServiceMockRecorder) UpdateMeetup(uid, id, dto interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMeetup", reflect.TypeOf((*MockMeetupService)(nil).UpdateMeetup), uid, dto)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMeetup", reflect.TypeOf((*MockMeetupService)(nil).UpdateMeetup), uid, id, dto)
 }
 
 // MockMeetupRepository is a mock of MeetupRepository interface.
@@ -116,6 +188,20 @@ func (m *MockMeetupRepository) EXPECT() *MockMeetupRepositoryMockRecorder {
 	return m.recorder
 }
 
+// AddParticipant mocks base method.
+func (m *MockMeetupRepository) AddParticipant(meetupID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddParticipant", meetupID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddParticipant indicates an expected call of AddParticipant.
+func (mr *MockMeetupRepositoryMockRecorder) AddParticipant(meetupID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddParticipant", reflect.TypeOf((*MockMeetupRepository)(nil).AddParticipant), meetupID, userID)
+}
+
 // CreateMeetup mocks base method.
 func (m_2 *MockMeetupRepository) CreateMeetup(m *domain.Meetup) error {
 	m_2.ctrl.T.Helper()
@@ -144,6 +230,21 @@ func (mr *MockMeetupRepositoryMockRecorder) DeleteMeetup(id interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMeetup", reflect.TypeOf((*MockMeetupRepository)(nil).DeleteMeetup), id)
 }
 
+// GetDiscoverableMeetups mocks base method.
+func (m *MockMeetupRepository) GetDiscoverableMeetups(from time.Time, excludeOwnerIDs []string, after string, limit int) ([]*domain.Meetup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDiscoverableMeetups", from, excludeOwnerIDs, after, limit)
+	ret0, _ := ret[0].([]*domain.Meetup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDiscoverableMeetups indicates an expected call of GetDiscoverableMeetups.
+func (mr *MockMeetupRepositoryMockRecorder) GetDiscoverableMeetups(from, excludeOwnerIDs, after, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiscoverableMeetups", reflect.TypeOf((*MockMeetupRepository)(nil).GetDiscoverableMeetups), from, excludeOwnerIDs, after, limit)
+}
+
 // GetMeetupByID mocks base method.
 func (m *MockMeetupRepository) GetMeetupByID(id string) (*domain.Meetup, error) {
 	m.ctrl.T.Helper()
@@ -159,6 +260,65 @@ func (mr *MockMeetupRepositoryMockRecorder) GetMeetupByID(id interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMeetupByID", reflect.TypeOf((*MockMeetupRepository)(nil).GetMeetupByID), id)
 }
 
+// GetParticipantIDs mocks base method.
+func (m *MockMeetupRepository) GetParticipantIDs(meetupID string) ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetParticipantIDs", meetupID)
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetParticipantIDs indicates an expected call of GetParticipantIDs.
+func (mr *MockMeetupRepositoryMockRecorder) GetParticipantIDs(meetupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetParticipantIDs", reflect.TypeOf((*MockMeetupRepository)(nil).GetParticipantIDs), meetupID)
+}
+
+// GetUpcomingMeetupsByParticipant mocks base method.
+func (m *MockMeetupRepository) GetUpcomingMeetupsByParticipant(userID string, from, to time.Time) ([]*domain.Meetup, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpcomingMeetupsByParticipant", userID, from, to)
+	ret0, _ := ret[0].([]*domain.Meetup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpcomingMeetupsByParticipant indicates an expected call of GetUpcomingMeetupsByParticipant.
+func (mr *MockMeetupRepositoryMockRecorder) GetUpcomingMeetupsByParticipant(userID, from, to interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpcomingMeetupsByParticipant", reflect.TypeOf((*MockMeetupRepository)(nil).GetUpcomingMeetupsByParticipant), userID, from, to)
+}
+
+// IsParticipant mocks base method.
+func (m *MockMeetupRepository) IsParticipant(meetupID, userID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsParticipant", meetupID, userID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsParticipant indicates an expected call of IsParticipant.
+func (mr *MockMeetupRepositoryMockRecorder) IsParticipant(meetupID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsParticipant", reflect.TypeOf((*MockMeetupRepository)(nil).IsParticipant), meetupID, userID)
+}
+
+// RemoveParticipant mocks base method.
+func (m *MockMeetupRepository) RemoveParticipant(meetupID, userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveParticipant", meetupID, userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveParticipant indicates an expected call of RemoveParticipant.
+func (mr *MockMeetupRepositoryMockRecorder) RemoveParticipant(meetupID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveParticipant", reflect.TypeOf((*MockMeetupRepository)(nil).RemoveParticipant), meetupID, userID)
+}
+
 // UpdateMeetup mocks base method.
 func (m_2 *MockMeetupRepository) UpdateMeetup(m *domain.Meetup) error {
 	m_2.ctrl.T.Helper()
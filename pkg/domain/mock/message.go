@@ -0,0 +1,417 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: D:\upmeet.app\server\pkg\domain\message.go
+
+// Package mock_domain is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	domain "github.com/UpMeetApp/server/pkg/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockChatService is a mock of ChatService interface.
+type MockChatService struct {
+	ctrl     *gomock.Controller
+	recorder *MockChatServiceMockRecorder
+}
+
+// MockChatServiceMockRecorder is the mock recorder for MockChatService.
+type MockChatServiceMockRecorder struct {
+	mock *MockChatService
+}
+
+// NewMockChatService creates a new mock instance.
+func NewMockChatService(ctrl *gomock.Controller) *MockChatService {
+	mock := &MockChatService{ctrl: ctrl}
+	mock.recorder = &MockChatServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockChatService) EXPECT() *MockChatServiceMockRecorder {
+	return m.recorder
+}
+
+// AddReaction mocks base method.
+func (m *MockChatService) AddReaction(uid, messageID, emoji string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReaction", uid, messageID, emoji)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddReaction indicates an expected call of AddReaction.
+func (mr *MockChatServiceMockRecorder) AddReaction(uid, messageID, emoji interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReaction", reflect.TypeOf((*MockChatService)(nil).AddReaction), uid, messageID, emoji)
+}
+
+// CheckMeetupChatAccess mocks base method.
+func (m *MockChatService) CheckMeetupChatAccess(uid, meetupID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckMeetupChatAccess", uid, meetupID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckMeetupChatAccess indicates an expected call of CheckMeetupChatAccess.
+func (mr *MockChatServiceMockRecorder) CheckMeetupChatAccess(uid, meetupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckMeetupChatAccess", reflect.TypeOf((*MockChatService)(nil).CheckMeetupChatAccess), uid, meetupID)
+}
+
+// CreateMeetupMessage mocks base method.
+func (m *MockChatService) CreateMeetupMessage(uid, meetupID string, dto *domain.CreateMessageDTO) (*domain.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMeetupMessage", uid, meetupID, dto)
+	ret0, _ := ret[0].(*domain.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMeetupMessage indicates an expected call of CreateMeetupMessage.
+func (mr *MockChatServiceMockRecorder) CreateMeetupMessage(uid, meetupID, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMeetupMessage", reflect.TypeOf((*MockChatService)(nil).CreateMeetupMessage), uid, meetupID, dto)
+}
+
+// DeleteMessage mocks base method.
+func (m *MockChatService) DeleteMessage(uid, messageID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMessage", uid, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteMessage indicates an expected call of DeleteMessage.
+func (mr *MockChatServiceMockRecorder) DeleteMessage(uid, messageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMessage", reflect.TypeOf((*MockChatService)(nil).DeleteMessage), uid, messageID)
+}
+
+// GetMeetupMessages mocks base method.
+func (m *MockChatService) GetMeetupMessages(uid, meetupID, before string, limit int) ([]*domain.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMeetupMessages", uid, meetupID, before, limit)
+	ret0, _ := ret[0].([]*domain.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMeetupMessages indicates an expected call of GetMeetupMessages.
+func (mr *MockChatServiceMockRecorder) GetMeetupMessages(uid, meetupID, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMeetupMessages", reflect.TypeOf((*MockChatService)(nil).GetMeetupMessages), uid, meetupID, before, limit)
+}
+
+// GetMeetupReadMarkers mocks base method.
+func (m *MockChatService) GetMeetupReadMarkers(uid, meetupID string) ([]*domain.ReadMarker, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMeetupReadMarkers", uid, meetupID)
+	ret0, _ := ret[0].([]*domain.ReadMarker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMeetupReadMarkers indicates an expected call of GetMeetupReadMarkers.
+func (mr *MockChatServiceMockRecorder) GetMeetupReadMarkers(uid, meetupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMeetupReadMarkers", reflect.TypeOf((*MockChatService)(nil).GetMeetupReadMarkers), uid, meetupID)
+}
+
+// MarkRead mocks base method.
+func (m *MockChatService) MarkRead(uid, messageID string) (*domain.ReadMarker, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkRead", uid, messageID)
+	ret0, _ := ret[0].(*domain.ReadMarker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkRead indicates an expected call of MarkRead.
+func (mr *MockChatServiceMockRecorder) MarkRead(uid, messageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkRead", reflect.TypeOf((*MockChatService)(nil).MarkRead), uid, messageID)
+}
+
+// RemoveReaction mocks base method.
+func (m *MockChatService) RemoveReaction(uid, messageID, emoji string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReaction", uid, messageID, emoji)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveReaction indicates an expected call of RemoveReaction.
+func (mr *MockChatServiceMockRecorder) RemoveReaction(uid, messageID, emoji interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReaction", reflect.TypeOf((*MockChatService)(nil).RemoveReaction), uid, messageID, emoji)
+}
+
+// SubscribeMeetupChat mocks base method.
+func (m *MockChatService) SubscribeMeetupChat(uid, meetupID string) (domain.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubscribeMeetupChat", uid, meetupID)
+	ret0, _ := ret[0].(domain.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubscribeMeetupChat indicates an expected call of SubscribeMeetupChat.
+func (mr *MockChatServiceMockRecorder) SubscribeMeetupChat(uid, meetupID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubscribeMeetupChat", reflect.TypeOf((*MockChatService)(nil).SubscribeMeetupChat), uid, meetupID)
+}
+
+// UpdateMessage mocks base method.
+func (m *MockChatService) UpdateMessage(uid, messageID string, dto *domain.UpdateMessageDTO) (*domain.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateMessage", uid, messageID, dto)
+	ret0, _ := ret[0].(*domain.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateMessage indicates an expected call of UpdateMessage.
+func (mr *MockChatServiceMockRecorder) UpdateMessage(uid, messageID, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMessage", reflect.TypeOf((*MockChatService)(nil).UpdateMessage), uid, messageID, dto)
+}
+
+// MockMessageRepository is a mock of MessageRepository interface.
+type MockMessageRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMessageRepositoryMockRecorder
+}
+
+// MockMessageRepositoryMockRecorder is the mock recorder for MockMessageRepository.
+type MockMessageRepositoryMockRecorder struct {
+	mock *MockMessageRepository
+}
+
+// NewMockMessageRepository creates a new mock instance.
+func NewMockMessageRepository(ctrl *gomock.Controller) *MockMessageRepository {
+	mock := &MockMessageRepository{ctrl: ctrl}
+	mock.recorder = &MockMessageRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMessageRepository) EXPECT() *MockMessageRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddReaction mocks base method.
+func (m *MockMessageRepository) AddReaction(r *domain.Reaction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddReaction", r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddReaction indicates an expected call of AddReaction.
+func (mr *MockMessageRepositoryMockRecorder) AddReaction(r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddReaction", reflect.TypeOf((*MockMessageRepository)(nil).AddReaction), r)
+}
+
+// CountUnreadConversationMessages mocks base method.
+func (m *MockMessageRepository) CountUnreadConversationMessages(userID string, conversationIDs []string) (map[string]int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CountUnreadConversationMessages", userID, conversationIDs)
+	ret0, _ := ret[0].(map[string]int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CountUnreadConversationMessages indicates an expected call of CountUnreadConversationMessages.
+func (mr *MockMessageRepositoryMockRecorder) CountUnreadConversationMessages(userID, conversationIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CountUnreadConversationMessages", reflect.TypeOf((*MockMessageRepository)(nil).CountUnreadConversationMessages), userID, conversationIDs)
+}
+
+// CreateMessage mocks base method.
+func (m_2 *MockMessageRepository) CreateMessage(m *domain.Message) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "CreateMessage", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateMessage indicates an expected call of CreateMessage.
+func (mr *MockMessageRepositoryMockRecorder) CreateMessage(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMessage", reflect.TypeOf((*MockMessageRepository)(nil).CreateMessage), m)
+}
+
+// GetLastConversationMessages mocks base method.
+func (m *MockMessageRepository) GetLastConversationMessages(conversationIDs []string) ([]*domain.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastConversationMessages", conversationIDs)
+	ret0, _ := ret[0].([]*domain.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastConversationMessages indicates an expected call of GetLastConversationMessages.
+func (mr *MockMessageRepositoryMockRecorder) GetLastConversationMessages(conversationIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastConversationMessages", reflect.TypeOf((*MockMessageRepository)(nil).GetLastConversationMessages), conversationIDs)
+}
+
+// GetLastMessageByConversationID mocks base method.
+func (m *MockMessageRepository) GetLastMessageByConversationID(conversationID string) (*domain.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLastMessageByConversationID", conversationID)
+	ret0, _ := ret[0].(*domain.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLastMessageByConversationID indicates an expected call of GetLastMessageByConversationID.
+func (mr *MockMessageRepositoryMockRecorder) GetLastMessageByConversationID(conversationID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLastMessageByConversationID", reflect.TypeOf((*MockMessageRepository)(nil).GetLastMessageByConversationID), conversationID)
+}
+
+// GetMessageByID mocks base method.
+func (m *MockMessageRepository) GetMessageByID(id string) (*domain.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessageByID", id)
+	ret0, _ := ret[0].(*domain.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessageByID indicates an expected call of GetMessageByID.
+func (mr *MockMessageRepositoryMockRecorder) GetMessageByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessageByID", reflect.TypeOf((*MockMessageRepository)(nil).GetMessageByID), id)
+}
+
+// GetMessagesByConversationID mocks base method.
+func (m *MockMessageRepository) GetMessagesByConversationID(conversationID, before string, limit int) ([]*domain.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessagesByConversationID", conversationID, before, limit)
+	ret0, _ := ret[0].([]*domain.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessagesByConversationID indicates an expected call of GetMessagesByConversationID.
+func (mr *MockMessageRepositoryMockRecorder) GetMessagesByConversationID(conversationID, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesByConversationID", reflect.TypeOf((*MockMessageRepository)(nil).GetMessagesByConversationID), conversationID, before, limit)
+}
+
+// GetMessagesByMeetupID mocks base method.
+func (m *MockMessageRepository) GetMessagesByMeetupID(meetupID, before string, limit int) ([]*domain.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMessagesByMeetupID", meetupID, before, limit)
+	ret0, _ := ret[0].([]*domain.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMessagesByMeetupID indicates an expected call of GetMessagesByMeetupID.
+func (mr *MockMessageRepositoryMockRecorder) GetMessagesByMeetupID(meetupID, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMessagesByMeetupID", reflect.TypeOf((*MockMessageRepository)(nil).GetMessagesByMeetupID), meetupID, before, limit)
+}
+
+// RemoveReaction mocks base method.
+func (m *MockMessageRepository) RemoveReaction(messageID, userID, emoji string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveReaction", messageID, userID, emoji)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveReaction indicates an expected call of RemoveReaction.
+func (mr *MockMessageRepositoryMockRecorder) RemoveReaction(messageID, userID, emoji interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveReaction", reflect.TypeOf((*MockMessageRepository)(nil).RemoveReaction), messageID, userID, emoji)
+}
+
+// UpdateMessage mocks base method.
+func (m_2 *MockMessageRepository) UpdateMessage(m *domain.Message) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "UpdateMessage", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateMessage indicates an expected call of UpdateMessage.
+func (mr *MockMessageRepositoryMockRecorder) UpdateMessage(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateMessage", reflect.TypeOf((*MockMessageRepository)(nil).UpdateMessage), m)
+}
+
+// MockReadMarkerRepository is a mock of ReadMarkerRepository interface.
+type MockReadMarkerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReadMarkerRepositoryMockRecorder
+}
+
+// MockReadMarkerRepositoryMockRecorder is the mock recorder for MockReadMarkerRepository.
+type MockReadMarkerRepositoryMockRecorder struct {
+	mock *MockReadMarkerRepository
+}
+
+// NewMockReadMarkerRepository creates a new mock instance.
+func NewMockReadMarkerRepository(ctrl *gomock.Controller) *MockReadMarkerRepository {
+	mock := &MockReadMarkerRepository{ctrl: ctrl}
+	mock.recorder = &MockReadMarkerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReadMarkerRepository) EXPECT() *MockReadMarkerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetReadMarker mocks base method.
+func (m *MockReadMarkerRepository) GetReadMarker(chatID, userID string) (*domain.ReadMarker, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadMarker", chatID, userID)
+	ret0, _ := ret[0].(*domain.ReadMarker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReadMarker indicates an expected call of GetReadMarker.
+func (mr *MockReadMarkerRepositoryMockRecorder) GetReadMarker(chatID, userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadMarker", reflect.TypeOf((*MockReadMarkerRepository)(nil).GetReadMarker), chatID, userID)
+}
+
+// GetReadMarkersByChatID mocks base method.
+func (m *MockReadMarkerRepository) GetReadMarkersByChatID(chatID string) ([]*domain.ReadMarker, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReadMarkersByChatID", chatID)
+	ret0, _ := ret[0].([]*domain.ReadMarker)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReadMarkersByChatID indicates an expected call of GetReadMarkersByChatID.
+func (mr *MockReadMarkerRepositoryMockRecorder) GetReadMarkersByChatID(chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReadMarkersByChatID", reflect.TypeOf((*MockReadMarkerRepository)(nil).GetReadMarkersByChatID), chatID)
+}
+
+// SaveReadMarker mocks base method.
+func (m *MockReadMarkerRepository) SaveReadMarker(rm *domain.ReadMarker) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveReadMarker", rm)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveReadMarker indicates an expected call of SaveReadMarker.
+func (mr *MockReadMarkerRepositoryMockRecorder) SaveReadMarker(rm interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveReadMarker", reflect.TypeOf((*MockReadMarkerRepository)(nil).SaveReadMarker), rm)
+}
@@ -0,0 +1,504 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: D:\upmeet.app\server\pkg\domain\moderation.go
+
+// Package mock_domain is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	domain "github.com/UpMeetApp/server/pkg/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockModerationService is a mock of ModerationService interface.
+type MockModerationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockModerationServiceMockRecorder
+}
+
+// MockModerationServiceMockRecorder is the mock recorder for MockModerationService.
+type MockModerationServiceMockRecorder struct {
+	mock *MockModerationService
+}
+
+// NewMockModerationService creates a new mock instance.
+func NewMockModerationService(ctrl *gomock.Controller) *MockModerationService {
+	mock := &MockModerationService{ctrl: ctrl}
+	mock.recorder = &MockModerationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModerationService) EXPECT() *MockModerationServiceMockRecorder {
+	return m.recorder
+}
+
+// AssignReport mocks base method.
+func (m *MockModerationService) AssignReport(adminID, id string, dto *domain.AssignReportDTO) (*domain.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignReport", adminID, id, dto)
+	ret0, _ := ret[0].(*domain.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignReport indicates an expected call of AssignReport.
+func (mr *MockModerationServiceMockRecorder) AssignReport(adminID, id, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignReport", reflect.TypeOf((*MockModerationService)(nil).AssignReport), adminID, id, dto)
+}
+
+// CreateAppeal mocks base method.
+func (m *MockModerationService) CreateAppeal(uid string, dto *domain.CreateAppealDTO) (*domain.Appeal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAppeal", uid, dto)
+	ret0, _ := ret[0].(*domain.Appeal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAppeal indicates an expected call of CreateAppeal.
+func (mr *MockModerationServiceMockRecorder) CreateAppeal(uid, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAppeal", reflect.TypeOf((*MockModerationService)(nil).CreateAppeal), uid, dto)
+}
+
+// CreateReport mocks base method.
+func (m *MockModerationService) CreateReport(uid string, dto *domain.CreateReportDTO) (*domain.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReport", uid, dto)
+	ret0, _ := ret[0].(*domain.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReport indicates an expected call of CreateReport.
+func (mr *MockModerationServiceMockRecorder) CreateReport(uid, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReport", reflect.TypeOf((*MockModerationService)(nil).CreateReport), uid, dto)
+}
+
+// GetAppeal mocks base method.
+func (m *MockModerationService) GetAppeal(id string) (*domain.Appeal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppeal", id)
+	ret0, _ := ret[0].(*domain.Appeal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppeal indicates an expected call of GetAppeal.
+func (mr *MockModerationServiceMockRecorder) GetAppeal(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppeal", reflect.TypeOf((*MockModerationService)(nil).GetAppeal), id)
+}
+
+// GetAppeals mocks base method.
+func (m *MockModerationService) GetAppeals(filter *domain.AppealFilter) ([]*domain.Appeal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppeals", filter)
+	ret0, _ := ret[0].([]*domain.Appeal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppeals indicates an expected call of GetAppeals.
+func (mr *MockModerationServiceMockRecorder) GetAppeals(filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppeals", reflect.TypeOf((*MockModerationService)(nil).GetAppeals), filter)
+}
+
+// GetModerationActions mocks base method.
+func (m *MockModerationService) GetModerationActions(filter *domain.ModerationActionFilter) ([]*domain.ModerationAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModerationActions", filter)
+	ret0, _ := ret[0].([]*domain.ModerationAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModerationActions indicates an expected call of GetModerationActions.
+func (mr *MockModerationServiceMockRecorder) GetModerationActions(filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerationActions", reflect.TypeOf((*MockModerationService)(nil).GetModerationActions), filter)
+}
+
+// GetReport mocks base method.
+func (m *MockModerationService) GetReport(id string) (*domain.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReport", id)
+	ret0, _ := ret[0].(*domain.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReport indicates an expected call of GetReport.
+func (mr *MockModerationServiceMockRecorder) GetReport(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReport", reflect.TypeOf((*MockModerationService)(nil).GetReport), id)
+}
+
+// GetReports mocks base method.
+func (m *MockModerationService) GetReports(filter *domain.ReportFilter) ([]*domain.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReports", filter)
+	ret0, _ := ret[0].([]*domain.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReports indicates an expected call of GetReports.
+func (mr *MockModerationServiceMockRecorder) GetReports(filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReports", reflect.TypeOf((*MockModerationService)(nil).GetReports), filter)
+}
+
+// GetUserAppeals mocks base method.
+func (m *MockModerationService) GetUserAppeals(uid string) ([]*domain.Appeal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAppeals", uid)
+	ret0, _ := ret[0].([]*domain.Appeal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAppeals indicates an expected call of GetUserAppeals.
+func (mr *MockModerationServiceMockRecorder) GetUserAppeals(uid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAppeals", reflect.TypeOf((*MockModerationService)(nil).GetUserAppeals), uid)
+}
+
+// GetUserModerationActions mocks base method.
+func (m *MockModerationService) GetUserModerationActions(uid string) ([]*domain.ModerationAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserModerationActions", uid)
+	ret0, _ := ret[0].([]*domain.ModerationAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserModerationActions indicates an expected call of GetUserModerationActions.
+func (mr *MockModerationServiceMockRecorder) GetUserModerationActions(uid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserModerationActions", reflect.TypeOf((*MockModerationService)(nil).GetUserModerationActions), uid)
+}
+
+// ResolveAppeal mocks base method.
+func (m *MockModerationService) ResolveAppeal(adminID, id string, dto *domain.ResolveAppealDTO) (*domain.Appeal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveAppeal", adminID, id, dto)
+	ret0, _ := ret[0].(*domain.Appeal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveAppeal indicates an expected call of ResolveAppeal.
+func (mr *MockModerationServiceMockRecorder) ResolveAppeal(adminID, id, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveAppeal", reflect.TypeOf((*MockModerationService)(nil).ResolveAppeal), adminID, id, dto)
+}
+
+// ResolveReport mocks base method.
+func (m *MockModerationService) ResolveReport(adminID, id string, dto *domain.ResolveReportDTO) (*domain.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveReport", adminID, id, dto)
+	ret0, _ := ret[0].(*domain.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveReport indicates an expected call of ResolveReport.
+func (mr *MockModerationServiceMockRecorder) ResolveReport(adminID, id, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveReport", reflect.TypeOf((*MockModerationService)(nil).ResolveReport), adminID, id, dto)
+}
+
+// UnassignReport mocks base method.
+func (m *MockModerationService) UnassignReport(adminID, id string) (*domain.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassignReport", adminID, id)
+	ret0, _ := ret[0].(*domain.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnassignReport indicates an expected call of UnassignReport.
+func (mr *MockModerationServiceMockRecorder) UnassignReport(adminID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassignReport", reflect.TypeOf((*MockModerationService)(nil).UnassignReport), adminID, id)
+}
+
+// UpdateUserStatus mocks base method.
+func (m *MockModerationService) UpdateUserStatus(adminID, userID string, dto *domain.UpdateUserStatusDTO) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserStatus", adminID, userID, dto)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserStatus indicates an expected call of UpdateUserStatus.
+func (mr *MockModerationServiceMockRecorder) UpdateUserStatus(adminID, userID, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserStatus", reflect.TypeOf((*MockModerationService)(nil).UpdateUserStatus), adminID, userID, dto)
+}
+
+// MockReportRepository is a mock of ReportRepository interface.
+type MockReportRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReportRepositoryMockRecorder
+}
+
+// MockReportRepositoryMockRecorder is the mock recorder for MockReportRepository.
+type MockReportRepositoryMockRecorder struct {
+	mock *MockReportRepository
+}
+
+// NewMockReportRepository creates a new mock instance.
+func NewMockReportRepository(ctrl *gomock.Controller) *MockReportRepository {
+	mock := &MockReportRepository{ctrl: ctrl}
+	mock.recorder = &MockReportRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReportRepository) EXPECT() *MockReportRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateReport mocks base method.
+func (m *MockReportRepository) CreateReport(r *domain.Report) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReport", r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateReport indicates an expected call of CreateReport.
+func (mr *MockReportRepositoryMockRecorder) CreateReport(r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReport", reflect.TypeOf((*MockReportRepository)(nil).CreateReport), r)
+}
+
+// GetOpenReport mocks base method.
+func (m *MockReportRepository) GetOpenReport(reporterID, targetType, targetID string) (*domain.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenReport", reporterID, targetType, targetID)
+	ret0, _ := ret[0].(*domain.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenReport indicates an expected call of GetOpenReport.
+func (mr *MockReportRepositoryMockRecorder) GetOpenReport(reporterID, targetType, targetID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenReport", reflect.TypeOf((*MockReportRepository)(nil).GetOpenReport), reporterID, targetType, targetID)
+}
+
+// GetReportByID mocks base method.
+func (m *MockReportRepository) GetReportByID(id string) (*domain.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReportByID", id)
+	ret0, _ := ret[0].(*domain.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReportByID indicates an expected call of GetReportByID.
+func (mr *MockReportRepositoryMockRecorder) GetReportByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReportByID", reflect.TypeOf((*MockReportRepository)(nil).GetReportByID), id)
+}
+
+// GetReports mocks base method.
+func (m *MockReportRepository) GetReports(filter *domain.ReportFilter) ([]*domain.Report, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReports", filter)
+	ret0, _ := ret[0].([]*domain.Report)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReports indicates an expected call of GetReports.
+func (mr *MockReportRepositoryMockRecorder) GetReports(filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReports", reflect.TypeOf((*MockReportRepository)(nil).GetReports), filter)
+}
+
+// UpdateReport mocks base method.
+func (m *MockReportRepository) UpdateReport(r *domain.Report) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateReport", r)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateReport indicates an expected call of UpdateReport.
+func (mr *MockReportRepositoryMockRecorder) UpdateReport(r interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateReport", reflect.TypeOf((*MockReportRepository)(nil).UpdateReport), r)
+}
+
+// MockModerationActionRepository is a mock of ModerationActionRepository interface.
+type MockModerationActionRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockModerationActionRepositoryMockRecorder
+}
+
+// MockModerationActionRepositoryMockRecorder is the mock recorder for MockModerationActionRepository.
+type MockModerationActionRepositoryMockRecorder struct {
+	mock *MockModerationActionRepository
+}
+
+// NewMockModerationActionRepository creates a new mock instance.
+func NewMockModerationActionRepository(ctrl *gomock.Controller) *MockModerationActionRepository {
+	mock := &MockModerationActionRepository{ctrl: ctrl}
+	mock.recorder = &MockModerationActionRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockModerationActionRepository) EXPECT() *MockModerationActionRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateModerationAction mocks base method.
+func (m *MockModerationActionRepository) CreateModerationAction(a *domain.ModerationAction) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateModerationAction", a)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateModerationAction indicates an expected call of CreateModerationAction.
+func (mr *MockModerationActionRepositoryMockRecorder) CreateModerationAction(a interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateModerationAction", reflect.TypeOf((*MockModerationActionRepository)(nil).CreateModerationAction), a)
+}
+
+// GetModerationActionByID mocks base method.
+func (m *MockModerationActionRepository) GetModerationActionByID(id string) (*domain.ModerationAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModerationActionByID", id)
+	ret0, _ := ret[0].(*domain.ModerationAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModerationActionByID indicates an expected call of GetModerationActionByID.
+func (mr *MockModerationActionRepositoryMockRecorder) GetModerationActionByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerationActionByID", reflect.TypeOf((*MockModerationActionRepository)(nil).GetModerationActionByID), id)
+}
+
+// GetModerationActions mocks base method.
+func (m *MockModerationActionRepository) GetModerationActions(filter *domain.ModerationActionFilter) ([]*domain.ModerationAction, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetModerationActions", filter)
+	ret0, _ := ret[0].([]*domain.ModerationAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetModerationActions indicates an expected call of GetModerationActions.
+func (mr *MockModerationActionRepositoryMockRecorder) GetModerationActions(filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetModerationActions", reflect.TypeOf((*MockModerationActionRepository)(nil).GetModerationActions), filter)
+}
+
+// MockAppealRepository is a mock of AppealRepository interface.
+type MockAppealRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAppealRepositoryMockRecorder
+}
+
+// MockAppealRepositoryMockRecorder is the mock recorder for MockAppealRepository.
+type MockAppealRepositoryMockRecorder struct {
+	mock *MockAppealRepository
+}
+
+// NewMockAppealRepository creates a new mock instance.
+func NewMockAppealRepository(ctrl *gomock.Controller) *MockAppealRepository {
+	mock := &MockAppealRepository{ctrl: ctrl}
+	mock.recorder = &MockAppealRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAppealRepository) EXPECT() *MockAppealRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateAppeal mocks base method.
+func (m *MockAppealRepository) CreateAppeal(a *domain.Appeal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAppeal", a)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAppeal indicates an expected call of CreateAppeal.
+func (mr *MockAppealRepositoryMockRecorder) CreateAppeal(a interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAppeal", reflect.TypeOf((*MockAppealRepository)(nil).CreateAppeal), a)
+}
+
+// GetAppealByActionID mocks base method.
+func (m *MockAppealRepository) GetAppealByActionID(actionID string) (*domain.Appeal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppealByActionID", actionID)
+	ret0, _ := ret[0].(*domain.Appeal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppealByActionID indicates an expected call of GetAppealByActionID.
+func (mr *MockAppealRepositoryMockRecorder) GetAppealByActionID(actionID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppealByActionID", reflect.TypeOf((*MockAppealRepository)(nil).GetAppealByActionID), actionID)
+}
+
+// GetAppealByID mocks base method.
+func (m *MockAppealRepository) GetAppealByID(id string) (*domain.Appeal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppealByID", id)
+	ret0, _ := ret[0].(*domain.Appeal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppealByID indicates an expected call of GetAppealByID.
+func (mr *MockAppealRepositoryMockRecorder) GetAppealByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppealByID", reflect.TypeOf((*MockAppealRepository)(nil).GetAppealByID), id)
+}
+
+// GetAppeals mocks base method.
+func (m *MockAppealRepository) GetAppeals(filter *domain.AppealFilter) ([]*domain.Appeal, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAppeals", filter)
+	ret0, _ := ret[0].([]*domain.Appeal)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAppeals indicates an expected call of GetAppeals.
+func (mr *MockAppealRepositoryMockRecorder) GetAppeals(filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAppeals", reflect.TypeOf((*MockAppealRepository)(nil).GetAppeals), filter)
+}
+
+// UpdateAppeal mocks base method.
+func (m *MockAppealRepository) UpdateAppeal(a *domain.Appeal) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAppeal", a)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAppeal indicates an expected call of UpdateAppeal.
+func (mr *MockAppealRepositoryMockRecorder) UpdateAppeal(a interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAppeal", reflect.TypeOf((*MockAppealRepository)(nil).UpdateAppeal), a)
+}
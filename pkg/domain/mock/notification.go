@@ -0,0 +1,398 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: D:\upmeet.app\server\pkg\domain\notification.go
+
+// Package mock_domain is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+	time "time"
+
+	domain "github.com/UpMeetApp/server/pkg/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockNotificationService is a mock of NotificationService interface.
+type MockNotificationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationServiceMockRecorder
+}
+
+// MockNotificationServiceMockRecorder is the mock recorder for MockNotificationService.
+type MockNotificationServiceMockRecorder struct {
+	mock *MockNotificationService
+}
+
+// NewMockNotificationService creates a new mock instance.
+func NewMockNotificationService(ctrl *gomock.Controller) *MockNotificationService {
+	mock := &MockNotificationService{ctrl: ctrl}
+	mock.recorder = &MockNotificationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationService) EXPECT() *MockNotificationServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteNotification mocks base method.
+func (m *MockNotificationService) DeleteNotification(uid, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotification", uid, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNotification indicates an expected call of DeleteNotification.
+func (mr *MockNotificationServiceMockRecorder) DeleteNotification(uid, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotification", reflect.TypeOf((*MockNotificationService)(nil).DeleteNotification), uid, id)
+}
+
+// GetNotificationSettings mocks base method.
+func (m *MockNotificationService) GetNotificationSettings(uid string) (*domain.NotificationSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationSettings", uid)
+	ret0, _ := ret[0].(*domain.NotificationSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationSettings indicates an expected call of GetNotificationSettings.
+func (mr *MockNotificationServiceMockRecorder) GetNotificationSettings(uid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationSettings", reflect.TypeOf((*MockNotificationService)(nil).GetNotificationSettings), uid)
+}
+
+// GetNotifications mocks base method.
+func (m *MockNotificationService) GetNotifications(uid, before string, limit int) ([]*domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotifications", uid, before, limit)
+	ret0, _ := ret[0].([]*domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotifications indicates an expected call of GetNotifications.
+func (mr *MockNotificationServiceMockRecorder) GetNotifications(uid, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotifications", reflect.TypeOf((*MockNotificationService)(nil).GetNotifications), uid, before, limit)
+}
+
+// MarkAllNotificationsRead mocks base method.
+func (m *MockNotificationService) MarkAllNotificationsRead(uid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllNotificationsRead", uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAllNotificationsRead indicates an expected call of MarkAllNotificationsRead.
+func (mr *MockNotificationServiceMockRecorder) MarkAllNotificationsRead(uid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllNotificationsRead", reflect.TypeOf((*MockNotificationService)(nil).MarkAllNotificationsRead), uid)
+}
+
+// MarkNotificationRead mocks base method.
+func (m *MockNotificationService) MarkNotificationRead(uid, id string) (*domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkNotificationRead", uid, id)
+	ret0, _ := ret[0].(*domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkNotificationRead indicates an expected call of MarkNotificationRead.
+func (mr *MockNotificationServiceMockRecorder) MarkNotificationRead(uid, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkNotificationRead", reflect.TypeOf((*MockNotificationService)(nil).MarkNotificationRead), uid, id)
+}
+
+// Notify mocks base method.
+func (m *MockNotificationService) Notify(userID, notificationType string, payload interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Notify", userID, notificationType, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Notify indicates an expected call of Notify.
+func (mr *MockNotificationServiceMockRecorder) Notify(userID, notificationType, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Notify", reflect.TypeOf((*MockNotificationService)(nil).Notify), userID, notificationType, payload)
+}
+
+// NotifyLater mocks base method.
+func (m *MockNotificationService) NotifyLater(userIDs []string, notificationType string, payload interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyLater", userIDs, notificationType, payload)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// NotifyLater indicates an expected call of NotifyLater.
+func (mr *MockNotificationServiceMockRecorder) NotifyLater(userIDs, notificationType, payload interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyLater", reflect.TypeOf((*MockNotificationService)(nil).NotifyLater), userIDs, notificationType, payload)
+}
+
+// ScheduleWeeklyDigest mocks base method.
+func (m *MockNotificationService) ScheduleWeeklyDigest() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScheduleWeeklyDigest")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ScheduleWeeklyDigest indicates an expected call of ScheduleWeeklyDigest.
+func (mr *MockNotificationServiceMockRecorder) ScheduleWeeklyDigest() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScheduleWeeklyDigest", reflect.TypeOf((*MockNotificationService)(nil).ScheduleWeeklyDigest))
+}
+
+// SendDeferredPushes mocks base method.
+func (m *MockNotificationService) SendDeferredPushes() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendDeferredPushes")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendDeferredPushes indicates an expected call of SendDeferredPushes.
+func (mr *MockNotificationServiceMockRecorder) SendDeferredPushes() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendDeferredPushes", reflect.TypeOf((*MockNotificationService)(nil).SendDeferredPushes))
+}
+
+// SendNotifications mocks base method.
+func (m *MockNotificationService) SendNotifications(j *domain.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendNotifications", j)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendNotifications indicates an expected call of SendNotifications.
+func (mr *MockNotificationServiceMockRecorder) SendNotifications(j interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendNotifications", reflect.TypeOf((*MockNotificationService)(nil).SendNotifications), j)
+}
+
+// SendWeeklyDigests mocks base method.
+func (m *MockNotificationService) SendWeeklyDigests(j *domain.Job) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendWeeklyDigests", j)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendWeeklyDigests indicates an expected call of SendWeeklyDigests.
+func (mr *MockNotificationServiceMockRecorder) SendWeeklyDigests(j interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendWeeklyDigests", reflect.TypeOf((*MockNotificationService)(nil).SendWeeklyDigests), j)
+}
+
+// UpdateNotificationSettings mocks base method.
+func (m *MockNotificationService) UpdateNotificationSettings(uid string, dto *domain.UpdateNotificationSettingsDTO) (*domain.NotificationSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotificationSettings", uid, dto)
+	ret0, _ := ret[0].(*domain.NotificationSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNotificationSettings indicates an expected call of UpdateNotificationSettings.
+func (mr *MockNotificationServiceMockRecorder) UpdateNotificationSettings(uid, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotificationSettings", reflect.TypeOf((*MockNotificationService)(nil).UpdateNotificationSettings), uid, dto)
+}
+
+// MockNotificationRepository is a mock of NotificationRepository interface.
+type MockNotificationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationRepositoryMockRecorder
+}
+
+// MockNotificationRepositoryMockRecorder is the mock recorder for MockNotificationRepository.
+type MockNotificationRepositoryMockRecorder struct {
+	mock *MockNotificationRepository
+}
+
+// NewMockNotificationRepository creates a new mock instance.
+func NewMockNotificationRepository(ctrl *gomock.Controller) *MockNotificationRepository {
+	mock := &MockNotificationRepository{ctrl: ctrl}
+	mock.recorder = &MockNotificationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationRepository) EXPECT() *MockNotificationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDueDeferredPushes mocks base method.
+func (m *MockNotificationRepository) ClaimDueDeferredPushes(now time.Time, limit int) ([]*domain.DeferredPush, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueDeferredPushes", now, limit)
+	ret0, _ := ret[0].([]*domain.DeferredPush)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDueDeferredPushes indicates an expected call of ClaimDueDeferredPushes.
+func (mr *MockNotificationRepositoryMockRecorder) ClaimDueDeferredPushes(now, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueDeferredPushes", reflect.TypeOf((*MockNotificationRepository)(nil).ClaimDueDeferredPushes), now, limit)
+}
+
+// CreateDeferredPush mocks base method.
+func (m *MockNotificationRepository) CreateDeferredPush(p *domain.DeferredPush) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeferredPush", p)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateDeferredPush indicates an expected call of CreateDeferredPush.
+func (mr *MockNotificationRepositoryMockRecorder) CreateDeferredPush(p interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeferredPush", reflect.TypeOf((*MockNotificationRepository)(nil).CreateDeferredPush), p)
+}
+
+// CreateNotification mocks base method.
+func (m *MockNotificationRepository) CreateNotification(n *domain.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotification", n)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNotification indicates an expected call of CreateNotification.
+func (mr *MockNotificationRepositoryMockRecorder) CreateNotification(n interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotification", reflect.TypeOf((*MockNotificationRepository)(nil).CreateNotification), n)
+}
+
+// DeleteNotification mocks base method.
+func (m *MockNotificationRepository) DeleteNotification(id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotification", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNotification indicates an expected call of DeleteNotification.
+func (mr *MockNotificationRepositoryMockRecorder) DeleteNotification(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotification", reflect.TypeOf((*MockNotificationRepository)(nil).DeleteNotification), id)
+}
+
+// GetNotificationByID mocks base method.
+func (m *MockNotificationRepository) GetNotificationByID(id string) (*domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationByID", id)
+	ret0, _ := ret[0].(*domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationByID indicates an expected call of GetNotificationByID.
+func (mr *MockNotificationRepositoryMockRecorder) GetNotificationByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationByID", reflect.TypeOf((*MockNotificationRepository)(nil).GetNotificationByID), id)
+}
+
+// GetNotificationsByUserID mocks base method.
+func (m *MockNotificationRepository) GetNotificationsByUserID(userID, before string, limit int) ([]*domain.Notification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationsByUserID", userID, before, limit)
+	ret0, _ := ret[0].([]*domain.Notification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationsByUserID indicates an expected call of GetNotificationsByUserID.
+func (mr *MockNotificationRepositoryMockRecorder) GetNotificationsByUserID(userID, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationsByUserID", reflect.TypeOf((*MockNotificationRepository)(nil).GetNotificationsByUserID), userID, before, limit)
+}
+
+// MarkAllNotificationsRead mocks base method.
+func (m *MockNotificationRepository) MarkAllNotificationsRead(userID string, readAt time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAllNotificationsRead", userID, readAt)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAllNotificationsRead indicates an expected call of MarkAllNotificationsRead.
+func (mr *MockNotificationRepositoryMockRecorder) MarkAllNotificationsRead(userID, readAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAllNotificationsRead", reflect.TypeOf((*MockNotificationRepository)(nil).MarkAllNotificationsRead), userID, readAt)
+}
+
+// UpdateNotification mocks base method.
+func (m *MockNotificationRepository) UpdateNotification(n *domain.Notification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNotification", n)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateNotification indicates an expected call of UpdateNotification.
+func (mr *MockNotificationRepositoryMockRecorder) UpdateNotification(n interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotification", reflect.TypeOf((*MockNotificationRepository)(nil).UpdateNotification), n)
+}
+
+// MockNotificationSettingsRepository is a mock of NotificationSettingsRepository interface.
+type MockNotificationSettingsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockNotificationSettingsRepositoryMockRecorder
+}
+
+// MockNotificationSettingsRepositoryMockRecorder is the mock recorder for MockNotificationSettingsRepository.
+type MockNotificationSettingsRepositoryMockRecorder struct {
+	mock *MockNotificationSettingsRepository
+}
+
+// NewMockNotificationSettingsRepository creates a new mock instance.
+func NewMockNotificationSettingsRepository(ctrl *gomock.Controller) *MockNotificationSettingsRepository {
+	mock := &MockNotificationSettingsRepository{ctrl: ctrl}
+	mock.recorder = &MockNotificationSettingsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockNotificationSettingsRepository) EXPECT() *MockNotificationSettingsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetNotificationSettingsByUserID mocks base method.
+func (m *MockNotificationSettingsRepository) GetNotificationSettingsByUserID(userID string) (*domain.NotificationSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotificationSettingsByUserID", userID)
+	ret0, _ := ret[0].(*domain.NotificationSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotificationSettingsByUserID indicates an expected call of GetNotificationSettingsByUserID.
+func (mr *MockNotificationSettingsRepositoryMockRecorder) GetNotificationSettingsByUserID(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotificationSettingsByUserID", reflect.TypeOf((*MockNotificationSettingsRepository)(nil).GetNotificationSettingsByUserID), userID)
+}
+
+// SaveNotificationSettings mocks base method.
+func (m *MockNotificationSettingsRepository) SaveNotificationSettings(s *domain.NotificationSettings) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveNotificationSettings", s)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveNotificationSettings indicates an expected call of SaveNotificationSettings.
+func (mr *MockNotificationSettingsRepositoryMockRecorder) SaveNotificationSettings(s interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveNotificationSettings", reflect.TypeOf((*MockNotificationSettingsRepository)(nil).SaveNotificationSettings), s)
+}
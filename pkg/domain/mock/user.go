@@ -34,6 +34,34 @@ func (m *MockUserService) EXPECT() *MockUserServiceMockRecorder {
 	return m.recorder
 }
 
+// ChangeEmail mocks base method.
+func (m *MockUserService) ChangeEmail(uid string, dto *domain.ChangeEmailDTO) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeEmail", uid, dto)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ChangeEmail indicates an expected call of ChangeEmail.
+func (mr *MockUserServiceMockRecorder) ChangeEmail(uid, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeEmail", reflect.TypeOf((*MockUserService)(nil).ChangeEmail), uid, dto)
+}
+
+// CheckUserStatus mocks base method.
+func (m *MockUserService) CheckUserStatus(uid string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckUserStatus", uid)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckUserStatus indicates an expected call of CheckUserStatus.
+func (mr *MockUserServiceMockRecorder) CheckUserStatus(uid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckUserStatus", reflect.TypeOf((*MockUserService)(nil).CheckUserStatus), uid)
+}
+
 // CreateUser mocks base method.
 func (m *MockUserService) CreateUser(uid string, dto *domain.CreateUserDTO) (*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -78,6 +106,36 @@ func (mr *MockUserServiceMockRecorder) GetUserByID(uid interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserService)(nil).GetUserByID), uid)
 }
 
+// GetUserProfile mocks base method.
+func (m *MockUserService) GetUserProfile(uid, username string) (*domain.UserProfile, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserProfile", uid, username)
+	ret0, _ := ret[0].(*domain.UserProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserProfile indicates an expected call of GetUserProfile.
+func (mr *MockUserServiceMockRecorder) GetUserProfile(uid, username interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserProfile", reflect.TypeOf((*MockUserService)(nil).GetUserProfile), uid, username)
+}
+
+// SearchUsers mocks base method.
+func (m *MockUserService) SearchUsers(uid, query string) ([]*domain.UserPreview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUsers", uid, query)
+	ret0, _ := ret[0].([]*domain.UserPreview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUsers indicates an expected call of SearchUsers.
+func (mr *MockUserServiceMockRecorder) SearchUsers(uid, query interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUsers", reflect.TypeOf((*MockUserService)(nil).SearchUsers), uid, query)
+}
+
 // UpdateUser mocks base method.
 func (m *MockUserService) UpdateUser(uid string, dto *domain.UpdateUserDTO) (*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -93,6 +151,21 @@ func (mr *MockUserServiceMockRecorder) UpdateUser(uid, dto interface{}) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserService)(nil).UpdateUser), uid, dto)
 }
 
+// VerifyEmail mocks base method.
+func (m *MockUserService) VerifyEmail(uid string, dto *domain.VerifyEmailDTO) (*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyEmail", uid, dto)
+	ret0, _ := ret[0].(*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyEmail indicates an expected call of VerifyEmail.
+func (mr *MockUserServiceMockRecorder) VerifyEmail(uid, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyEmail", reflect.TypeOf((*MockUserService)(nil).VerifyEmail), uid, dto)
+}
+
 // MockUserRepository is a mock of UserRepository interface.
 type MockUserRepository struct {
 	ctrl     *gomock.Controller
@@ -189,6 +262,21 @@ func (mr *MockUserRepositoryMockRecorder) GetUserByUsername(username interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByUsername", reflect.TypeOf((*MockUserRepository)(nil).GetUserByUsername), username)
 }
 
+// GetUsersWithEmail mocks base method.
+func (m *MockUserRepository) GetUsersWithEmail(afterID string, limit int) ([]*domain.User, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUsersWithEmail", afterID, limit)
+	ret0, _ := ret[0].([]*domain.User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUsersWithEmail indicates an expected call of GetUsersWithEmail.
+func (mr *MockUserRepositoryMockRecorder) GetUsersWithEmail(afterID, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUsersWithEmail", reflect.TypeOf((*MockUserRepository)(nil).GetUsersWithEmail), afterID, limit)
+}
+
 // SearchUsersByName mocks base method.
 func (m *MockUserRepository) SearchUsersByName(name string) ([]*domain.User, error) {
 	m.ctrl.T.Helper()
@@ -232,3 +320,83 @@ func (mr *MockUserRepositoryMockRecorder) UpdateUser(u interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserRepository)(nil).UpdateUser), u)
 }
+
+// UpdateUserStatus mocks base method.
+func (m *MockUserRepository) UpdateUserStatus(u *domain.User) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserStatus", u)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUserStatus indicates an expected call of UpdateUserStatus.
+func (mr *MockUserRepositoryMockRecorder) UpdateUserStatus(u interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserStatus", reflect.TypeOf((*MockUserRepository)(nil).UpdateUserStatus), u)
+}
+
+// MockEmailVerificationRepository is a mock of EmailVerificationRepository interface.
+type MockEmailVerificationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockEmailVerificationRepositoryMockRecorder
+}
+
+// MockEmailVerificationRepositoryMockRecorder is the mock recorder for MockEmailVerificationRepository.
+type MockEmailVerificationRepositoryMockRecorder struct {
+	mock *MockEmailVerificationRepository
+}
+
+// NewMockEmailVerificationRepository creates a new mock instance.
+func NewMockEmailVerificationRepository(ctrl *gomock.Controller) *MockEmailVerificationRepository {
+	mock := &MockEmailVerificationRepository{ctrl: ctrl}
+	mock.recorder = &MockEmailVerificationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockEmailVerificationRepository) EXPECT() *MockEmailVerificationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateEmailVerification mocks base method.
+func (m *MockEmailVerificationRepository) CreateEmailVerification(v *domain.EmailVerification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEmailVerification", v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateEmailVerification indicates an expected call of CreateEmailVerification.
+func (mr *MockEmailVerificationRepositoryMockRecorder) CreateEmailVerification(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEmailVerification", reflect.TypeOf((*MockEmailVerificationRepository)(nil).CreateEmailVerification), v)
+}
+
+// DeleteEmailVerificationsByUserID mocks base method.
+func (m *MockEmailVerificationRepository) DeleteEmailVerificationsByUserID(userID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmailVerificationsByUserID", userID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEmailVerificationsByUserID indicates an expected call of DeleteEmailVerificationsByUserID.
+func (mr *MockEmailVerificationRepositoryMockRecorder) DeleteEmailVerificationsByUserID(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmailVerificationsByUserID", reflect.TypeOf((*MockEmailVerificationRepository)(nil).DeleteEmailVerificationsByUserID), userID)
+}
+
+// GetEmailVerification mocks base method.
+func (m *MockEmailVerificationRepository) GetEmailVerification(tokenHash string) (*domain.EmailVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmailVerification", tokenHash)
+	ret0, _ := ret[0].(*domain.EmailVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmailVerification indicates an expected call of GetEmailVerification.
+func (mr *MockEmailVerificationRepositoryMockRecorder) GetEmailVerification(tokenHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailVerification", reflect.TypeOf((*MockEmailVerificationRepository)(nil).GetEmailVerification), tokenHash)
+}
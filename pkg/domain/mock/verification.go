@@ -0,0 +1,206 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: D:\upmeet.app\server\pkg\domain\verification.go
+
+// Package mock_domain is a generated GoMock package.
+package mock
+
+import (
+	reflect "reflect"
+
+	domain "github.com/UpMeetApp/server/pkg/domain"
+	gomock "github.com/golang/mock/gomock"
+)
+
+// MockAgeVerificationService is a mock of AgeVerificationService interface.
+type MockAgeVerificationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAgeVerificationServiceMockRecorder
+}
+
+// MockAgeVerificationServiceMockRecorder is the mock recorder for MockAgeVerificationService.
+type MockAgeVerificationServiceMockRecorder struct {
+	mock *MockAgeVerificationService
+}
+
+// NewMockAgeVerificationService creates a new mock instance.
+func NewMockAgeVerificationService(ctrl *gomock.Controller) *MockAgeVerificationService {
+	mock := &MockAgeVerificationService{ctrl: ctrl}
+	mock.recorder = &MockAgeVerificationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAgeVerificationService) EXPECT() *MockAgeVerificationServiceMockRecorder {
+	return m.recorder
+}
+
+// GetAgeVerification mocks base method.
+func (m *MockAgeVerificationService) GetAgeVerification(id string) (*domain.AgeVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAgeVerification", id)
+	ret0, _ := ret[0].(*domain.AgeVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAgeVerification indicates an expected call of GetAgeVerification.
+func (mr *MockAgeVerificationServiceMockRecorder) GetAgeVerification(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAgeVerification", reflect.TypeOf((*MockAgeVerificationService)(nil).GetAgeVerification), id)
+}
+
+// GetAgeVerifications mocks base method.
+func (m *MockAgeVerificationService) GetAgeVerifications(filter *domain.AgeVerificationFilter) ([]*domain.AgeVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAgeVerifications", filter)
+	ret0, _ := ret[0].([]*domain.AgeVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAgeVerifications indicates an expected call of GetAgeVerifications.
+func (mr *MockAgeVerificationServiceMockRecorder) GetAgeVerifications(filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAgeVerifications", reflect.TypeOf((*MockAgeVerificationService)(nil).GetAgeVerifications), filter)
+}
+
+// GetUserAgeVerification mocks base method.
+func (m *MockAgeVerificationService) GetUserAgeVerification(uid string) (*domain.AgeVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserAgeVerification", uid)
+	ret0, _ := ret[0].(*domain.AgeVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserAgeVerification indicates an expected call of GetUserAgeVerification.
+func (mr *MockAgeVerificationServiceMockRecorder) GetUserAgeVerification(uid interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserAgeVerification", reflect.TypeOf((*MockAgeVerificationService)(nil).GetUserAgeVerification), uid)
+}
+
+// ReviewAgeVerification mocks base method.
+func (m *MockAgeVerificationService) ReviewAgeVerification(adminID, id string, dto *domain.ReviewAgeVerificationDTO) (*domain.AgeVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReviewAgeVerification", adminID, id, dto)
+	ret0, _ := ret[0].(*domain.AgeVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReviewAgeVerification indicates an expected call of ReviewAgeVerification.
+func (mr *MockAgeVerificationServiceMockRecorder) ReviewAgeVerification(adminID, id, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReviewAgeVerification", reflect.TypeOf((*MockAgeVerificationService)(nil).ReviewAgeVerification), adminID, id, dto)
+}
+
+// SubmitAgeVerification mocks base method.
+func (m *MockAgeVerificationService) SubmitAgeVerification(uid string, dto *domain.SubmitAgeVerificationDTO) (*domain.AgeVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SubmitAgeVerification", uid, dto)
+	ret0, _ := ret[0].(*domain.AgeVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SubmitAgeVerification indicates an expected call of SubmitAgeVerification.
+func (mr *MockAgeVerificationServiceMockRecorder) SubmitAgeVerification(uid, dto interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SubmitAgeVerification", reflect.TypeOf((*MockAgeVerificationService)(nil).SubmitAgeVerification), uid, dto)
+}
+
+// MockAgeVerificationRepository is a mock of AgeVerificationRepository interface.
+type MockAgeVerificationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAgeVerificationRepositoryMockRecorder
+}
+
+// MockAgeVerificationRepositoryMockRecorder is the mock recorder for MockAgeVerificationRepository.
+type MockAgeVerificationRepositoryMockRecorder struct {
+	mock *MockAgeVerificationRepository
+}
+
+// NewMockAgeVerificationRepository creates a new mock instance.
+func NewMockAgeVerificationRepository(ctrl *gomock.Controller) *MockAgeVerificationRepository {
+	mock := &MockAgeVerificationRepository{ctrl: ctrl}
+	mock.recorder = &MockAgeVerificationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAgeVerificationRepository) EXPECT() *MockAgeVerificationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateAgeVerification mocks base method.
+func (m *MockAgeVerificationRepository) CreateAgeVerification(v *domain.AgeVerification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAgeVerification", v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateAgeVerification indicates an expected call of CreateAgeVerification.
+func (mr *MockAgeVerificationRepositoryMockRecorder) CreateAgeVerification(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAgeVerification", reflect.TypeOf((*MockAgeVerificationRepository)(nil).CreateAgeVerification), v)
+}
+
+// GetAgeVerificationByID mocks base method.
+func (m *MockAgeVerificationRepository) GetAgeVerificationByID(id string) (*domain.AgeVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAgeVerificationByID", id)
+	ret0, _ := ret[0].(*domain.AgeVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAgeVerificationByID indicates an expected call of GetAgeVerificationByID.
+func (mr *MockAgeVerificationRepositoryMockRecorder) GetAgeVerificationByID(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAgeVerificationByID", reflect.TypeOf((*MockAgeVerificationRepository)(nil).GetAgeVerificationByID), id)
+}
+
+// GetAgeVerifications mocks base method.
+func (m *MockAgeVerificationRepository) GetAgeVerifications(filter *domain.AgeVerificationFilter) ([]*domain.AgeVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAgeVerifications", filter)
+	ret0, _ := ret[0].([]*domain.AgeVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAgeVerifications indicates an expected call of GetAgeVerifications.
+func (mr *MockAgeVerificationRepositoryMockRecorder) GetAgeVerifications(filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAgeVerifications", reflect.TypeOf((*MockAgeVerificationRepository)(nil).GetAgeVerifications), filter)
+}
+
+// GetLatestAgeVerification mocks base method.
+func (m *MockAgeVerificationRepository) GetLatestAgeVerification(userID string) (*domain.AgeVerification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLatestAgeVerification", userID)
+	ret0, _ := ret[0].(*domain.AgeVerification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLatestAgeVerification indicates an expected call of GetLatestAgeVerification.
+func (mr *MockAgeVerificationRepositoryMockRecorder) GetLatestAgeVerification(userID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestAgeVerification", reflect.TypeOf((*MockAgeVerificationRepository)(nil).GetLatestAgeVerification), userID)
+}
+
+// UpdateAgeVerification mocks base method.
+func (m *MockAgeVerificationRepository) UpdateAgeVerification(v *domain.AgeVerification) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAgeVerification", v)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateAgeVerification indicates an expected call of UpdateAgeVerification.
+func (mr *MockAgeVerificationRepositoryMockRecorder) UpdateAgeVerification(v interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAgeVerification", reflect.TypeOf((*MockAgeVerificationRepository)(nil).UpdateAgeVerification), v)
+}
@@ -0,0 +1,285 @@
+package domain
+
+import "time"
+
+// Report is a report of a user, meetup or message filed by another user for moderators to review.
+// TargetUserID is the reported user, the owner of the reported meetup or the author of the reported message.
+type Report struct {
+	ID           string     `json:"id" gorm:"primaryKey"`
+	ReporterID   string     `json:"reporter_id" gorm:"index"`
+	TargetType   string     `json:"target_type" gorm:"index:idx_report_target"`
+	TargetID     string     `json:"target_id" gorm:"index:idx_report_target"`
+	TargetUserID string     `json:"target_user_id" gorm:"index"`
+	Reason       string     `json:"reason"`
+	Details      string     `json:"details"`
+	Status       string     `json:"status" gorm:"index:idx_report_status_created"`
+	AssigneeID   string     `json:"assignee_id,omitempty"`
+	Resolution   string     `json:"resolution,omitempty"`
+	ResolvedBy   string     `json:"resolved_by,omitempty"`
+	ResolvedAt   *time.Time `json:"resolved_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at" gorm:"index:idx_report_status_created"`
+}
+
+// ModerationAction is an entry in the moderation log, every assignment and resolution of a report or appeal is recorded.
+// AssigneeID is the assignee of the report at the time of the action, ReportID is empty for status changes of users made without a report.
+// Resolutions of appeals carry the ID of the appeal and the report of the appealed action.
+type ModerationAction struct {
+	ID           string    `json:"id" gorm:"primaryKey"`
+	ReportID     string    `json:"report_id" gorm:"index"`
+	AppealID     string    `json:"appeal_id,omitempty" gorm:"index"`
+	ModeratorID  string    `json:"moderator_id"`
+	Action       string    `json:"action"`
+	TargetUserID string    `json:"target_user_id" gorm:"index"`
+	AssigneeID   string    `json:"assignee_id,omitempty"`
+	Note         string    `json:"note,omitempty"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+const (
+	// ReportTargetUser is a report of a user (their profile).
+	ReportTargetUser = "user"
+	// ReportTargetMeetup is a report of a meetup.
+	ReportTargetMeetup = "meetup"
+	// ReportTargetMessage is a report of a chat message.
+	ReportTargetMessage = "message"
+)
+
+const (
+	// ReportReasonSpam is unsolicited advertising or repetitive content.
+	ReportReasonSpam = "spam"
+	// ReportReasonHarassment is bullying, threats or other targeted abuse.
+	ReportReasonHarassment = "harassment"
+	// ReportReasonHateSpeech is content attacking people for who they are.
+	ReportReasonHateSpeech = "hate_speech"
+	// ReportReasonInappropriate is sexual, violent or otherwise inappropriate content.
+	ReportReasonInappropriate = "inappropriate"
+	// ReportReasonScam is fraud or an attempt to get money or personal data.
+	ReportReasonScam = "scam"
+	// ReportReasonImpersonation is pretending to be someone else.
+	ReportReasonImpersonation = "impersonation"
+	// ReportReasonUnderage is a user below the minimum age.
+	ReportReasonUnderage = "underage"
+	// ReportReasonOther is anything else, described in the details.
+	ReportReasonOther = "other"
+)
+
+// ReportReasons contains all reasons a report can be filed for.
+var ReportReasons = []string{
+	ReportReasonSpam,
+	ReportReasonHarassment,
+	ReportReasonHateSpeech,
+	ReportReasonInappropriate,
+	ReportReasonScam,
+	ReportReasonImpersonation,
+	ReportReasonUnderage,
+	ReportReasonOther,
+}
+
+const (
+	// ReportStatusOpen is a report waiting in the moderation queue.
+	ReportStatusOpen = "open"
+	// ReportStatusResolved is a report a moderator has taken an action on.
+	ReportStatusResolved = "resolved"
+)
+
+const (
+	// ModerationActionAssign assigns a report to a moderator.
+	ModerationActionAssign = "assign"
+	// ModerationActionUnassign removes the assignee of a report.
+	ModerationActionUnassign = "unassign"
+	// ModerationActionDismiss resolves a report without consequences for the reported user.
+	ModerationActionDismiss = "dismiss"
+	// ModerationActionWarn resolves a report by warning the reported user.
+	ModerationActionWarn = "warn"
+	// ModerationActionRemoveContent resolves a report by removing the reported content.
+	// Meetups are deleted, messages are replaced by a deleted placeholder and user profiles lose their bio and picture.
+	ModerationActionRemoveContent = "remove_content"
+	// ModerationActionSuspendUser resolves a report by suspending the reported user.
+	ModerationActionSuspendUser = "suspend_user"
+	// ModerationActionBanUser bans a user.
+	ModerationActionBanUser = "ban_user"
+	// ModerationActionReactivateUser lifts the suspension or ban of a user.
+	ModerationActionReactivateUser = "reactivate_user"
+	// ModerationActionUpholdAppeal rejects an appeal, the appealed action stays in place.
+	ModerationActionUpholdAppeal = "uphold_appeal"
+	// ModerationActionOverturnAppeal accepts an appeal. Suspensions and bans are lifted, removed content can't be restored.
+	ModerationActionOverturnAppeal = "overturn_appeal"
+)
+
+// AppealableActions contains the moderation actions users can appeal.
+var AppealableActions = []string{
+	ModerationActionRemoveContent,
+	ModerationActionSuspendUser,
+	ModerationActionBanUser,
+}
+
+// Appeal is an objection of a user against a moderation action taken against them, reviewed by moderators in the appeal queue.
+// Each action can be appealed once, the resolution is recorded as a moderation action with the ID of the appeal.
+type Appeal struct {
+	ID         string     `json:"id" gorm:"primaryKey"`
+	UserID     string     `json:"user_id" gorm:"index"`
+	ActionID   string     `json:"action_id" gorm:"uniqueIndex"`
+	Action     string     `json:"action"`
+	Text       string     `json:"text"`
+	Status     string     `json:"status" gorm:"index:idx_appeal_status_created"`
+	Note       string     `json:"note,omitempty"`
+	ResolvedBy string     `json:"resolved_by,omitempty"`
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"index:idx_appeal_status_created"`
+}
+
+const (
+	// AppealStatusPending is an appeal waiting in the appeal queue.
+	AppealStatusPending = "pending"
+	// AppealStatusUpheld is an appeal that was rejected, the appealed action stays in place.
+	AppealStatusUpheld = "upheld"
+	// AppealStatusOverturned is an appeal that was accepted, the appealed action is reverted where possible.
+	AppealStatusOverturned = "overturned"
+)
+
+const (
+	// ReportDetailsMaxLength is the maximum length of a reports' details.
+	ReportDetailsMaxLength = 1000
+	// ModerationNoteMaxLength is the maximum length of a moderators' note.
+	ModerationNoteMaxLength = 1000
+	// SuspensionDefaultDays is the default length of a suspension in days.
+	SuspensionDefaultDays = 7
+	// SuspensionMaxDays is the maximum length of a suspension in days.
+	SuspensionMaxDays = 365
+	// ReportsDefaultLimit is the default number of reports returned per page.
+	ReportsDefaultLimit = 50
+	// ReportsMaxLimit is the maximum number of reports returned per page.
+	ReportsMaxLimit = 100
+	// AppealTextMaxLength is the maximum length of an appeals' text.
+	AppealTextMaxLength = 2000
+	// AppealWindow is how long after a moderation action it can be appealed.
+	AppealWindow = 30 * 24 * time.Hour
+)
+
+// ModerationWarning is the payload of the notification sent to a warned user.
+type ModerationWarning struct {
+	ReportID   string `json:"report_id"`
+	TargetType string `json:"target_type"`
+	TargetID   string `json:"target_id"`
+	Reason     string `json:"reason"`
+	Note       string `json:"note,omitempty"`
+}
+
+// CreateReportDTO is the data transfer object for filing a report.
+type CreateReportDTO struct {
+	TargetType string `json:"target_type"`
+	TargetID   string `json:"target_id"`
+	Reason     string `json:"reason"`
+	Details    string `json:"details"`
+}
+
+// ReportFilter filters the moderation queue. Empty fields don't filter.
+type ReportFilter struct {
+	Status     string
+	TargetType string
+	Reason     string
+	AssigneeID string
+	Unassigned bool
+	Before     string
+	Limit      int
+}
+
+// AssignReportDTO is the data transfer object for assigning a report, an empty assignee assigns the report to the acting moderator.
+type AssignReportDTO struct {
+	AssigneeID string `json:"assignee_id"`
+}
+
+// ResolveReportDTO is the data transfer object for resolving a report.
+// SuspensionDays is only used by the suspend_user action.
+type ResolveReportDTO struct {
+	Action         string `json:"action"`
+	Note           string `json:"note"`
+	SuspensionDays int    `json:"suspension_days,omitempty"`
+}
+
+// UpdateUserStatusDTO is the data transfer object for setting the status of a user.
+// SuspensionDays is only used for suspensions, the reason is shown to the user.
+type UpdateUserStatusDTO struct {
+	Status         string `json:"status"`
+	Reason         string `json:"reason"`
+	SuspensionDays int    `json:"suspension_days,omitempty"`
+}
+
+// ModerationActionFilter filters the moderation log. Empty fields don't filter.
+type ModerationActionFilter struct {
+	ReportID     string
+	AppealID     string
+	TargetUserID string
+	Actions      []string
+	Before       string
+	Limit        int
+}
+
+// AppealResolution is the payload of the notification sent to a user when their appeal is resolved.
+type AppealResolution struct {
+	AppealID string `json:"appeal_id"`
+	ActionID string `json:"action_id"`
+	Action   string `json:"action"`
+	Status   string `json:"status"`
+	Note     string `json:"note,omitempty"`
+}
+
+// CreateAppealDTO is the data transfer object for appealing a moderation action.
+type CreateAppealDTO struct {
+	ActionID string `json:"action_id"`
+	Text     string `json:"text"`
+}
+
+// ResolveAppealDTO is the data transfer object for resolving an appeal, the status is either upheld or overturned.
+// The note is shown to the user.
+type ResolveAppealDTO struct {
+	Status string `json:"status"`
+	Note   string `json:"note"`
+}
+
+// AppealFilter filters the appeal queue. Empty fields don't filter.
+type AppealFilter struct {
+	Status string
+	UserID string
+	Before string
+	Limit  int
+}
+
+type ModerationService interface {
+	CreateReport(uid string, dto *CreateReportDTO) (*Report, error)
+	GetReports(filter *ReportFilter) ([]*Report, error)
+	GetReport(id string) (*Report, error)
+	AssignReport(adminID string, id string, dto *AssignReportDTO) (*Report, error)
+	UnassignReport(adminID string, id string) (*Report, error)
+	ResolveReport(adminID string, id string, dto *ResolveReportDTO) (*Report, error)
+	GetModerationActions(filter *ModerationActionFilter) ([]*ModerationAction, error)
+	UpdateUserStatus(adminID string, userID string, dto *UpdateUserStatusDTO) (*User, error)
+	GetUserModerationActions(uid string) ([]*ModerationAction, error)
+	CreateAppeal(uid string, dto *CreateAppealDTO) (*Appeal, error)
+	GetUserAppeals(uid string) ([]*Appeal, error)
+	GetAppeals(filter *AppealFilter) ([]*Appeal, error)
+	GetAppeal(id string) (*Appeal, error)
+	ResolveAppeal(adminID string, id string, dto *ResolveAppealDTO) (*Appeal, error)
+}
+
+type ReportRepository interface {
+	CreateReport(r *Report) error
+	GetReportByID(id string) (*Report, error)
+	GetOpenReport(reporterID string, targetType string, targetID string) (*Report, error)
+	GetReports(filter *ReportFilter) ([]*Report, error)
+	UpdateReport(r *Report) error
+}
+
+type ModerationActionRepository interface {
+	CreateModerationAction(a *ModerationAction) error
+	GetModerationActionByID(id string) (*ModerationAction, error)
+	GetModerationActions(filter *ModerationActionFilter) ([]*ModerationAction, error)
+}
+
+type AppealRepository interface {
+	CreateAppeal(a *Appeal) error
+	GetAppealByID(id string) (*Appeal, error)
+	GetAppealByActionID(actionID string) (*Appeal, error)
+	GetAppeals(filter *AppealFilter) ([]*Appeal, error)
+	UpdateAppeal(a *Appeal) error
+}
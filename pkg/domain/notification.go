@@ -0,0 +1,187 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Notification is an entry in a users' in-app notification inbox.
+// Payload holds the type specific data, e.g. the updated meetup or the received invitation.
+type Notification struct {
+	ID        string          `json:"id" gorm:"primaryKey"`
+	UserID    string          `json:"user_id" gorm:"index:idx_notification_user_created"`
+	Type      string          `json:"type"`
+	Payload   json.RawMessage `json:"payload" gorm:"type:jsonb"`
+	Read      bool            `json:"read" gorm:"default:false"`
+	ReadAt    *time.Time      `json:"read_at,omitempty"`
+	CreatedAt time.Time       `json:"created_at" gorm:"index:idx_notification_user_created"`
+}
+
+const (
+	// NotificationTypeMeetupUpdated notifies the participants of a meetup that it was updated.
+	NotificationTypeMeetupUpdated = "meetup.updated"
+	// NotificationTypeMeetupCancelled notifies the participants of a meetup that it was cancelled.
+	NotificationTypeMeetupCancelled = "meetup.cancelled"
+	// NotificationTypeParticipantJoined notifies the meetup owner that a user joined their meetup.
+	NotificationTypeParticipantJoined = "participant.joined"
+	// NotificationTypeInvitationReceived notifies a user that they were invited to a meetup.
+	NotificationTypeInvitationReceived = "invitation.received"
+	// NotificationTypeMeetupReminder reminds the participants of a meetup that it starts soon.
+	NotificationTypeMeetupReminder = "meetup.reminder"
+	// NotificationTypeWeeklyDigest is the weekly email listing a users' upcoming meetups. It is only sent by email.
+	NotificationTypeWeeklyDigest = "digest.weekly"
+	// NotificationTypeModerationWarning warns a user that a moderator found their content to break the rules.
+	// It isn't part of NotificationTypes since users can't opt out of it.
+	NotificationTypeModerationWarning = "moderation.warning"
+	// NotificationTypeAppealResolved tells a user that their appeal was upheld or overturned.
+	// Like warnings, users can't opt out of it.
+	NotificationTypeAppealResolved = "moderation.appeal_resolved"
+	// NotificationTypeAgeVerificationReviewed tells a user that their age verification was approved or rejected, users can't opt out of it either.
+	NotificationTypeAgeVerificationReviewed = "user.age_verification_reviewed"
+)
+
+// NotificationTypes contains all notification types users can set preferences for.
+var NotificationTypes = []string{
+	NotificationTypeMeetupUpdated,
+	NotificationTypeMeetupCancelled,
+	NotificationTypeParticipantJoined,
+	NotificationTypeInvitationReceived,
+	NotificationTypeMeetupReminder,
+	NotificationTypeWeeklyDigest,
+}
+
+// NotificationSettings are the notification preferences of a user.
+// During the quiet hours push notifications are deferred until the quiet hours end, both are wall clock times ("15:04") in the users' timezone.
+type NotificationSettings struct {
+	UserID            string                    `json:"-" gorm:"primaryKey"`
+	Timezone          string                    `json:"timezone"`
+	QuietHoursEnabled bool                      `json:"quiet_hours_enabled"`
+	QuietHoursStart   string                    `json:"quiet_hours_start,omitempty"`
+	QuietHoursEnd     string                    `json:"quiet_hours_end,omitempty"`
+	Preferences       []*NotificationPreference `json:"preferences" gorm:"foreignKey:UserID;references:UserID"`
+	UpdatedAt         time.Time                 `json:"updated_at"`
+}
+
+// NotificationPreference holds the channels a user wants to receive a notification type on.
+type NotificationPreference struct {
+	UserID string `json:"-" gorm:"primaryKey"`
+	Type   string `json:"type" gorm:"primaryKey"`
+	InApp  bool   `json:"in_app"`
+	Push   bool   `json:"push"`
+	Email  bool   `json:"email"`
+}
+
+// Preference returns the users' preference for the notification type. Every channel is enabled unless the user changed it.
+func (s *NotificationSettings) Preference(notificationType string) *NotificationPreference {
+	for _, p := range s.Preferences {
+		if p.Type == notificationType {
+			return p
+		}
+	}
+	return &NotificationPreference{
+		UserID: s.UserID,
+		Type:   notificationType,
+		InApp:  true,
+		Push:   true,
+		Email:  true,
+	}
+}
+
+// DeferredPush is a push notification held back until the quiet hours of its recipient end.
+type DeferredPush struct {
+	NotificationID string `gorm:"primaryKey"`
+	UserID         string `gorm:"index"`
+	Type           string
+	Payload        json.RawMessage `gorm:"type:jsonb"`
+	SendAt         time.Time       `gorm:"index"`
+}
+
+// UpdateNotificationSettingsDTO is the data transfer object for replacing the notification settings of a user.
+type UpdateNotificationSettingsDTO struct {
+	Timezone          string                    `json:"timezone"`
+	QuietHoursEnabled bool                      `json:"quiet_hours_enabled"`
+	QuietHoursStart   string                    `json:"quiet_hours_start"`
+	QuietHoursEnd     string                    `json:"quiet_hours_end"`
+	Preferences       []*NotificationPreference `json:"preferences"`
+}
+
+const (
+	// NotificationsDefaultLimit is the default number of notifications returned per page.
+	NotificationsDefaultLimit = 20
+	// NotificationsMaxLimit is the maximum number of notifications returned per page.
+	NotificationsMaxLimit = 100
+	// NotificationDefaultTimezone is the timezone of users who did not set one.
+	NotificationDefaultTimezone = "UTC"
+	// QuietHoursLayout is the time layout of the quiet hours start and end.
+	QuietHoursLayout = "15:04"
+	// DeferredPushesBatchSize is the maximum number of deferred pushes claimed at once.
+	DeferredPushesBatchSize = 100
+	// DigestUsersBatchSize is the number of users loaded at once while sending digests.
+	DigestUsersBatchSize = 100
+	// DigestRunDuration is how long a single weekly digest job sends digests before leaving the remaining users to a new job,
+	// which keeps it within the JobLease.
+	DigestRunDuration = 10 * time.Minute
+	// DigestPeriod is the period of upcoming meetups listed in the weekly digest.
+	DigestPeriod = 7 * 24 * time.Hour
+	// NotificationJobBatchSize is the maximum number of users notified by a single notification job.
+	NotificationJobBatchSize = 20
+)
+
+const (
+	// JobTypeNotification notifies users in the background, so requests don't wait for push and email providers.
+	JobTypeNotification = "notification.send"
+	// JobTypeWeeklyDigest sends the weekly digest to all users, each run schedules the digest of the following week.
+	JobTypeWeeklyDigest = "digest.weekly"
+)
+
+// NotificationJob is the payload of notification jobs.
+// Users who couldn't be notified while others were are retried in a new job, so nobody is notified twice.
+type NotificationJob struct {
+	UserIDs []string        `json:"user_ids"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload"`
+}
+
+// WeeklyDigestJob is the payload of weekly digest jobs.
+// A run that failed part way through continues in a new job after the last user who was already handled.
+type WeeklyDigestJob struct {
+	DueAt   time.Time `json:"due_at"`
+	AfterID string    `json:"after_id,omitempty"`
+}
+
+// WeeklyDigestJobKey returns the job key of the digest due at the given time.
+// All servers schedule the next digest on startup, the key makes sure it is only sent once per week.
+func WeeklyDigestJobKey(dueAt time.Time) string {
+	return JobTypeWeeklyDigest + ":" + dueAt.UTC().Format("2006-01-02")
+}
+
+type NotificationService interface {
+	Notify(userID string, notificationType string, payload interface{}) error
+	NotifyLater(userIDs []string, notificationType string, payload interface{}) error
+	SendNotifications(j *Job) error
+	GetNotifications(uid string, before string, limit int) ([]*Notification, error)
+	MarkNotificationRead(uid string, id string) (*Notification, error)
+	MarkAllNotificationsRead(uid string) error
+	DeleteNotification(uid string, id string) error
+	GetNotificationSettings(uid string) (*NotificationSettings, error)
+	UpdateNotificationSettings(uid string, dto *UpdateNotificationSettingsDTO) (*NotificationSettings, error)
+	SendDeferredPushes() error
+	ScheduleWeeklyDigest() error
+	SendWeeklyDigests(j *Job) error
+}
+
+type NotificationRepository interface {
+	CreateNotification(n *Notification) error
+	GetNotificationByID(id string) (*Notification, error)
+	GetNotificationsByUserID(userID string, before string, limit int) ([]*Notification, error)
+	UpdateNotification(n *Notification) error
+	MarkAllNotificationsRead(userID string, readAt time.Time) error
+	DeleteNotification(id string) error
+	CreateDeferredPush(p *DeferredPush) error
+	ClaimDueDeferredPushes(now time.Time, limit int) ([]*DeferredPush, error)
+}
+
+type NotificationSettingsRepository interface {
+	GetNotificationSettingsByUserID(userID string) (*NotificationSettings, error)
+	SaveNotificationSettings(s *NotificationSettings) error
+}
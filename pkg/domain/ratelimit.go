@@ -0,0 +1,18 @@
+package domain
+
+import "time"
+
+// RateLimit is the request budget of a route, Requests are allowed per Window.
+type RateLimit struct {
+	Requests int
+	Window   time.Duration
+}
+
+// RateLimitStore counts requests in fixed windows. The memory store is meant for single instances,
+// deployments with multiple replicas share their counters through Redis.
+type RateLimitStore interface {
+	// Increment counts a request for the key and returns the number of requests in the current window and when it resets.
+	// The window starts with the first request for the key.
+	Increment(key string, window time.Duration) (count int, reset time.Time, err error)
+	Close()
+}
@@ -0,0 +1,69 @@
+package domain
+
+import "encoding/json"
+
+// RealtimeEvent is an event delivered to clients over a real-time channel.
+type RealtimeEvent struct {
+	Type string      `json:"type"`
+	Data interface{} `json:"data,omitempty"`
+}
+
+// RealtimeCommand is a command sent by a client over a real-time channel.
+type RealtimeCommand struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+const (
+	// RealtimeEventError is sent to a client when one of its commands failed.
+	RealtimeEventError = "error"
+	// RealtimeEventMessageCreated is sent when a new chat message was created.
+	RealtimeEventMessageCreated = "message.created"
+	// RealtimeEventMessageUpdated is sent when a chat message was edited.
+	RealtimeEventMessageUpdated = "message.updated"
+	// RealtimeEventMessageDeleted is sent when a chat message was deleted.
+	RealtimeEventMessageDeleted = "message.deleted"
+	// RealtimeEventReactionAdded is sent when a user reacted to a chat message.
+	RealtimeEventReactionAdded = "reaction.added"
+	// RealtimeEventReactionRemoved is sent when a user removed their reaction from a chat message.
+	RealtimeEventReactionRemoved = "reaction.removed"
+	// RealtimeEventReadMarkerUpdated is sent when a user read a chat up to a newer message.
+	RealtimeEventReadMarkerUpdated = "read_marker.updated"
+	// RealtimeEventMeetupUpdated is sent to the participants of a meetup when it was updated.
+	RealtimeEventMeetupUpdated = "meetup.updated"
+	// RealtimeEventMeetupCancelled is sent to the participants of a meetup when it was cancelled.
+	RealtimeEventMeetupCancelled = "meetup.cancelled"
+	// RealtimeEventParticipantJoined is sent to the participants of a meetup when a user joined it.
+	RealtimeEventParticipantJoined = "participant.joined"
+	// RealtimeEventParticipantLeft is sent to the participants of a meetup when a user left it.
+	RealtimeEventParticipantLeft = "participant.left"
+	// RealtimeEventInvitationReceived is sent to a user when they were invited to a meetup.
+	RealtimeEventInvitationReceived = "invitation.received"
+	// RealtimeEventNotificationCreated is sent to a user when a new notification was added to their inbox.
+	RealtimeEventNotificationCreated = "notification.created"
+
+	// RealtimeCommandMessageCreate creates a new chat message.
+	RealtimeCommandMessageCreate = "message.create"
+	// RealtimeCommandMessageUpdate edits a chat message.
+	RealtimeCommandMessageUpdate = "message.update"
+	// RealtimeCommandMessageDelete deletes a chat message.
+	RealtimeCommandMessageDelete = "message.delete"
+	// RealtimeCommandReactionAdd adds a reaction to a chat message.
+	RealtimeCommandReactionAdd = "reaction.add"
+	// RealtimeCommandReactionRemove removes a reaction from a chat message.
+	RealtimeCommandReactionRemove = "reaction.remove"
+	// RealtimeCommandMessageRead marks a chat as read up to a message.
+	RealtimeCommandMessageRead = "message.read"
+)
+
+// Subscription receives the events published to the topics it was subscribed to.
+type Subscription interface {
+	Events() <-chan *RealtimeEvent
+	Close()
+}
+
+// Hub fans out real-time events to all subscribers of a topic.
+type Hub interface {
+	Publish(topic string, e *RealtimeEvent)
+	Subscribe(topics ...string) Subscription
+}
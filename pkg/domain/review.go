@@ -0,0 +1,65 @@
+package domain
+
+import "time"
+
+// Review is a rating and short review of a meetup left by one of its participants after it ended.
+type Review struct {
+	ID        string    `json:"id" gorm:"primaryKey"`
+	MeetupID  string    `json:"meetup_id" gorm:"uniqueIndex:idx_review_meetup_author"`
+	AuthorID  string    `json:"author_id" gorm:"uniqueIndex:idx_review_meetup_author"`
+	HostID    string    `json:"host_id" gorm:"index"`
+	Rating    int       `json:"rating"`
+	Text      string    `json:"text,omitempty"`
+	Hidden    bool      `json:"-" gorm:"default:false"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// RatingSummary is the aggregated rating of all reviews of a hosts' meetups.
+type RatingSummary struct {
+	Count   int64   `json:"count"`
+	Average float64 `json:"average"`
+}
+
+const (
+	// ReviewMinRating is the lowest rating a review can give.
+	ReviewMinRating = 1
+	// ReviewMaxRating is the highest rating a review can give.
+	ReviewMaxRating = 5
+	// ReviewTextMaxLength is the maximum length of a reviews' text.
+	ReviewTextMaxLength = 512
+)
+
+// CreateReviewDTO is the data transfer object for creating a review.
+type CreateReviewDTO struct {
+	Rating int    `json:"rating"`
+	Text   string `json:"text,omitempty"`
+}
+
+// UpdateReviewDTO is the data transfer object for updating a review.
+type UpdateReviewDTO struct {
+	Rating int    `json:"rating,omitempty"`
+	Text   string `json:"text,omitempty"`
+}
+
+// ReviewModerator inspects reviews before they are stored.
+// Returning an error rejects the review, setting Review.Hidden keeps it out of listings and ratings.
+type ReviewModerator interface {
+	ModerateReview(r *Review) error
+}
+
+type ReviewService interface {
+	GetMeetupReviews(uid string, meetupID string) ([]*Review, error)
+	CreateReview(uid string, meetupID string, dto *CreateReviewDTO) (*Review, error)
+	UpdateReview(uid string, meetupID string, dto *UpdateReviewDTO) (*Review, error)
+	DeleteReview(uid string, meetupID string) error
+}
+
+type ReviewRepository interface {
+	CreateReview(r *Review) error
+	GetReview(meetupID string, authorID string) (*Review, error)
+	GetReviewsByMeetupID(meetupID string) ([]*Review, error)
+	GetRatingSummaryByHostID(hostID string) (*RatingSummary, error)
+	UpdateReview(r *Review) error
+	DeleteReview(id string) error
+}
@@ -1,24 +1,85 @@
 package domain
 
 import (
+	"encoding/json"
 	"time"
 )
 
 // User is a user of the UpMeet application.
+// Their age isn't stored since it changes every birthday, it is computed from the birthdate by Age and added to the JSON representation.
 type User struct {
-	ID               string    `json:"id" gorm:"primaryKey"`
-	Username         string    `json:"username" gorm:"uniqueIndex"`
-	Name             string    `json:"name" gorm:"index"`
-	ProfilePicture   string    `json:"profile_picture"`
-	Age              int       `json:"age" gorm:"default:-1"`
-	AgeVerified      bool      `json:"age_verified"`
-	AgePrivate       bool      `json:"age_private"`
-	Bio              string    `json:"bio"`
-	InstagramProfile string    `json:"instagram_profile"`
-	FacebookProfile  string    `json:"facebook_profile"`
-	TwitterProfile   string    `json:"twitter_profile"`
-	DiscordTag       string    `json:"discord_tag"`
-	CreatedAt        time.Time `json:"created_at"`
+	ID                string     `json:"id" gorm:"primaryKey"`
+	Username          string     `json:"username" gorm:"uniqueIndex"`
+	Name              string     `json:"name" gorm:"index"`
+	Email             string     `json:"email,omitempty" gorm:"uniqueIndex:idx_user_email,where:email <> ''"`
+	EmailVerified     bool       `json:"email_verified"`
+	EmailPrivate      bool       `json:"email_private"`
+	ProfilePicture    string     `json:"profile_picture"`
+	AvatarID          string     `json:"-"`
+	Birthdate         *time.Time `json:"birthdate,omitempty" gorm:"type:date"`
+	AgeVerified       bool       `json:"age_verified"`
+	VerifiedBirthdate *time.Time `json:"verified_birthdate,omitempty" gorm:"type:date"`
+	AgePrivate        bool       `json:"age_private"`
+	AttendancePrivate bool       `json:"attendance_private"`
+	Bio               string     `json:"bio"`
+	InstagramProfile  string     `json:"instagram_profile"`
+	FacebookProfile   string     `json:"facebook_profile"`
+	TwitterProfile    string     `json:"twitter_profile"`
+	DiscordTag        string     `json:"discord_tag"`
+	Status            string     `json:"status" gorm:"default:active;index"`
+	StatusReason      string     `json:"status_reason,omitempty"`
+	SuspendedUntil    *time.Time `json:"suspended_until,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+}
+
+const (
+	// UserStatusActive is a user in good standing.
+	UserStatusActive = "active"
+	// UserStatusSuspended is a user who can't use the application until SuspendedUntil.
+	UserStatusSuspended = "suspended"
+	// UserStatusBanned is a user who can't use the application anymore, their content is hidden from everyone else.
+	UserStatusBanned = "banned"
+)
+
+// IsSuspended returns whether the user is suspended at the given time. Suspensions expire at SuspendedUntil.
+func (u *User) IsSuspended(now time.Time) bool {
+	return u.Status == UserStatusSuspended && u.SuspendedUntil != nil && now.Before(*u.SuspendedUntil)
+}
+
+// Age returns the age of the user at the given time, or -1 if they didn't enter their birthdate.
+// A verified birthdate takes precedence over the one the user entered.
+func (u *User) Age(now time.Time) int {
+	if u.AgeVerified && u.VerifiedBirthdate != nil {
+		return AgeAt(*u.VerifiedBirthdate, now)
+	}
+	if u.Birthdate == nil {
+		return -1
+	}
+	return AgeAt(*u.Birthdate, now)
+}
+
+// MarshalJSON adds the current age to the JSON representation of the user, it is left out without a birthdate.
+func (u User) MarshalJSON() ([]byte, error) {
+	type user User
+	var age int
+	if a := u.Age(time.Now()); a >= 0 {
+		age = a
+	}
+	return json.Marshal(&struct {
+		user
+		Age int `json:"age,omitempty"`
+	}{user(u), age})
+}
+
+// UserStatusError is returned for every request of a suspended or banned user, it tells them why and until when.
+type UserStatusError struct {
+	Status         string     `json:"status"`
+	Reason         string     `json:"reason,omitempty"`
+	SuspendedUntil *time.Time `json:"suspended_until,omitempty"`
+}
+
+func (e *UserStatusError) Error() string {
+	return "user-" + e.Status
 }
 
 const (
@@ -30,35 +91,111 @@ const (
 	UserNameMaxLength = 64
 	// UserBioMaxLength is the maximum length of a users' bio.
 	UserBioMaxLength = 256
+	// UserEmailMaxLength is the maximum length of a users' email address.
+	UserEmailMaxLength = 254
+	// EmailVerificationTTL is the time in which an email verification token has to be used.
+	EmailVerificationTTL = 24 * time.Hour
+	// UserStatusReasonMaxLength is the maximum length of the reason for a users' suspension or ban.
+	UserStatusReasonMaxLength = 500
+	// UserSearchQueryMinLength is the minimum length of a user search query.
+	UserSearchQueryMinLength = 2
 	// UserMaxAge is the maximum age of a user. funfact: (03/29/2022 - current oldest person is Kane Tananka at age 119)
 	UserMaxAge = 120
+	// BirthdateLayout is the format of dates of birth in requests.
+	BirthdateLayout = "2006-01-02"
 )
 
+// AgeAt returns the age in whole years of someone born on birthdate at the given time.
+func AgeAt(birthdate time.Time, now time.Time) int {
+	age := now.Year() - birthdate.Year()
+	if now.Month() < birthdate.Month() || (now.Month() == birthdate.Month() && now.Day() < birthdate.Day()) {
+		age--
+	}
+	return age
+}
+
+// ParseBirthdate parses a date of birth formatted like BirthdateLayout, it has to lie in the past and be at most UserMaxAge years ago.
+func ParseBirthdate(value string, now time.Time) (time.Time, error) {
+	birthdate, err := time.Parse(BirthdateLayout, value)
+	if err != nil || birthdate.After(now) || AgeAt(birthdate, now) > UserMaxAge {
+		return time.Time{}, ErrInvalidBirthdate
+	}
+	return birthdate, nil
+}
+
+// EmailVerification is a pending change of a users' email address. Only the SHA-256 hash of the token sent to the address is stored.
+type EmailVerification struct {
+	TokenHash string `gorm:"primaryKey"`
+	UserID    string `gorm:"index"`
+	Email     string
+	ExpiresAt time.Time
+	CreatedAt time.Time
+}
+
 // CreateUserDTO is the data transfer object for creating a user.
+// Email and EmailVerified are taken from the claims of the users' ID token.
 type CreateUserDTO struct {
-	Username string `json:"username"`
-	Name     string `json:"name"`
+	Username      string `json:"username"`
+	Name          string `json:"name"`
+	Email         string `json:"-"`
+	EmailVerified bool   `json:"-"`
+}
+
+// ChangeEmailDTO is the data transfer object for requesting an email address change.
+type ChangeEmailDTO struct {
+	Email string `json:"email"`
+}
+
+// VerifyEmailDTO is the data transfer object for verifying an email address with the token sent to it.
+type VerifyEmailDTO struct {
+	Token string `json:"token"`
 }
 
 // UpdateUserDTO is the data transfer object for updating a user.
 type UpdateUserDTO struct {
-	Username         string    `json:"username,omitempty"`
-	Name             string    `json:"name,omitempty"`
-	Bio              string    `json:"bio,omitempty"`
-	Age              int       `json:"age,omitempty"`
-	AgePrivate       bool      `json:"age_private,omitempty"`
-	InstagramProfile string    `json:"instagram_profile,omitempty"`
-	FacebookProfile  string    `json:"facebook_profile,omitempty"`
-	TwitterProfile   string    `json:"twitter_profile,omitempty"`
-	DiscordTag       string    `json:"discord_tag,omitempty"`
-	Meetups          []*Meetup `gorm:"many2many:participants;"`
+	Username          string    `json:"username,omitempty"`
+	Name              string    `json:"name,omitempty"`
+	Bio               string    `json:"bio,omitempty"`
+	Birthdate         string    `json:"birthdate,omitempty"`
+	AgePrivate        bool      `json:"age_private,omitempty"`
+	AttendancePrivate bool      `json:"attendance_private,omitempty"`
+	EmailPrivate      *bool     `json:"email_private,omitempty"`
+	InstagramProfile  string    `json:"instagram_profile,omitempty"`
+	FacebookProfile   string    `json:"facebook_profile,omitempty"`
+	TwitterProfile    string    `json:"twitter_profile,omitempty"`
+	DiscordTag        string    `json:"discord_tag,omitempty"`
+	Meetups           []*Meetup `gorm:"many2many:participants;"`
+}
+
+// UserProfile is the public representation of a user as seen by other users.
+type UserProfile struct {
+	ID               string           `json:"id"`
+	Username         string           `json:"username"`
+	Name             string           `json:"name"`
+	Email            string           `json:"email,omitempty"`
+	ProfilePicture   string           `json:"profile_picture"`
+	Age              int              `json:"age,omitempty"`
+	AgeVerified      bool             `json:"age_verified"`
+	Bio              string           `json:"bio"`
+	InstagramProfile string           `json:"instagram_profile"`
+	FacebookProfile  string           `json:"facebook_profile"`
+	TwitterProfile   string           `json:"twitter_profile"`
+	DiscordTag       string           `json:"discord_tag"`
+	Attendance       *AttendanceStats `json:"attendance,omitempty"`
+	HostRating       *RatingSummary   `json:"host_rating,omitempty"`
+	CreatedAt        time.Time        `json:"created_at"`
 }
 
 type UserService interface {
 	GetUserByID(uid string) (*User, error)
+	GetUserProfile(uid string, username string) (*UserProfile, error)
+	SearchUsers(uid string, query string) ([]*UserPreview, error)
 	CreateUser(uid string, dto *CreateUserDTO) (*User, error)
 	UpdateUser(uid string, dto *UpdateUserDTO) (*User, error)
 	DeleteUser(uid string) error
+	CheckUserStatus(uid string) error
+	ChangeEmail(uid string, dto *ChangeEmailDTO) error
+	VerifyEmail(uid string, dto *VerifyEmailDTO) (*User, error)
 }
 
 type UserRepository interface {
@@ -68,6 +205,14 @@ type UserRepository interface {
 	GetUserByUsername(username string) (*User, error)
 	SearchUsersByName(name string) ([]*User, error)
 	SearchUsersByUsername(username string) ([]*User, error)
+	GetUsersWithEmail(afterID string, limit int) ([]*User, error)
 	UpdateUser(u *User) error
+	UpdateUserStatus(u *User) error
 	DeleteUser(id string) error
 }
+
+type EmailVerificationRepository interface {
+	CreateEmailVerification(v *EmailVerification) error
+	GetEmailVerification(tokenHash string) (*EmailVerification, error)
+	DeleteEmailVerificationsByUserID(userID string) error
+}
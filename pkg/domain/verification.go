@@ -0,0 +1,89 @@
+package domain
+
+import "time"
+
+// AgeVerification is a request of a user to verify their age with a date of birth and the image of an identity document.
+// The document is only kept until an admin reviewed the request, approving it sets the verified birthdate of the user.
+// The verified birthdate replaces the one the user entered and can't be changed by them anymore.
+type AgeVerification struct {
+	ID           string     `json:"id" gorm:"primaryKey"`
+	UserID       string     `json:"user_id" gorm:"index"`
+	Birthdate    time.Time  `json:"birthdate" gorm:"type:date"`
+	Document     []byte     `json:"-"`
+	DocumentType string     `json:"document_type,omitempty"`
+	Status       string     `json:"status" gorm:"index:idx_age_verification_status_created"`
+	Note         string     `json:"note,omitempty"`
+	ReviewedBy   string     `json:"reviewed_by,omitempty"`
+	ReviewedAt   *time.Time `json:"reviewed_at,omitempty"`
+	CreatedAt    time.Time  `json:"created_at" gorm:"index:idx_age_verification_status_created"`
+}
+
+const (
+	// AgeVerificationStatusPending is a request waiting in the review queue.
+	AgeVerificationStatusPending = "pending"
+	// AgeVerificationStatusApproved is a request whose date of birth matched the document.
+	AgeVerificationStatusApproved = "approved"
+	// AgeVerificationStatusRejected is a request that couldn't be verified, the note tells the user why.
+	AgeVerificationStatusRejected = "rejected"
+)
+
+// AgeVerificationDocumentTypes contains the accepted content types of document images.
+var AgeVerificationDocumentTypes = []string{
+	"image/jpeg",
+	"image/png",
+	"image/webp",
+}
+
+const (
+	// AgeVerificationDocumentMaxSize is the maximum size of a document image in bytes.
+	AgeVerificationDocumentMaxSize = 8 << 20
+	// AgeVerificationsDefaultLimit is the default number of age verifications returned per page.
+	AgeVerificationsDefaultLimit = 50
+	// AgeVerificationsMaxLimit is the maximum number of age verifications returned per page.
+	AgeVerificationsMaxLimit = 100
+)
+
+// AgeVerificationReview is the payload of the notification sent to a user when their age verification was reviewed.
+type AgeVerificationReview struct {
+	VerificationID string `json:"verification_id"`
+	Status         string `json:"status"`
+	Note           string `json:"note,omitempty"`
+}
+
+// SubmitAgeVerificationDTO is the data transfer object for requesting an age verification.
+// The birthdate is formatted like BirthdateLayout, the document is the raw image.
+type SubmitAgeVerificationDTO struct {
+	Birthdate string
+	Document  []byte
+}
+
+// ReviewAgeVerificationDTO is the data transfer object for reviewing an age verification, the status is either approved or rejected.
+// The note is shown to the user, e.g. to explain a rejection.
+type ReviewAgeVerificationDTO struct {
+	Status string `json:"status"`
+	Note   string `json:"note"`
+}
+
+// AgeVerificationFilter filters the age verification queue. Empty fields don't filter.
+type AgeVerificationFilter struct {
+	Status string
+	UserID string
+	Before string
+	Limit  int
+}
+
+type AgeVerificationService interface {
+	SubmitAgeVerification(uid string, dto *SubmitAgeVerificationDTO) (*AgeVerification, error)
+	GetUserAgeVerification(uid string) (*AgeVerification, error)
+	GetAgeVerifications(filter *AgeVerificationFilter) ([]*AgeVerification, error)
+	GetAgeVerification(id string) (*AgeVerification, error)
+	ReviewAgeVerification(adminID string, id string, dto *ReviewAgeVerificationDTO) (*AgeVerification, error)
+}
+
+type AgeVerificationRepository interface {
+	CreateAgeVerification(v *AgeVerification) error
+	GetAgeVerificationByID(id string) (*AgeVerification, error)
+	GetLatestAgeVerification(userID string) (*AgeVerification, error)
+	GetAgeVerifications(filter *AgeVerificationFilter) ([]*AgeVerification, error)
+	UpdateAgeVerification(v *AgeVerification) error
+}
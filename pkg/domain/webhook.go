@@ -0,0 +1,153 @@
+package domain
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Webhook is an endpoint registered by a meetup owner that receives the events of the meetup.
+// The secret is only returned once on creation, it is used to sign every request sent to the endpoint.
+type Webhook struct {
+	ID                  string        `json:"id" gorm:"primaryKey"`
+	MeetupID            string        `json:"meetup_id" gorm:"index"`
+	OwnerID             string        `json:"owner_id"`
+	URL                 string        `json:"url"`
+	Secret              string        `json:"secret,omitempty"`
+	Events              WebhookEvents `json:"events" gorm:"type:jsonb"`
+	Active              bool          `json:"active"`
+	ConsecutiveFailures int           `json:"consecutive_failures"`
+	DisabledAt          *time.Time    `json:"disabled_at,omitempty"`
+	CreatedAt           time.Time     `json:"created_at"`
+}
+
+// WebhookEvents is the event filter of a webhook, stored as JSON array.
+type WebhookEvents []string
+
+// Value implements driver.Valuer.
+func (e WebhookEvents) Value() (driver.Value, error) {
+	b, err := json.Marshal(e)
+	return string(b), err
+}
+
+// Scan implements sql.Scanner.
+func (e *WebhookEvents) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, e)
+	case string:
+		return json.Unmarshal([]byte(v), e)
+	}
+	return errors.New("unsupported webhook events value")
+}
+
+// Matches returns whether the filter includes the event type.
+func (e WebhookEvents) Matches(eventType string) bool {
+	for _, t := range e {
+		if t == eventType || t == EventTypeAll {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookDelivery is the record of a single attempt to deliver an event to a webhook.
+type WebhookDelivery struct {
+	ID         string    `json:"id" gorm:"primaryKey"`
+	WebhookID  string    `json:"webhook_id" gorm:"index:idx_webhook_delivery_webhook_created"`
+	EventID    string    `json:"event_id"`
+	EventType  string    `json:"event_type"`
+	Attempt    int       `json:"attempt"`
+	StatusCode int       `json:"status_code,omitempty"`
+	Error      string    `json:"error,omitempty"`
+	Success    bool      `json:"success"`
+	DurationMs int64     `json:"duration_ms"`
+	CreatedAt  time.Time `json:"created_at" gorm:"index:idx_webhook_delivery_webhook_created"`
+}
+
+// WebhookPayload is the body of the requests sent to webhooks.
+type WebhookPayload struct {
+	ID        string          `json:"id"`
+	Type      string          `json:"type"`
+	CreatedAt time.Time       `json:"created_at"`
+	Data      json.RawMessage `json:"data"`
+}
+
+// WebhookDeliveryJob is the payload of webhook delivery jobs.
+type WebhookDeliveryJob struct {
+	WebhookID string `json:"webhook_id"`
+	Event     *Event `json:"event"`
+}
+
+// WebhookEventTypes are the event types webhooks can subscribe to.
+var WebhookEventTypes = []string{
+	EventTypeMeetupUpdated,
+	EventTypeMeetupDeleted,
+	EventTypeParticipantAdded,
+	EventTypeParticipantRemoved,
+}
+
+const (
+	// JobTypeWebhookDelivery delivers an event to a webhook.
+	JobTypeWebhookDelivery = "webhook.delivery"
+)
+
+const (
+	// WebhookURLMaxLength is the maximum length of a webhooks' URL.
+	WebhookURLMaxLength = 2048
+	// WebhooksMaxPerMeetup is the maximum number of webhooks of a single meetup.
+	WebhooksMaxPerMeetup = 5
+	// WebhookTimeout is how long a webhook has to respond before the delivery fails.
+	WebhookTimeout = 10 * time.Second
+	// WebhookMaxConsecutiveFailures is the number of failed deliveries in a row after which a webhook is disabled.
+	WebhookMaxConsecutiveFailures = 15
+	// WebhookDeliveriesDefaultLimit is the default number of webhook deliveries returned per page.
+	WebhookDeliveriesDefaultLimit = 50
+	// WebhookDeliveriesMaxLimit is the maximum number of webhook deliveries returned per page.
+	WebhookDeliveriesMaxLimit = 100
+	// WebhookSignatureHeader is the header containing the HMAC-SHA256 signature of "<timestamp>.<body>".
+	WebhookSignatureHeader = "X-UpMeet-Signature"
+	// WebhookTimestampHeader is the header containing the unix time the request was signed at.
+	WebhookTimestampHeader = "X-UpMeet-Timestamp"
+	// WebhookEventHeader is the header containing the event type.
+	WebhookEventHeader = "X-UpMeet-Event"
+	// WebhookDeliveryHeader is the header containing the event id, which stays the same across retries.
+	WebhookDeliveryHeader = "X-UpMeet-Delivery"
+)
+
+// CreateWebhookDTO is the data transfer object for registering a webhook.
+type CreateWebhookDTO struct {
+	URL    string   `json:"url"`
+	Events []string `json:"events"`
+}
+
+// UpdateWebhookDTO is the data transfer object for updating a webhook, enabling a disabled webhook resets its failures.
+type UpdateWebhookDTO struct {
+	URL    string   `json:"url,omitempty"`
+	Events []string `json:"events,omitempty"`
+	Active *bool    `json:"active,omitempty"`
+}
+
+type WebhookService interface {
+	GetWebhooks(uid string, meetupID string) ([]*Webhook, error)
+	CreateWebhook(uid string, meetupID string, dto *CreateWebhookDTO) (*Webhook, error)
+	UpdateWebhook(uid string, meetupID string, id string, dto *UpdateWebhookDTO) (*Webhook, error)
+	DeleteWebhook(uid string, meetupID string, id string) error
+	GetWebhookDeliveries(uid string, meetupID string, id string, before string, limit int) ([]*WebhookDelivery, error)
+	DispatchEvent(e *Event) error
+	DeliverWebhook(j *Job) error
+}
+
+type WebhookRepository interface {
+	CreateWebhook(w *Webhook) error
+	GetWebhookByID(id string) (*Webhook, error)
+	GetWebhooksByMeetupID(meetupID string) ([]*Webhook, error)
+	UpdateWebhook(w *Webhook) error
+	DeleteWebhook(id string) error
+}
+
+type WebhookDeliveryRepository interface {
+	CreateWebhookDelivery(d *WebhookDelivery) error
+	GetWebhookDeliveries(webhookID string, before string, limit int) ([]*WebhookDelivery, error)
+}
@@ -0,0 +1,72 @@
+package email
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRender(t *testing.T) {
+	u := &domain.User{Name: "Test", Email: "test@upmeet.app"}
+	m := &domain.Meetup{Name: "Board <games>", StartsAt: time.Date(2022, 4, 1, 18, 0, 0, 0, time.UTC)}
+
+	for _, name := range []string{domain.EmailTemplateMeetupReminder, domain.EmailTemplateMeetupCancelled, domain.EmailTemplateInvitation} {
+		e, err := Render(name, &domain.EmailData{User: u, Meetup: m})
+		assert.NoError(t, err, name)
+		assert.Equal(t, "test@upmeet.app", e.To)
+		assert.Contains(t, e.Subject, "Board <games>")
+		assert.Contains(t, e.Text, "Hi Test")
+		assert.Contains(t, e.Text, "Fri, 01 Apr 2022 18:00 UTC")
+		// The HTML body is escaped
+		assert.Contains(t, e.HTML, "Board &lt;games&gt;")
+	}
+
+	e, err := Render(domain.EmailTemplateWeeklyDigest, &domain.EmailData{User: u, Meetups: []*domain.Meetup{m, {Name: "Hiking"}}})
+	assert.NoError(t, err)
+	assert.Contains(t, e.Text, "- Hiking")
+	assert.Contains(t, e.HTML, "<li><strong>Hiking</strong>")
+
+	e, err = Render(domain.EmailTemplateEmailVerification, &domain.EmailData{User: u, URL: "https://upmeet.app/verify-email?token=a&b"})
+	assert.NoError(t, err)
+	assert.Contains(t, e.Text, "https://upmeet.app/verify-email?token=a&b")
+	assert.Contains(t, e.HTML, "https://upmeet.app/verify-email?token=a&amp;b")
+}
+
+func Test_buildMessage(t *testing.T) {
+	from := &mail.Address{Name: "UpMeet", Address: "noreply@upmeet.app"}
+	e := &domain.Email{To: "test@upmeet.app", Subject: "Grüße", Text: "plain text", HTML: "<p>html</p>"}
+
+	b, err := buildMessage(from, e, time.Date(2022, 4, 1, 18, 0, 0, 0, time.UTC))
+	assert.NoError(t, err)
+
+	msg, err := mail.ReadMessage(strings.NewReader(string(b)))
+	assert.NoError(t, err)
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	assert.NoError(t, err)
+	assert.Equal(t, "Grüße", subject)
+	assert.Equal(t, "<test@upmeet.app>", msg.Header.Get("To"))
+	assert.True(t, strings.HasSuffix(msg.Header.Get("Message-ID"), "@upmeet.app>"))
+
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	assert.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+	r := multipart.NewReader(msg.Body, params["boundary"])
+	var bodies []string
+	for {
+		p, err := r.NextPart()
+		if err == io.EOF {
+			break
+		}
+		assert.NoError(t, err)
+		body, err := io.ReadAll(p)
+		assert.NoError(t, err)
+		bodies = append(bodies, string(body))
+	}
+	assert.Equal(t, []string{"plain text", "<p>html</p>"}, bodies)
+}
@@ -0,0 +1,55 @@
+package email
+
+import (
+	"bytes"
+	"embed"
+	"github.com/UpMeetApp/server/pkg/domain"
+	htmltemplate "html/template"
+	texttemplate "text/template"
+	"time"
+)
+
+// Every email template consists of <name>.txt defining the "subject" and the plain text "body"
+// and <name>.html defining the HTML "body". Both use the shared layout of their format.
+//
+//go:embed templates
+var templatesFS embed.FS
+
+var funcs = map[string]interface{}{
+	"datetime": func(t time.Time) string {
+		return t.UTC().Format("Mon, 02 Jan 2006 15:04 MST")
+	},
+}
+
+// Render renders the email template with the data and addresses the email to the user of the data.
+func Render(name string, data *domain.EmailData) (*domain.Email, error) {
+	text, err := texttemplate.New(name).Funcs(funcs).ParseFS(templatesFS, "templates/layout.txt", "templates/"+name+".txt")
+	if err != nil {
+		return nil, err
+	}
+	html, err := htmltemplate.New(name).Funcs(funcs).ParseFS(templatesFS, "templates/layout.html", "templates/"+name+".html")
+	if err != nil {
+		return nil, err
+	}
+
+	var subject, textBody, htmlBody bytes.Buffer
+	err = text.ExecuteTemplate(&subject, "subject", data)
+	if err != nil {
+		return nil, err
+	}
+	err = text.ExecuteTemplate(&textBody, "layout", data)
+	if err != nil {
+		return nil, err
+	}
+	err = html.ExecuteTemplate(&htmlBody, "layout", data)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.Email{
+		To:      data.User.Email,
+		Subject: subject.String(),
+		Text:    textBody.String(),
+		HTML:    htmlBody.String(),
+	}, nil
+}
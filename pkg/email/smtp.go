@@ -0,0 +1,114 @@
+package email
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/UpMeetApp/server/pkg/config"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"time"
+)
+
+type smtpSender struct {
+	addr string
+	auth smtp.Auth
+	from *mail.Address
+}
+
+// NewSMTPSender creates an email sender delivering through the SMTP server in the config.
+// Without credentials no authentication is used, e.g. for a local SMTP catcher like MailHog.
+func NewSMTPSender(cfg *config.Config) domain.EmailSender {
+	from, err := mail.ParseAddress(cfg.SMTPFrom)
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Fatal("failed to parse smtp from address", zap.Error(err))
+	}
+	s := &smtpSender{
+		addr: fmt.Sprintf("%s:%d", cfg.SMTPHost, cfg.SMTPPort),
+		from: from,
+	}
+	if len(cfg.SMTPUsername) > 0 {
+		s.auth = smtp.PlainAuth("", cfg.SMTPUsername, cfg.SMTPPassword, cfg.SMTPHost)
+	}
+	return s
+}
+
+func (s *smtpSender) Send(e *domain.Email) error {
+	msg, err := buildMessage(s.from, e, time.Now())
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to build email", zap.Error(err))
+		return err
+	}
+	err = smtp.SendMail(s.addr, s.auth, s.from.Address, []string{e.To}, msg)
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to send email", zap.Error(err))
+		return err
+	}
+	return nil
+}
+
+// buildMessage builds a multipart/alternative MIME message with the plain text and HTML body of the email.
+func buildMessage(from *mail.Address, e *domain.Email, date time.Time) ([]byte, error) {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	for _, part := range []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=utf-8", e.Text},
+		{"text/html; charset=utf-8", e.HTML},
+	} {
+		pw, err := w.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qw := quotedprintable.NewWriter(pw)
+		_, err = qw.Write([]byte(part.content))
+		if err != nil {
+			return nil, err
+		}
+		err = qw.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+	err := w.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "From: %s\r\n", from.String())
+	fmt.Fprintf(&msg, "To: %s\r\n", (&mail.Address{Address: e.To}).String())
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", e.Subject))
+	fmt.Fprintf(&msg, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	fmt.Fprintf(&msg, "Message-ID: <%s@%s>\r\n", uuid.NewString(), domainOf(from.Address))
+	fmt.Fprintf(&msg, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&msg, "Content-Type: multipart/alternative; boundary=%q\r\n", w.Boundary())
+	fmt.Fprintf(&msg, "\r\n")
+	msg.Write(body.Bytes())
+	return msg.Bytes(), nil
+}
+
+// domainOf returns the domain part of an email address.
+func domainOf(address string) string {
+	for i := len(address) - 1; i >= 0; i-- {
+		if address[i] == '@' {
+			return address[i+1:]
+		}
+	}
+	return address
+}
@@ -0,0 +1,250 @@
+package filter
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"go.uber.org/zap"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+)
+
+// defaultReservedUsernames are always reserved, regardless of the configured list.
+//
+//go:embed lists/reserved_usernames.txt
+var defaultReservedUsernames []byte
+
+// leetspeak maps the characters commonly used to disguise letters to the letters they stand for.
+var leetspeak = map[rune]rune{
+	'0': 'o',
+	'1': 'i',
+	'3': 'e',
+	'4': 'a',
+	'5': 's',
+	'7': 't',
+	'8': 'b',
+	'9': 'g',
+	'@': 'a',
+	'$': 's',
+	'!': 'i',
+	'|': 'i',
+	'+': 't',
+}
+
+type contentFilter struct {
+	wordsPath    string
+	reservedPath string
+	words        map[string]bool
+	reserved     map[string]bool
+	modTimes     map[string]time.Time
+	mu           sync.RWMutex
+}
+
+// NewContentFilter creates a new content filter instance.
+// The blocked words and additional reserved usernames are read from the files at wordsPath and reservedPath, one per line,
+// empty lines and lines starting with # are ignored. Empty paths are skipped, the files are reloaded by Start when they change.
+func NewContentFilter(wordsPath string, reservedPath string) (domain.ContentFilter, error) {
+	f := &contentFilter{
+		wordsPath:    wordsPath,
+		reservedPath: reservedPath,
+	}
+	err := f.Reload()
+	if err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *contentFilter) CheckUsername(username string) error {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	if f.reserved[strings.ToLower(username)] || f.reserved[compact(normalize(username))] {
+		return domain.ErrReservedUsername
+	}
+	if f.containsWord(candidateWords(username)) {
+		return domain.ErrInappropriateContent
+	}
+	// Usernames are often written without separators, so longer words are also matched inside of them.
+	c := compact(normalize(username))
+	for word := range f.words {
+		if len(word) >= domain.ContentFilterSubstringMinLength && (strings.Contains(c, word) || strings.Contains(squeeze(c), word)) {
+			return domain.ErrInappropriateContent
+		}
+	}
+	return nil
+}
+
+func (f *contentFilter) CheckText(text string) error {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+
+	if f.containsWord(candidateWords(text)) {
+		return domain.ErrInappropriateContent
+	}
+	return nil
+}
+
+func (f *contentFilter) Reload() error {
+	words := make(map[string]bool)
+	reserved := make(map[string]bool)
+	modTimes := make(map[string]time.Time)
+
+	addWord := func(line string) {
+		words[compact(normalize(line))] = true
+	}
+	addReserved := func(line string) {
+		reserved[strings.ToLower(line)] = true
+	}
+	readList(bytes.NewReader(defaultReservedUsernames), addReserved)
+	for path, add := range map[string]func(line string){f.wordsPath: addWord, f.reservedPath: addReserved} {
+		if len(path) == 0 {
+			continue
+		}
+		modTime, err := loadList(path, add)
+		if err != nil {
+			sentry.CaptureException(err)
+			zap.L().Error("failed to load content filter list", zap.String("path", path), zap.Error(err))
+			return err
+		}
+		modTimes[path] = modTime
+	}
+	delete(words, "")
+	delete(reserved, "")
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.words = words
+	f.reserved = reserved
+	f.modTimes = modTimes
+	return nil
+}
+
+func (f *contentFilter) Start() {
+	go func() {
+		for range time.Tick(domain.ContentFilterReloadInterval) {
+			if f.changed() {
+				// A broken list is only logged, the previous lists stay in use.
+				if f.Reload() == nil {
+					zap.L().Info("reloaded content filter lists")
+				}
+			}
+		}
+	}()
+}
+
+// changed returns whether one of the list files was modified since it was loaded.
+func (f *contentFilter) changed() bool {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	for path, modTime := range f.modTimes {
+		info, err := os.Stat(path)
+		if err != nil || !info.ModTime().Equal(modTime) {
+			return true
+		}
+	}
+	return false
+}
+
+// containsWord returns whether one of the tokens, or the token with repeated letters squeezed ("fuuuck"), is a blocked word.
+func (f *contentFilter) containsWord(tokens []string) bool {
+	for _, t := range tokens {
+		if f.words[t] || f.words[squeeze(t)] {
+			return true
+		}
+	}
+	return false
+}
+
+// loadList reads the list file at path and returns its modification time.
+func loadList(path string, add func(line string)) (time.Time, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer file.Close()
+	info, err := file.Stat()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return info.ModTime(), readList(file, add)
+}
+
+// readList calls add with every entry of the list, skipping empty lines and comments.
+func readList(r io.Reader, add func(line string)) error {
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if len(line) == 0 || strings.HasPrefix(line, "#") {
+			continue
+		}
+		add(line)
+	}
+	return scanner.Err()
+}
+
+// candidateWords returns the words of the text with and without leetspeak undone.
+// Both are needed since punctuation can either disguise letters ("a$$") or just be punctuation ("word!!!").
+func candidateWords(text string) []string {
+	return append(tokens(normalize(text)), tokens(strings.ToLower(text))...)
+}
+
+// normalize lowercases the text and undoes leetspeak.
+func normalize(text string) string {
+	return strings.Map(func(r rune) rune {
+		if l, ok := leetspeak[r]; ok {
+			return l
+		}
+		return unicode.ToLower(r)
+	}, text)
+}
+
+// tokens splits normalized text into words. Letters spelled out one by one ("f u c k") are joined into a single word.
+func tokens(text string) []string {
+	var result []string
+	var spelled strings.Builder
+	for _, t := range strings.FieldsFunc(text, func(r rune) bool { return !unicode.IsLetter(r) }) {
+		if len([]rune(t)) == 1 {
+			spelled.WriteString(t)
+			continue
+		}
+		if spelled.Len() > 0 {
+			result = append(result, spelled.String())
+			spelled.Reset()
+		}
+		result = append(result, t)
+	}
+	if spelled.Len() > 0 {
+		result = append(result, spelled.String())
+	}
+	return result
+}
+
+// compact removes everything but letters from normalized text.
+func compact(text string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) {
+			return r
+		}
+		return -1
+	}, text)
+}
+
+// squeeze collapses repeated letters into one.
+func squeeze(text string) string {
+	var b strings.Builder
+	var last rune
+	for i, r := range text {
+		if i == 0 || r != last {
+			b.WriteRune(r)
+		}
+		last = r
+	}
+	return b.String()
+}
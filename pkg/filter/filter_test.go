@@ -0,0 +1,103 @@
+package filter
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func writeList(t *testing.T, path string, content string) {
+	assert.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+}
+
+func Test_contentFilter_CheckUsername(t *testing.T) {
+	dir := t.TempDir()
+	words := filepath.Join(dir, "words.txt")
+	writeList(t, words, "# blocked words\nass\nbadword\n\n")
+	reserved := filepath.Join(dir, "reserved.txt")
+	writeList(t, reserved, "founder\n")
+	f, err := NewContentFilter(words, reserved)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	tests := []struct {
+		username string
+		want     error
+	}{
+		{"jane", nil},
+		{"classic_jane", nil},
+		{"admin", domain.ErrReservedUsername},
+		{"ADMIN", domain.ErrReservedUsername},
+		{"adm1n", domain.ErrReservedUsername},
+		{"ad_min", domain.ErrReservedUsername},
+		{"@me", domain.ErrReservedUsername},
+		{"founder", domain.ErrReservedUsername},
+		{"ass", domain.ErrInappropriateContent},
+		{"jane_ass", domain.ErrInappropriateContent},
+		{"xxbadwordxx", domain.ErrInappropriateContent},
+		{"b4dw0rd", domain.ErrInappropriateContent},
+		{"baaadword", domain.ErrInappropriateContent},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, f.CheckUsername(tt.username), tt.username)
+	}
+}
+
+func Test_contentFilter_CheckText(t *testing.T) {
+	dir := t.TempDir()
+	words := filepath.Join(dir, "words.txt")
+	writeList(t, words, "ass\nbadword\n")
+	f, err := NewContentFilter(words, "")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	tests := []struct {
+		text string
+		want error
+	}{
+		{"Board games in the park, bring a classic!", nil},
+		{"Admin meetup", nil},
+		{"what a badword", domain.ErrInappropriateContent},
+		{"BADWORD!!!", domain.ErrInappropriateContent},
+		{"b@dw0rd", domain.ErrInappropriateContent},
+		{"b a d w o r d", domain.ErrInappropriateContent},
+		{"badwooord", domain.ErrInappropriateContent},
+		{"kick a$$", domain.ErrInappropriateContent},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, f.CheckText(tt.text), tt.text)
+	}
+}
+
+func Test_contentFilter_Reload(t *testing.T) {
+	dir := t.TempDir()
+	words := filepath.Join(dir, "words.txt")
+	writeList(t, words, "badword\n")
+	f, err := NewContentFilter(words, "")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, f.CheckText("newword"))
+
+	// Changed lists are picked up
+	writeList(t, words, "badword\nnewword\n")
+	assert.NoError(t, os.Chtimes(words, time.Now(), time.Now().Add(time.Minute)))
+	assert.True(t, f.(*contentFilter).changed())
+	assert.NoError(t, f.Reload())
+	assert.ErrorIs(t, f.CheckText("newword"), domain.ErrInappropriateContent)
+	assert.False(t, f.(*contentFilter).changed())
+
+	// Missing list keeps the previous words
+	assert.NoError(t, os.Remove(words))
+	assert.Error(t, f.Reload())
+	assert.ErrorIs(t, f.CheckText("newword"), domain.ErrInappropriateContent)
+
+	// Missing list fails on creation
+	_, err = NewContentFilter(words, "")
+	assert.Error(t, err)
+}
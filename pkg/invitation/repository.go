@@ -0,0 +1,65 @@
+package invitation
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type invitationRepository struct {
+	db *gorm.DB
+}
+
+// NewInvitationRepository creates a new invitation repository instance.
+func NewInvitationRepository(db *gorm.DB) domain.InvitationRepository {
+	return &invitationRepository{
+		db: db,
+	}
+}
+
+func (r *invitationRepository) CreateInvitation(i *domain.Invitation) error {
+	err := r.db.Create(i).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to create invitation", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *invitationRepository) GetInvitation(meetupID string, userID string) (*domain.Invitation, error) {
+	i := &domain.Invitation{}
+	err := r.db.Where("meetup_id = ? AND user_id = ?", meetupID, userID).First(i).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fiber.ErrNotFound
+		}
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get invitation", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return i, nil
+}
+
+func (r *invitationRepository) GetInvitationsByUserID(userID string) ([]*domain.Invitation, error) {
+	var invitations []*domain.Invitation
+	err := r.db.Where("user_id = ?", userID).Order("created_at DESC").Find(&invitations).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get invitations by user id", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return invitations, nil
+}
+
+func (r *invitationRepository) DeleteInvitation(meetupID string, userID string) error {
+	err := r.db.Delete(&domain.Invitation{}, "meetup_id = ? AND user_id = ?", meetupID, userID).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to delete invitation", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
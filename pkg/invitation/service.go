@@ -0,0 +1,101 @@
+package invitation
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+	"time"
+)
+
+type invitationService struct {
+	invitationRepository domain.InvitationRepository
+	meetupRepository     domain.MeetupRepository
+	userRepository       domain.UserRepository
+	blockRepository      domain.BlockRepository
+	abuseService         domain.AbuseService
+	notificationService  domain.NotificationService
+	hub                  domain.Hub
+}
+
+// NewInvitationService creates a new invitation service instance.
+func NewInvitationService(invitationRepository domain.InvitationRepository, meetupRepository domain.MeetupRepository, userRepository domain.UserRepository, blockRepository domain.BlockRepository, abuseService domain.AbuseService, notificationService domain.NotificationService, hub domain.Hub) domain.InvitationService {
+	return &invitationService{
+		invitationRepository: invitationRepository,
+		meetupRepository:     meetupRepository,
+		userRepository:       userRepository,
+		blockRepository:      blockRepository,
+		abuseService:         abuseService,
+		notificationService:  notificationService,
+		hub:                  hub,
+	}
+}
+
+func (s *invitationService) CreateInvitation(uid string, meetupID string, dto *domain.CreateInvitationDTO) (*domain.Invitation, error) {
+	m, err := s.meetupRepository.GetMeetupByID(meetupID)
+	if err != nil {
+		return nil, err
+	}
+	if m.OwnerID != uid {
+		return nil, domain.ErrNotMeetupOwner
+	}
+
+	u, err := s.userRepository.GetUserByUsername(dto.Username)
+	if err != nil {
+		return nil, err
+	}
+	blocked, err := s.blockRepository.IsBlocked(uid, u.ID)
+	if err != nil {
+		return nil, err
+	}
+	if blocked {
+		return nil, domain.ErrUserBlocked
+	}
+	ok, err := s.meetupRepository.IsParticipant(meetupID, u.ID)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return nil, domain.ErrAlreadyParticipant
+	}
+	_, err = s.invitationRepository.GetInvitation(meetupID, u.ID)
+	if err != fiber.ErrNotFound {
+		if err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrAlreadyInvited
+	}
+	err = s.abuseService.Check(uid, domain.AbuseActionInvitationCreate)
+	if err != nil {
+		return nil, err
+	}
+
+	i := &domain.Invitation{
+		MeetupID:  meetupID,
+		UserID:    u.ID,
+		InviterID: uid,
+		CreatedAt: time.Now(),
+	}
+	err = s.invitationRepository.CreateInvitation(i)
+	if err != nil {
+		return nil, err
+	}
+
+	s.hub.Publish(domain.UserTopic(u.ID), &domain.RealtimeEvent{
+		Type: domain.RealtimeEventInvitationReceived,
+		Data: i,
+	})
+	// The invitation was already created, so a failed notification is only logged.
+	_ = s.notificationService.NotifyLater([]string{u.ID}, domain.NotificationTypeInvitationReceived, i)
+	return i, nil
+}
+
+func (s *invitationService) GetInvitations(uid string) ([]*domain.Invitation, error) {
+	return s.invitationRepository.GetInvitationsByUserID(uid)
+}
+
+func (s *invitationService) DeclineInvitation(uid string, meetupID string) error {
+	_, err := s.invitationRepository.GetInvitation(meetupID, uid)
+	if err != nil {
+		return err
+	}
+	return s.invitationRepository.DeleteInvitation(meetupID, uid)
+}
@@ -0,0 +1,105 @@
+package invitation
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/UpMeetApp/server/pkg/domain/mock"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_invitationService_CreateInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockInvitationRepository(ctrl)
+	meetupRepo := mock.NewMockMeetupRepository(ctrl)
+	userRepo := mock.NewMockUserRepository(ctrl)
+	notificationService := mock.NewMockNotificationService(ctrl)
+	blockRepo := mock.NewMockBlockRepository(ctrl)
+	abuseService := mock.NewMockAbuseService(ctrl)
+	hub := mock.NewMockHub(ctrl)
+	s := NewInvitationService(repo, meetupRepo, userRepo, blockRepo, abuseService, notificationService, hub)
+
+	uid := "1"
+	id := "m1"
+	dto := &domain.CreateInvitationDTO{Username: "test"}
+
+	// Not the owner
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: "3"}, nil)
+	i, err := s.CreateInvitation(uid, id, dto)
+	assert.ErrorIs(t, err, domain.ErrNotMeetupOwner)
+	assert.Nil(t, i)
+
+	// Blocked user
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: uid}, nil)
+	userRepo.EXPECT().GetUserByUsername(gomock.Eq("test")).Return(&domain.User{ID: "2"}, nil)
+	blockRepo.EXPECT().IsBlocked(gomock.Eq(uid), gomock.Eq("2")).Return(true, nil)
+	i, err = s.CreateInvitation(uid, id, dto)
+	assert.ErrorIs(t, err, domain.ErrUserBlocked)
+	assert.Nil(t, i)
+
+	// Already participant
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: uid}, nil)
+	userRepo.EXPECT().GetUserByUsername(gomock.Eq("test")).Return(&domain.User{ID: "2"}, nil)
+	blockRepo.EXPECT().IsBlocked(gomock.Eq(uid), gomock.Eq("2")).Return(false, nil)
+	meetupRepo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq("2")).Return(true, nil)
+	i, err = s.CreateInvitation(uid, id, dto)
+	assert.ErrorIs(t, err, domain.ErrAlreadyParticipant)
+	assert.Nil(t, i)
+
+	// Already invited
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: uid}, nil)
+	userRepo.EXPECT().GetUserByUsername(gomock.Eq("test")).Return(&domain.User{ID: "2"}, nil)
+	blockRepo.EXPECT().IsBlocked(gomock.Eq(uid), gomock.Eq("2")).Return(false, nil)
+	meetupRepo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq("2")).Return(false, nil)
+	repo.EXPECT().GetInvitation(gomock.Eq(id), gomock.Eq("2")).Return(&domain.Invitation{}, nil)
+	i, err = s.CreateInvitation(uid, id, dto)
+	assert.ErrorIs(t, err, domain.ErrAlreadyInvited)
+	assert.Nil(t, i)
+
+	// Invitation limit exceeded
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: uid}, nil)
+	userRepo.EXPECT().GetUserByUsername(gomock.Eq("test")).Return(&domain.User{ID: "2"}, nil)
+	blockRepo.EXPECT().IsBlocked(gomock.Eq(uid), gomock.Eq("2")).Return(false, nil)
+	meetupRepo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq("2")).Return(false, nil)
+	repo.EXPECT().GetInvitation(gomock.Eq(id), gomock.Eq("2")).Return(nil, fiber.ErrNotFound)
+	abuseService.EXPECT().Check(gomock.Eq(uid), gomock.Eq(domain.AbuseActionInvitationCreate)).Return(domain.ErrActionLimitExceeded)
+	i, err = s.CreateInvitation(uid, id, dto)
+	assert.ErrorIs(t, err, domain.ErrActionLimitExceeded)
+	assert.Nil(t, i)
+
+	// CreateInvitation successful and the invitee is notified
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: uid}, nil)
+	userRepo.EXPECT().GetUserByUsername(gomock.Eq("test")).Return(&domain.User{ID: "2"}, nil)
+	blockRepo.EXPECT().IsBlocked(gomock.Eq(uid), gomock.Eq("2")).Return(false, nil)
+	meetupRepo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq("2")).Return(false, nil)
+	repo.EXPECT().GetInvitation(gomock.Eq(id), gomock.Eq("2")).Return(nil, fiber.ErrNotFound)
+	abuseService.EXPECT().Check(gomock.Eq(uid), gomock.Eq(domain.AbuseActionInvitationCreate)).Return(nil)
+	repo.EXPECT().CreateInvitation(gomock.Any()).Return(nil)
+	hub.EXPECT().Publish(gomock.Eq(domain.UserTopic("2")), gomock.Any())
+	notificationService.EXPECT().NotifyLater(gomock.Eq([]string{"2"}), gomock.Eq(domain.NotificationTypeInvitationReceived), gomock.Any()).Return(nil)
+	i, err = s.CreateInvitation(uid, id, dto)
+	assert.NoError(t, err)
+	assert.Equal(t, "2", i.UserID)
+	assert.Equal(t, uid, i.InviterID)
+}
+
+func Test_invitationService_DeclineInvitation(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockInvitationRepository(ctrl)
+	s := NewInvitationService(repo, mock.NewMockMeetupRepository(ctrl), mock.NewMockUserRepository(ctrl), mock.NewMockBlockRepository(ctrl), mock.NewMockAbuseService(ctrl), mock.NewMockNotificationService(ctrl), mock.NewMockHub(ctrl))
+
+	uid := "1"
+	id := "m1"
+
+	// Not invited
+	repo.EXPECT().GetInvitation(gomock.Eq(id), gomock.Eq(uid)).Return(nil, fiber.ErrNotFound)
+	err := s.DeclineInvitation(uid, id)
+	assert.ErrorIs(t, err, fiber.ErrNotFound)
+
+	// DeclineInvitation successful
+	repo.EXPECT().GetInvitation(gomock.Eq(id), gomock.Eq(uid)).Return(&domain.Invitation{}, nil)
+	repo.EXPECT().DeleteInvitation(gomock.Eq(id), gomock.Eq(uid)).Return(nil)
+	err = s.DeclineInvitation(uid, id)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,15 @@
+package job
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"gorm.io/gorm"
+)
+
+// MigrateKeyIndex drops the former unique index on the key of all jobs, which is replaced by one only covering pending jobs.
+// It does nothing once the index is gone.
+func MigrateKeyIndex(db *gorm.DB) error {
+	if !db.Migrator().HasIndex(&domain.Job{}, "idx_jobs_key") {
+		return nil
+	}
+	return db.Migrator().DropIndex(&domain.Job{}, "idx_jobs_key")
+}
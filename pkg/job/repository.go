@@ -0,0 +1,110 @@
+package job
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type jobRepository struct {
+	db *gorm.DB
+}
+
+// NewJobRepository creates a new job repository instance.
+func NewJobRepository(db *gorm.DB) domain.JobRepository {
+	return &jobRepository{
+		db: db,
+	}
+}
+
+func (r *jobRepository) SaveJob(j *domain.Job) error {
+	// A job with the same key replaces the pending one, a running one is left alone and the new job is added next to it.
+	err := r.db.Clauses(clause.OnConflict{
+		Columns: []clause.Column{{Name: "key"}},
+		// The predicate is written out like in the index, Postgres can't match a bound parameter against it.
+		TargetWhere: clause.Where{Exprs: []clause.Expression{clause.Expr{SQL: "status = 'pending'"}}},
+		DoUpdates:   clause.AssignmentColumns([]string{"id", "type", "payload", "run_at", "attempts", "max_attempts", "last_error", "locked_at", "created_at"}),
+	}).Create(j).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to save job", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *jobRepository) ClaimDueJobs(now time.Time, lockedBefore time.Time, limit int) ([]*domain.Job, error) {
+	var jobs []*domain.Job
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// SKIP LOCKED lets every server claim different jobs without waiting for each other.
+		// Running jobs locked before the lease are claimed again, the server running them is assumed to have crashed.
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("(status = ? AND run_at <= ?) OR (status = ? AND locked_at <= ?)", domain.JobStatusPending, now, domain.JobStatusRunning, lockedBefore).
+			Order("run_at").Limit(limit).Find(&jobs).Error
+		if err != nil || len(jobs) == 0 {
+			return err
+		}
+
+		ids := make([]string, len(jobs))
+		for i, j := range jobs {
+			j.Status = domain.JobStatusRunning
+			j.Attempts++
+			j.LockedAt = &now
+			ids[i] = j.ID
+		}
+		return tx.Model(&domain.Job{}).Where("id IN ?", ids).Updates(map[string]interface{}{
+			"status":    domain.JobStatusRunning,
+			"attempts":  gorm.Expr("attempts + 1"),
+			"locked_at": now,
+		}).Error
+	})
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to claim due jobs", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return jobs, nil
+}
+
+func (r *jobRepository) UpdateJob(j *domain.Job) error {
+	// Only the fields changed by running the job are updated, so a job cancelled in the meantime isn't created again.
+	q := r.db.Model(j).Select("status", "run_at", "last_error", "locked_at")
+	if j.Status == domain.JobStatusPending {
+		// A job scheduled with the same key while this one was running replaces its retry.
+		q = q.Where("NOT EXISTS (SELECT 1 FROM jobs AS other WHERE other.key = ? AND other.status = ?)", j.Key, domain.JobStatusPending)
+	}
+	res := q.Updates(j)
+	if res.Error == nil && res.RowsAffected == 0 && j.Status == domain.JobStatusPending {
+		res = r.db.Where("id = ?", j.ID).Delete(&domain.Job{})
+	}
+	if res.Error != nil {
+		sentry.CaptureException(res.Error)
+		zap.L().Error("failed to update job", zap.Error(res.Error))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *jobRepository) DeleteJob(id string) error {
+	err := r.db.Where("id = ?", id).Delete(&domain.Job{}).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to delete job", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *jobRepository) DeleteJobByKey(key string) error {
+	err := r.db.Where("key = ?", key).Delete(&domain.Job{}).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to delete job by key", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
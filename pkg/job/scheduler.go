@@ -0,0 +1,144 @@
+package job
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"sync"
+	"time"
+)
+
+type jobScheduler struct {
+	jobRepository domain.JobRepository
+	handlers      map[string]domain.JobHandler
+	mu            sync.RWMutex
+}
+
+// NewJobScheduler creates a new job scheduler instance.
+func NewJobScheduler(jobRepository domain.JobRepository) domain.JobScheduler {
+	return &jobScheduler{
+		jobRepository: jobRepository,
+		handlers:      make(map[string]domain.JobHandler),
+	}
+}
+
+func (s *jobScheduler) Register(jobType string, handler domain.JobHandler) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.handlers[jobType] = handler
+}
+
+func (s *jobScheduler) Schedule(jobType string, key string, runAt time.Time, payload interface{}) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to marshal job payload", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+
+	id := uuid.NewString()
+	if len(key) == 0 {
+		key = id
+	}
+	return s.jobRepository.SaveJob(&domain.Job{
+		ID:          id,
+		Type:        jobType,
+		Key:         key,
+		Payload:     b,
+		Status:      domain.JobStatusPending,
+		RunAt:       runAt,
+		MaxAttempts: domain.JobMaxAttempts,
+		CreatedAt:   time.Now(),
+	})
+}
+
+func (s *jobScheduler) Cancel(key string) error {
+	return s.jobRepository.DeleteJobByKey(key)
+}
+
+func (s *jobScheduler) RunDueJobs() error {
+	for {
+		now := time.Now()
+		jobs, err := s.jobRepository.ClaimDueJobs(now, now.Add(-domain.JobLease), domain.JobBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, j := range jobs {
+			s.run(j)
+		}
+		if len(jobs) < domain.JobBatchSize {
+			return nil
+		}
+	}
+}
+
+func (s *jobScheduler) Start() {
+	go func() {
+		for range time.Tick(domain.JobPollInterval) {
+			_ = s.RunDueJobs()
+		}
+	}()
+}
+
+// run runs a claimed job and then removes it, or schedules a retry if it failed.
+// Failing to store the outcome is only logged by the repository, the job won't be run again in any case.
+func (s *jobScheduler) run(j *domain.Job) {
+	s.mu.RLock()
+	handler, ok := s.handlers[j.Type]
+	s.mu.RUnlock()
+
+	var err error
+	switch {
+	case !ok:
+		err = fmt.Errorf("unknown job type %q", j.Type)
+		j.Attempts = j.MaxAttempts
+	case j.Attempts > j.MaxAttempts:
+		// The last attempt was claimed again after its lease expired, so it was abandoned rather than failed.
+		err = errors.New("job abandoned on its last attempt")
+	default:
+		err = s.handle(handler, j)
+	}
+	if err == nil {
+		_ = s.jobRepository.DeleteJob(j.ID)
+		return
+	}
+
+	zap.L().Warn("job failed", zap.String("id", j.ID), zap.String("type", j.Type), zap.Int("attempt", j.Attempts), zap.Error(err))
+	j.LastError = err.Error()
+	j.LockedAt = nil
+	if j.Attempts >= j.MaxAttempts {
+		sentry.CaptureException(err)
+		j.Status = domain.JobStatusFailed
+	} else {
+		j.Status = domain.JobStatusPending
+		j.RunAt = time.Now().Add(retryDelay(j.Attempts))
+	}
+	_ = s.jobRepository.UpdateJob(j)
+}
+
+// handle calls the handler and turns a panic into an error, so a single broken job can't stop the scheduler.
+func (s *jobScheduler) handle(handler domain.JobHandler, j *domain.Job) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("job panicked: %v", r)
+		}
+	}()
+	return handler(j)
+}
+
+// retryDelay returns the delay before the next attempt of a job that failed the given number of times.
+func retryDelay(attempts int) time.Duration {
+	d := domain.JobRetryBaseDelay
+	for i := 1; i < attempts; i++ {
+		d *= 2
+		if d >= domain.JobRetryMaxDelay {
+			return domain.JobRetryMaxDelay
+		}
+	}
+	return d
+}
@@ -0,0 +1,163 @@
+package job
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/UpMeetApp/server/pkg/domain/mock"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func Test_jobScheduler_Schedule(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockJobRepository(ctrl)
+	s := NewJobScheduler(repo)
+
+	runAt := time.Now().Add(time.Hour)
+
+	// Schedule successful
+	repo.EXPECT().SaveJob(gomock.Any()).DoAndReturn(func(j *domain.Job) error {
+		assert.NotEmpty(t, j.ID)
+		assert.Equal(t, "test", j.Type)
+		assert.Equal(t, "test:1", j.Key)
+		assert.Equal(t, json.RawMessage(`{"meetup_id":"1"}`), j.Payload)
+		assert.Equal(t, domain.JobStatusPending, j.Status)
+		assert.Equal(t, runAt, j.RunAt)
+		assert.Equal(t, domain.JobMaxAttempts, j.MaxAttempts)
+		return nil
+	})
+	err := s.Schedule("test", "test:1", runAt, &domain.MeetupReminderJob{MeetupID: "1"})
+	assert.NoError(t, err)
+
+	// Key defaults to the id
+	repo.EXPECT().SaveJob(gomock.Any()).DoAndReturn(func(j *domain.Job) error {
+		assert.Equal(t, j.ID, j.Key)
+		return nil
+	})
+	err = s.Schedule("test", "", runAt, nil)
+	assert.NoError(t, err)
+
+	// SaveJob returns error
+	repo.EXPECT().SaveJob(gomock.Any()).Return(fiber.ErrInternalServerError)
+	err = s.Schedule("test", "", runAt, nil)
+	assert.ErrorIs(t, err, fiber.ErrInternalServerError)
+}
+
+func Test_jobScheduler_RunDueJobs(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockJobRepository(ctrl)
+	s := NewJobScheduler(repo)
+
+	var ran []string
+	s.Register("ok", func(j *domain.Job) error {
+		ran = append(ran, j.ID)
+		return nil
+	})
+	s.Register("failing", func(j *domain.Job) error {
+		return errors.New("failed")
+	})
+	s.Register("panicking", func(j *domain.Job) error {
+		panic("panicked")
+	})
+
+	// ClaimDueJobs returns error
+	repo.EXPECT().ClaimDueJobs(gomock.Any(), gomock.Any(), gomock.Eq(domain.JobBatchSize)).Return(nil, fiber.ErrInternalServerError)
+	err := s.RunDueJobs()
+	assert.ErrorIs(t, err, fiber.ErrInternalServerError)
+
+	// Successful job is removed
+	repo.EXPECT().ClaimDueJobs(gomock.Any(), gomock.Any(), gomock.Eq(domain.JobBatchSize)).Return([]*domain.Job{{ID: "1", Type: "ok", Attempts: 1, MaxAttempts: 5}}, nil)
+	repo.EXPECT().DeleteJob(gomock.Eq("1")).Return(nil)
+	err = s.RunDueJobs()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1"}, ran)
+
+	// Failed job is retried later
+	repo.EXPECT().ClaimDueJobs(gomock.Any(), gomock.Any(), gomock.Eq(domain.JobBatchSize)).Return([]*domain.Job{{ID: "2", Type: "failing", Status: domain.JobStatusRunning, Attempts: 2, MaxAttempts: 5}}, nil)
+	repo.EXPECT().UpdateJob(gomock.Any()).DoAndReturn(func(j *domain.Job) error {
+		assert.Equal(t, domain.JobStatusPending, j.Status)
+		assert.Equal(t, "failed", j.LastError)
+		assert.WithinDuration(t, time.Now().Add(time.Minute), j.RunAt, time.Second)
+		return nil
+	})
+	err = s.RunDueJobs()
+	assert.NoError(t, err)
+
+	// Panicking job on its last attempt is marked as failed
+	repo.EXPECT().ClaimDueJobs(gomock.Any(), gomock.Any(), gomock.Eq(domain.JobBatchSize)).Return([]*domain.Job{{ID: "3", Type: "panicking", Status: domain.JobStatusRunning, Attempts: 5, MaxAttempts: 5}}, nil)
+	repo.EXPECT().UpdateJob(gomock.Any()).DoAndReturn(func(j *domain.Job) error {
+		assert.Equal(t, domain.JobStatusFailed, j.Status)
+		assert.Contains(t, j.LastError, "panicked")
+		return nil
+	})
+	err = s.RunDueJobs()
+	assert.NoError(t, err)
+
+	// Unknown job type fails without retries
+	repo.EXPECT().ClaimDueJobs(gomock.Any(), gomock.Any(), gomock.Eq(domain.JobBatchSize)).Return([]*domain.Job{{ID: "4", Type: "unknown", Status: domain.JobStatusRunning, Attempts: 1, MaxAttempts: 5}}, nil)
+	repo.EXPECT().UpdateJob(gomock.Any()).DoAndReturn(func(j *domain.Job) error {
+		assert.Equal(t, domain.JobStatusFailed, j.Status)
+		return nil
+	})
+	err = s.RunDueJobs()
+	assert.NoError(t, err)
+
+	// Running jobs past their lease are claimed and run again
+	lockedAt := time.Now().Add(-domain.JobLease - time.Minute)
+	ran = nil
+	repo.EXPECT().ClaimDueJobs(gomock.Any(), gomock.Any(), gomock.Eq(domain.JobBatchSize)).DoAndReturn(func(now time.Time, lockedBefore time.Time, limit int) ([]*domain.Job, error) {
+		assert.Equal(t, now.Add(-domain.JobLease), lockedBefore)
+		assert.True(t, lockedAt.Before(lockedBefore))
+		return []*domain.Job{{ID: "5", Type: "ok", Status: domain.JobStatusRunning, Attempts: 2, MaxAttempts: 5, LockedAt: &lockedAt}}, nil
+	})
+	repo.EXPECT().DeleteJob(gomock.Eq("5")).Return(nil)
+	err = s.RunDueJobs()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"5"}, ran)
+
+	// Job abandoned on its last attempt is marked as failed without running it
+	ran = nil
+	repo.EXPECT().ClaimDueJobs(gomock.Any(), gomock.Any(), gomock.Eq(domain.JobBatchSize)).Return([]*domain.Job{{ID: "6", Type: "ok", Status: domain.JobStatusRunning, Attempts: 6, MaxAttempts: 5, LockedAt: &lockedAt}}, nil)
+	repo.EXPECT().UpdateJob(gomock.Any()).DoAndReturn(func(j *domain.Job) error {
+		assert.Equal(t, domain.JobStatusFailed, j.Status)
+		assert.Contains(t, j.LastError, "abandoned")
+		assert.Nil(t, j.LockedAt)
+		return nil
+	})
+	err = s.RunDueJobs()
+	assert.NoError(t, err)
+	assert.Empty(t, ran)
+
+	// Full batches are followed by another claim
+	batch := make([]*domain.Job, domain.JobBatchSize)
+	for i := range batch {
+		batch[i] = &domain.Job{ID: "batch", Type: "ok", Attempts: 1, MaxAttempts: 5}
+	}
+	ran = nil
+	repo.EXPECT().ClaimDueJobs(gomock.Any(), gomock.Any(), gomock.Eq(domain.JobBatchSize)).Return(batch, nil)
+	repo.EXPECT().DeleteJob(gomock.Eq("batch")).Return(nil).Times(domain.JobBatchSize)
+	repo.EXPECT().ClaimDueJobs(gomock.Any(), gomock.Any(), gomock.Eq(domain.JobBatchSize)).Return(nil, nil)
+	err = s.RunDueJobs()
+	assert.NoError(t, err)
+	assert.Len(t, ran, domain.JobBatchSize)
+}
+
+func Test_retryDelay(t *testing.T) {
+	tests := []struct {
+		attempts int
+		want     time.Duration
+	}{
+		{1, 30 * time.Second},
+		{2, time.Minute},
+		{4, 4 * time.Minute},
+		{8, time.Hour},
+		{100, time.Hour},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, retryDelay(tt.attempts), tt.attempts)
+	}
+}
@@ -2,10 +2,12 @@ package meetup
 
 import (
 	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/UpMeetApp/server/pkg/outbox"
 	"github.com/getsentry/sentry-go"
 	"github.com/gofiber/fiber/v2"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"time"
 )
 
 type meetupRepository struct {
@@ -20,7 +22,22 @@ func NewMeetupRepository(db *gorm.DB) domain.MeetupRepository {
 }
 
 func (r *meetupRepository) CreateMeetup(m *domain.Meetup) error {
-	err := r.db.Create(m).Error
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(m).Error
+		if err != nil {
+			return err
+		}
+		err = outbox.Append(tx, domain.EventTypeMeetupCreated, m.ID, m)
+		if err != nil {
+			return err
+		}
+		// The owner is the first participant of every meetup.
+		err = tx.Table("participants").Create(map[string]interface{}{"meetup_id": m.ID, "user_id": m.OwnerID}).Error
+		if err != nil {
+			return err
+		}
+		return outbox.Append(tx, domain.EventTypeParticipantAdded, m.ID, &domain.ParticipantEvent{MeetupID: m.ID, UserID: m.OwnerID})
+	})
 	if err != nil {
 		sentry.CaptureException(err)
 		zap.L().Error("failed to create meetup", zap.Error(err))
@@ -44,7 +61,13 @@ func (r *meetupRepository) GetMeetupByID(id string) (*domain.Meetup, error) {
 }
 
 func (r *meetupRepository) UpdateMeetup(m *domain.Meetup) error {
-	err := r.db.Save(m).Error
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Save(m).Error
+		if err != nil {
+			return err
+		}
+		return outbox.Append(tx, domain.EventTypeMeetupUpdated, m.ID, m)
+	})
 	if err != nil {
 		sentry.CaptureException(err)
 		zap.L().Error("failed to update meetup", zap.Error(err))
@@ -54,7 +77,13 @@ func (r *meetupRepository) UpdateMeetup(m *domain.Meetup) error {
 }
 
 func (r *meetupRepository) DeleteMeetup(id string) error {
-	err := r.db.Delete(&domain.Meetup{}, "id = ?", id).Error
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Select("Participants").Delete(&domain.Meetup{ID: id}).Error
+		if err != nil {
+			return err
+		}
+		return outbox.Append(tx, domain.EventTypeMeetupDeleted, id, &domain.DeletedEvent{ID: id})
+	})
 	if err != nil {
 		sentry.CaptureException(err)
 		zap.L().Error("failed to delete meetup", zap.Error(err))
@@ -62,3 +91,90 @@ func (r *meetupRepository) DeleteMeetup(id string) error {
 	}
 	return nil
 }
+
+func (r *meetupRepository) AddParticipant(meetupID string, userID string) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Table("participants").Create(map[string]interface{}{"meetup_id": meetupID, "user_id": userID}).Error
+		if err != nil {
+			return err
+		}
+		return outbox.Append(tx, domain.EventTypeParticipantAdded, meetupID, &domain.ParticipantEvent{MeetupID: meetupID, UserID: userID})
+	})
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to add participant", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *meetupRepository) RemoveParticipant(meetupID string, userID string) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&domain.Meetup{ID: meetupID}).Association("Participants").Delete(&domain.User{ID: userID})
+		if err != nil {
+			return err
+		}
+		return outbox.Append(tx, domain.EventTypeParticipantRemoved, meetupID, &domain.ParticipantEvent{MeetupID: meetupID, UserID: userID})
+	})
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to remove participant", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *meetupRepository) IsParticipant(meetupID string, userID string) (bool, error) {
+	var count int64
+	err := r.db.Table("participants").Where("meetup_id = ? AND user_id = ?", meetupID, userID).Count(&count).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to check participant", zap.Error(err))
+		return false, fiber.ErrInternalServerError
+	}
+	return count > 0, nil
+}
+
+func (r *meetupRepository) GetParticipantIDs(meetupID string) ([]string, error) {
+	var ids []string
+	err := r.db.Table("participants").Where("meetup_id = ?", meetupID).Pluck("user_id", &ids).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get participant ids", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return ids, nil
+}
+
+func (r *meetupRepository) GetUpcomingMeetupsByParticipant(userID string, from time.Time, to time.Time) ([]*domain.Meetup, error) {
+	var meetups []*domain.Meetup
+	err := r.db.Joins("JOIN participants ON participants.meetup_id = meetups.id").
+		Where("participants.user_id = ? AND meetups.starts_at >= ? AND meetups.starts_at < ?", userID, from, to).
+		Order("meetups.starts_at").
+		Find(&meetups).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get upcoming meetups by participant", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return meetups, nil
+}
+
+func (r *meetupRepository) GetDiscoverableMeetups(from time.Time, excludeOwnerIDs []string, after string, limit int) ([]*domain.Meetup, error) {
+	var meetups []*domain.Meetup
+	q := r.db.Where("NOT invite_only AND starts_at > ?", from).
+		Where("owner_id NOT IN (SELECT id FROM users WHERE status = ?)", domain.UserStatusBanned)
+	if len(excludeOwnerIDs) > 0 {
+		q = q.Where("owner_id NOT IN ?", excludeOwnerIDs)
+	}
+	if len(after) > 0 {
+		q = q.Where("(starts_at, id) > (SELECT starts_at, id FROM meetups WHERE id = ?)", after)
+	}
+	err := q.Order("starts_at, id").Limit(limit).Find(&meetups).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get discoverable meetups", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return meetups, nil
+}
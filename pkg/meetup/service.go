@@ -1,34 +1,409 @@
 package meetup
 
-import "github.com/UpMeetApp/server/pkg/domain"
+import (
+	"encoding/json"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"time"
+)
 
 type meetupService struct {
-	meetupRepository domain.MeetupRepository
+	meetupRepository     domain.MeetupRepository
+	userRepository       domain.UserRepository
+	invitationRepository domain.InvitationRepository
+	blockRepository      domain.BlockRepository
+	contentFilter        domain.ContentFilter
+	abuseService         domain.AbuseService
+	notificationService  domain.NotificationService
+	jobScheduler         domain.JobScheduler
+	hub                  domain.Hub
+	reminderOffset       time.Duration
 }
 
 // NewMeetupService creates a new meetup service instance.
-func NewMeetupService(meetupRepository domain.MeetupRepository) domain.MeetupService {
+func NewMeetupService(meetupRepository domain.MeetupRepository, userRepository domain.UserRepository, invitationRepository domain.InvitationRepository, blockRepository domain.BlockRepository, contentFilter domain.ContentFilter, abuseService domain.AbuseService, notificationService domain.NotificationService, jobScheduler domain.JobScheduler, hub domain.Hub, reminderOffset time.Duration) domain.MeetupService {
 	return &meetupService{
-		meetupRepository: meetupRepository,
+		meetupRepository:     meetupRepository,
+		userRepository:       userRepository,
+		invitationRepository: invitationRepository,
+		blockRepository:      blockRepository,
+		contentFilter:        contentFilter,
+		abuseService:         abuseService,
+		notificationService:  notificationService,
+		jobScheduler:         jobScheduler,
+		hub:                  hub,
+		reminderOffset:       reminderOffset,
 	}
 }
 
 func (s *meetupService) GetMeetupByID(uid string, id string) (*domain.Meetup, error) {
-	//TODO implement me
-	panic("implement me")
+	m, err := s.meetupRepository.GetMeetupByID(id)
+	if err != nil {
+		return nil, err
+	}
+	// Meetups of banned users are hidden like the rest of their content.
+	if m.OwnerID != uid {
+		owner, err := s.userRepository.GetUserByID(m.OwnerID)
+		if err != nil && err != fiber.ErrNotFound {
+			return nil, err
+		}
+		if owner != nil && owner.Status == domain.UserStatusBanned {
+			return nil, fiber.ErrNotFound
+		}
+	}
+	return m, nil
+}
+
+func (s *meetupService) DiscoverMeetups(uid string, after string, limit int) ([]*domain.Meetup, error) {
+	if limit <= 0 || limit > domain.MeetupsMaxLimit {
+		limit = domain.MeetupsDefaultLimit
+	}
+	blockedIDs, err := s.blockRepository.GetBlockedUserIDs(uid)
+	if err != nil {
+		return nil, err
+	}
+	return s.meetupRepository.GetDiscoverableMeetups(time.Now(), blockedIDs, after, limit)
 }
 
 func (s *meetupService) CreateMeetup(uid string, dto *domain.CreateMeetupDTO) (*domain.Meetup, error) {
-	//TODO implement me
-	panic("implement me")
+	if len(dto.Name) < domain.MeetupNameMinLength || len(dto.Name) > domain.MeetupNameMaxLength {
+		return nil, domain.ErrInvalidMeetupName
+	}
+	if len(dto.Description) > domain.MeetupDescriptionMaxLength {
+		return nil, domain.ErrInvalidMeetupDescription
+	}
+	minAge := domain.MeetupNoMinAge
+	if dto.MinAge != 0 && dto.MinAge != domain.MeetupNoMinAge {
+		if dto.MinAge < 0 || dto.MinAge > domain.UserMaxAge {
+			return nil, domain.ErrInvalidMinAge
+		}
+		minAge = dto.MinAge
+	}
+	if dto.StartsAt.IsZero() || !dto.EndsAt.After(dto.StartsAt) {
+		return nil, domain.ErrInvalidMeetupTime
+	}
+	err := s.contentFilter.CheckText(dto.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = s.contentFilter.CheckText(dto.Description)
+	if err != nil {
+		return nil, err
+	}
+	err = s.abuseService.Check(uid, domain.AbuseActionMeetupCreate)
+	if err != nil {
+		return nil, err
+	}
+
+	m := &domain.Meetup{
+		ID:                 uuid.NewString(),
+		Name:               dto.Name,
+		Description:        dto.Description,
+		InviteOnly:         dto.InviteOnly,
+		MinAge:             minAge,
+		RequireVerifiedAge: dto.RequireVerifiedAge,
+		MeetupLocation:     dto.MeetupLocation,
+		OwnerID:            uid,
+		StartsAt:           dto.StartsAt,
+		EndsAt:             dto.EndsAt,
+		CreatedAt:          time.Now(),
+	}
+
+	err = s.meetupRepository.CreateMeetup(m)
+	if err != nil {
+		return nil, err
+	}
+	s.scheduleReminder(m)
+	return m, nil
 }
 
-func (s *meetupService) UpdateMeetup(uid string, dto *domain.UpdateMeetupDTO) (*domain.Meetup, error) {
-	//TODO implement me
-	panic("implement me")
+func (s *meetupService) UpdateMeetup(uid string, id string, dto *domain.UpdateMeetupDTO) (*domain.Meetup, error) {
+	m, err := s.meetupRepository.GetMeetupByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if m.OwnerID != uid {
+		return nil, domain.ErrNotMeetupOwner
+	}
+
+	// Update Name
+	if len(dto.Name) > 0 {
+		if len(dto.Name) < domain.MeetupNameMinLength || len(dto.Name) > domain.MeetupNameMaxLength {
+			return nil, domain.ErrInvalidMeetupName
+		}
+		err = s.contentFilter.CheckText(dto.Name)
+		if err != nil {
+			return nil, err
+		}
+		m.Name = dto.Name
+	}
+
+	// Update Description
+	if len(dto.Description) > 0 {
+		if len(dto.Description) > domain.MeetupDescriptionMaxLength {
+			return nil, domain.ErrInvalidMeetupDescription
+		}
+		err = s.contentFilter.CheckText(dto.Description)
+		if err != nil {
+			return nil, err
+		}
+		m.Description = dto.Description
+	}
+
+	// Update Invite only
+	if dto.InviteOnly != m.InviteOnly {
+		m.InviteOnly = dto.InviteOnly
+	}
+
+	// Update Min age
+	if dto.MinAge != 0 {
+		if dto.MinAge != domain.MeetupNoMinAge && (dto.MinAge < 0 || dto.MinAge > domain.UserMaxAge) {
+			return nil, domain.ErrInvalidMinAge
+		}
+		m.MinAge = dto.MinAge
+	}
+
+	// Update Require verified age
+	if dto.RequireVerifiedAge != nil {
+		m.RequireVerifiedAge = *dto.RequireVerifiedAge
+	}
+
+	// Update Location
+	if dto.MeetupLocation != (domain.MeetupLocation{}) {
+		m.MeetupLocation = dto.MeetupLocation
+	}
+
+	// Update Time
+	timeChanged := false
+	if !dto.StartsAt.IsZero() || !dto.EndsAt.IsZero() {
+		startsAt, endsAt := m.StartsAt, m.EndsAt
+		if !dto.StartsAt.IsZero() {
+			startsAt = dto.StartsAt
+		}
+		if !dto.EndsAt.IsZero() {
+			endsAt = dto.EndsAt
+		}
+		if !endsAt.After(startsAt) {
+			return nil, domain.ErrInvalidMeetupTime
+		}
+		timeChanged = !startsAt.Equal(m.StartsAt)
+		m.StartsAt, m.EndsAt = startsAt, endsAt
+	}
+
+	err = s.meetupRepository.UpdateMeetup(m)
+	if err != nil {
+		return nil, err
+	}
+	if timeChanged {
+		s.scheduleReminder(m)
+	}
+
+	participantIDs := s.publishToParticipants(id, &domain.RealtimeEvent{
+		Type: domain.RealtimeEventMeetupUpdated,
+		Data: m,
+	})
+	s.notify(participantIDs, uid, domain.NotificationTypeMeetupUpdated, m)
+	return m, nil
 }
 
 func (s *meetupService) DeleteMeetup(uid string, id string) error {
-	//TODO implement me
-	panic("implement me")
+	m, err := s.meetupRepository.GetMeetupByID(id)
+	if err != nil {
+		return err
+	}
+	if m.OwnerID != uid {
+		return domain.ErrNotMeetupOwner
+	}
+	return s.deleteMeetup(m, uid)
+}
+
+func (s *meetupService) RemoveMeetup(id string) error {
+	m, err := s.meetupRepository.GetMeetupByID(id)
+	if err != nil {
+		return err
+	}
+	// The meetup is removed by a moderator, so the owner is notified like every other participant.
+	return s.deleteMeetup(m, "")
+}
+
+func (s *meetupService) JoinMeetup(uid string, id string) error {
+	m, err := s.meetupRepository.GetMeetupByID(id)
+	if err != nil {
+		return err
+	}
+	ok, err := s.meetupRepository.IsParticipant(id, uid)
+	if err != nil {
+		return err
+	}
+	if ok {
+		return domain.ErrAlreadyParticipant
+	}
+	invitation, err := s.invitationRepository.GetInvitation(id, uid)
+	if err != nil && err != fiber.ErrNotFound {
+		return err
+	}
+	if m.InviteOnly && invitation == nil {
+		return domain.ErrMeetupInviteOnly
+	}
+
+	u, err := s.userRepository.GetUserByID(uid)
+	if err != nil {
+		return err
+	}
+	if m.MinAge > 0 {
+		if m.RequireVerifiedAge && !u.AgeVerified {
+			return domain.ErrAgeVerificationRequired
+		}
+		if u.Age(time.Now()) < m.MinAge {
+			return domain.ErrMeetupAgeRestricted
+		}
+	}
+	err = s.abuseService.Check(uid, domain.AbuseActionMeetupJoin)
+	if err != nil {
+		return err
+	}
+
+	err = s.meetupRepository.AddParticipant(id, uid)
+	if err != nil {
+		return err
+	}
+	if invitation != nil {
+		err = s.invitationRepository.DeleteInvitation(id, uid)
+		if err != nil {
+			return err
+		}
+	}
+
+	e := &domain.ParticipantEvent{MeetupID: id, UserID: uid}
+	s.publishToParticipants(id, &domain.RealtimeEvent{
+		Type: domain.RealtimeEventParticipantJoined,
+		Data: e,
+	})
+	s.notify([]string{m.OwnerID}, uid, domain.NotificationTypeParticipantJoined, e)
+	return nil
+}
+
+func (s *meetupService) LeaveMeetup(uid string, id string) error {
+	m, err := s.meetupRepository.GetMeetupByID(id)
+	if err != nil {
+		return err
+	}
+	if m.OwnerID == uid {
+		return domain.ErrOwnerCannotLeave
+	}
+	ok, err := s.meetupRepository.IsParticipant(id, uid)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return domain.ErrNotParticipant
+	}
+	err = s.meetupRepository.RemoveParticipant(id, uid)
+	if err != nil {
+		return err
+	}
+
+	e := &domain.RealtimeEvent{
+		Type: domain.RealtimeEventParticipantLeft,
+		Data: &domain.ParticipantEvent{MeetupID: id, UserID: uid},
+	}
+	s.publishToParticipants(id, e)
+	// Chat subscriptions of the user end on this event.
+	s.hub.Publish(domain.MeetupChatTopic(id), e)
+	return nil
+}
+
+func (s *meetupService) SendMeetupReminder(j *domain.Job) error {
+	p := &domain.MeetupReminderJob{}
+	err := json.Unmarshal(j.Payload, p)
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to unmarshal meetup reminder job payload", zap.Error(err))
+		return err
+	}
+
+	m, err := s.meetupRepository.GetMeetupByID(p.MeetupID)
+	if err != nil {
+		// The meetup was deleted after the reminder was claimed, there is nobody left to remind.
+		if err == fiber.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+	participantIDs, err := s.meetupRepository.GetParticipantIDs(m.ID)
+	if err != nil {
+		return err
+	}
+	s.notify(participantIDs, "", domain.NotificationTypeMeetupReminder, m)
+	return nil
+}
+
+// deleteMeetup deletes the meetup, cancels its reminder and tells its participants except the actor that it was cancelled.
+func (s *meetupService) deleteMeetup(m *domain.Meetup, actorID string) error {
+	// The participants are gone after the deletion, so they have to be looked up beforehand.
+	participantIDs, err := s.meetupRepository.GetParticipantIDs(m.ID)
+	if err != nil {
+		return err
+	}
+	err = s.meetupRepository.DeleteMeetup(m.ID)
+	if err != nil {
+		return err
+	}
+	_ = s.jobScheduler.Cancel(domain.MeetupReminderJobKey(m.ID))
+
+	e := &domain.RealtimeEvent{
+		Type: domain.RealtimeEventMeetupCancelled,
+		Data: m,
+	}
+	for _, participantID := range participantIDs {
+		s.hub.Publish(domain.UserTopic(participantID), e)
+	}
+	s.notify(participantIDs, actorID, domain.NotificationTypeMeetupCancelled, m)
+	return nil
+}
+
+// scheduleReminder schedules the reminder of the meetup, replacing the one of its previous start time.
+// Meetups starting within the reminder offset are reminded of right away, the ones in the past not at all.
+// Like notify, failures are only logged because the change was already made.
+func (s *meetupService) scheduleReminder(m *domain.Meetup) {
+	now := time.Now()
+	if !m.StartsAt.After(now) {
+		_ = s.jobScheduler.Cancel(domain.MeetupReminderJobKey(m.ID))
+		return
+	}
+	runAt := m.StartsAt.Add(-s.reminderOffset)
+	if runAt.Before(now) {
+		runAt = now
+	}
+	_ = s.jobScheduler.Schedule(domain.JobTypeMeetupReminder, domain.MeetupReminderJobKey(m.ID), runAt, &domain.MeetupReminderJob{MeetupID: m.ID})
+}
+
+// publishToParticipants publishes a real-time event to every participant of the meetup and returns their ids.
+// The change was already made at this point, so failing to look up the participants is only logged by the repository.
+func (s *meetupService) publishToParticipants(meetupID string, e *domain.RealtimeEvent) []string {
+	participantIDs, err := s.meetupRepository.GetParticipantIDs(meetupID)
+	if err != nil {
+		return nil
+	}
+	for _, participantID := range participantIDs {
+		s.hub.Publish(domain.UserTopic(participantID), e)
+	}
+	return participantIDs
+}
+
+// notify notifies every given user except the one who caused it in the background.
+// Like publishToParticipants, failures are only logged because the change was already made.
+func (s *meetupService) notify(userIDs []string, actorID string, notificationType string, payload interface{}) {
+	recipients := make([]string, 0, len(userIDs))
+	for _, userID := range userIDs {
+		if userID != actorID {
+			recipients = append(recipients, userID)
+		}
+	}
+	if len(recipients) > 0 {
+		_ = s.notificationService.NotifyLater(recipients, notificationType, payload)
+	}
 }
@@ -0,0 +1,456 @@
+package meetup
+
+import (
+	"encoding/json"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/UpMeetApp/server/pkg/domain/mock"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func Test_meetupService_CreateMeetup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockMeetupRepository(ctrl)
+	jobScheduler := mock.NewMockJobScheduler(ctrl)
+	contentFilter := mock.NewMockContentFilter(ctrl)
+	contentFilter.EXPECT().CheckText(gomock.Eq("badword")).Return(domain.ErrInappropriateContent)
+	contentFilter.EXPECT().CheckText(gomock.Any()).Return(nil).AnyTimes()
+	abuseService := mock.NewMockAbuseService(ctrl)
+	s := NewMeetupService(repo, mock.NewMockUserRepository(ctrl), mock.NewMockInvitationRepository(ctrl), mock.NewMockBlockRepository(ctrl), contentFilter, abuseService, mock.NewMockNotificationService(ctrl), jobScheduler, mock.NewMockHub(ctrl), 2*time.Hour)
+
+	uid := "1"
+
+	// Name too short
+	dto := &domain.CreateMeetupDTO{
+		Name: "te",
+	}
+	m, err := s.CreateMeetup(uid, dto)
+	assert.ErrorIs(t, err, domain.ErrInvalidMeetupName)
+	assert.Nil(t, m)
+
+	// Min age invalid
+	dto = &domain.CreateMeetupDTO{
+		Name:   "test",
+		MinAge: 420,
+	}
+	m, err = s.CreateMeetup(uid, dto)
+	assert.ErrorIs(t, err, domain.ErrInvalidMinAge)
+	assert.Nil(t, m)
+
+	// End time before start time
+	dto = &domain.CreateMeetupDTO{
+		Name:     "test",
+		StartsAt: time.Now().Add(time.Hour),
+		EndsAt:   time.Now(),
+	}
+	m, err = s.CreateMeetup(uid, dto)
+	assert.ErrorIs(t, err, domain.ErrInvalidMeetupTime)
+	assert.Nil(t, m)
+
+	// Name with a blocked word
+	dto = &domain.CreateMeetupDTO{
+		Name:     "badword",
+		StartsAt: time.Now(),
+		EndsAt:   time.Now().Add(time.Hour),
+	}
+	m, err = s.CreateMeetup(uid, dto)
+	assert.ErrorIs(t, err, domain.ErrInappropriateContent)
+	assert.Nil(t, m)
+
+	// Meetup quota exceeded
+	dto = &domain.CreateMeetupDTO{
+		Name:     "test",
+		StartsAt: time.Now(),
+		EndsAt:   time.Now().Add(time.Hour),
+	}
+	abuseService.EXPECT().Check(gomock.Eq(uid), gomock.Eq(domain.AbuseActionMeetupCreate)).Return(domain.ErrActionLimitExceeded)
+	m, err = s.CreateMeetup(uid, dto)
+	assert.ErrorIs(t, err, domain.ErrActionLimitExceeded)
+	assert.Nil(t, m)
+
+	// CreateMeetup returns error
+	abuseService.EXPECT().Check(gomock.Eq(uid), gomock.Eq(domain.AbuseActionMeetupCreate)).Return(nil)
+	repo.EXPECT().CreateMeetup(gomock.Any()).Return(fiber.ErrInternalServerError)
+	m, err = s.CreateMeetup(uid, dto)
+	assert.ErrorIs(t, err, fiber.ErrInternalServerError)
+	assert.Nil(t, m)
+
+	// CreateMeetup successful and the reminder is scheduled
+	dto = &domain.CreateMeetupDTO{
+		Name:     "test",
+		MinAge:   18,
+		StartsAt: time.Now().Add(3 * time.Hour),
+		EndsAt:   time.Now().Add(4 * time.Hour),
+	}
+	abuseService.EXPECT().Check(gomock.Eq(uid), gomock.Eq(domain.AbuseActionMeetupCreate)).Return(nil)
+	repo.EXPECT().CreateMeetup(gomock.Any()).Return(nil)
+	jobScheduler.EXPECT().Schedule(gomock.Eq(domain.JobTypeMeetupReminder), gomock.Any(), gomock.Eq(dto.StartsAt.Add(-2*time.Hour)), gomock.Any()).Return(nil)
+	m, err = s.CreateMeetup(uid, dto)
+	assert.NoError(t, err)
+	assert.NotNil(t, m)
+	assert.NotEmpty(t, m.ID)
+	assert.Equal(t, uid, m.OwnerID)
+	assert.Equal(t, dto.MinAge, m.MinAge)
+
+	// Min age defaults to none and meetups starting soon are reminded of right away
+	dto = &domain.CreateMeetupDTO{
+		Name:     "test",
+		StartsAt: time.Now().Add(time.Hour),
+		EndsAt:   time.Now().Add(2 * time.Hour),
+	}
+	abuseService.EXPECT().Check(gomock.Eq(uid), gomock.Eq(domain.AbuseActionMeetupCreate)).Return(nil)
+	repo.EXPECT().CreateMeetup(gomock.Any()).Return(nil)
+	jobScheduler.EXPECT().Schedule(gomock.Eq(domain.JobTypeMeetupReminder), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(func(jobType string, key string, runAt time.Time, payload interface{}) error {
+		assert.WithinDuration(t, time.Now(), runAt, time.Second)
+		return nil
+	})
+	m, err = s.CreateMeetup(uid, dto)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.MeetupNoMinAge, m.MinAge)
+}
+
+func Test_meetupService_UpdateMeetup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockMeetupRepository(ctrl)
+	notificationService := mock.NewMockNotificationService(ctrl)
+	jobScheduler := mock.NewMockJobScheduler(ctrl)
+	hub := mock.NewMockHub(ctrl)
+	contentFilter := mock.NewMockContentFilter(ctrl)
+	contentFilter.EXPECT().CheckText(gomock.Eq("badword")).Return(domain.ErrInappropriateContent)
+	contentFilter.EXPECT().CheckText(gomock.Any()).Return(nil).AnyTimes()
+	s := NewMeetupService(repo, mock.NewMockUserRepository(ctrl), mock.NewMockInvitationRepository(ctrl), mock.NewMockBlockRepository(ctrl), contentFilter, mock.NewMockAbuseService(ctrl), notificationService, jobScheduler, hub, 2*time.Hour)
+
+	uid := "1"
+	id := "m1"
+
+	// Not the owner
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: "2"}, nil)
+	m, err := s.UpdateMeetup(uid, id, &domain.UpdateMeetupDTO{})
+	assert.ErrorIs(t, err, domain.ErrNotMeetupOwner)
+	assert.Nil(t, m)
+
+	// Description too long
+	dto := &domain.UpdateMeetupDTO{
+		Description: string(make([]byte, domain.MeetupDescriptionMaxLength+1)),
+	}
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: uid}, nil)
+	m, err = s.UpdateMeetup(uid, id, dto)
+	assert.ErrorIs(t, err, domain.ErrInvalidMeetupDescription)
+	assert.Nil(t, m)
+
+	// Description with a blocked word
+	dto = &domain.UpdateMeetupDTO{
+		Description: "badword",
+	}
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: uid}, nil)
+	m, err = s.UpdateMeetup(uid, id, dto)
+	assert.ErrorIs(t, err, domain.ErrInappropriateContent)
+	assert.Nil(t, m)
+
+	// Updated end time before start time
+	now := time.Now()
+	dto = &domain.UpdateMeetupDTO{
+		EndsAt: now.Add(-time.Hour),
+	}
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: uid, StartsAt: now, EndsAt: now.Add(time.Hour)}, nil)
+	m, err = s.UpdateMeetup(uid, id, dto)
+	assert.ErrorIs(t, err, domain.ErrInvalidMeetupTime)
+	assert.Nil(t, m)
+
+	// UpdateMeetup successful
+	dto = &domain.UpdateMeetupDTO{
+		Name:   "test",
+		MinAge: domain.MeetupNoMinAge,
+	}
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: uid, MinAge: 18}, nil)
+	repo.EXPECT().UpdateMeetup(gomock.Any()).Return(nil)
+	repo.EXPECT().GetParticipantIDs(gomock.Eq(id)).Return([]string{uid, "2"}, nil)
+	hub.EXPECT().Publish(gomock.Eq(domain.UserTopic(uid)), gomock.Any())
+	hub.EXPECT().Publish(gomock.Eq(domain.UserTopic("2")), gomock.Any())
+	notificationService.EXPECT().NotifyLater(gomock.Eq([]string{"2"}), gomock.Eq(domain.NotificationTypeMeetupUpdated), gomock.Any()).Return(nil)
+	m, err = s.UpdateMeetup(uid, id, dto)
+	assert.NoError(t, err)
+	assert.Equal(t, dto.Name, m.Name)
+	assert.Equal(t, domain.MeetupNoMinAge, m.MinAge)
+
+	// Changed start time reschedules the reminder
+	dto = &domain.UpdateMeetupDTO{
+		StartsAt: now.Add(5 * time.Hour),
+	}
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: uid, StartsAt: now.Add(3 * time.Hour), EndsAt: now.Add(6 * time.Hour)}, nil)
+	repo.EXPECT().UpdateMeetup(gomock.Any()).Return(nil)
+	jobScheduler.EXPECT().Schedule(gomock.Eq(domain.JobTypeMeetupReminder), gomock.Eq(domain.MeetupReminderJobKey(id)), gomock.Eq(now.Add(3*time.Hour)), gomock.Any()).Return(nil)
+	repo.EXPECT().GetParticipantIDs(gomock.Eq(id)).Return([]string{uid}, nil)
+	hub.EXPECT().Publish(gomock.Eq(domain.UserTopic(uid)), gomock.Any())
+	m, err = s.UpdateMeetup(uid, id, dto)
+	assert.NoError(t, err)
+	assert.Equal(t, dto.StartsAt, m.StartsAt)
+}
+
+func Test_meetupService_DeleteMeetup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockMeetupRepository(ctrl)
+	notificationService := mock.NewMockNotificationService(ctrl)
+	jobScheduler := mock.NewMockJobScheduler(ctrl)
+	hub := mock.NewMockHub(ctrl)
+	s := NewMeetupService(repo, mock.NewMockUserRepository(ctrl), mock.NewMockInvitationRepository(ctrl), mock.NewMockBlockRepository(ctrl), mock.NewMockContentFilter(ctrl), mock.NewMockAbuseService(ctrl), notificationService, jobScheduler, hub, 2*time.Hour)
+
+	uid := "1"
+	id := "m1"
+
+	// Not the owner
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: "2"}, nil)
+	err := s.DeleteMeetup(uid, id)
+	assert.ErrorIs(t, err, domain.ErrNotMeetupOwner)
+
+	// DeleteMeetup successful and participants are notified
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: uid}, nil)
+	repo.EXPECT().GetParticipantIDs(gomock.Eq(id)).Return([]string{uid, "2"}, nil)
+	repo.EXPECT().DeleteMeetup(gomock.Eq(id)).Return(nil)
+	jobScheduler.EXPECT().Cancel(gomock.Eq(domain.MeetupReminderJobKey(id))).Return(nil)
+	hub.EXPECT().Publish(gomock.Eq(domain.UserTopic(uid)), gomock.Any())
+	hub.EXPECT().Publish(gomock.Eq(domain.UserTopic("2")), gomock.Any())
+	notificationService.EXPECT().NotifyLater(gomock.Eq([]string{"2"}), gomock.Eq(domain.NotificationTypeMeetupCancelled), gomock.Any()).Return(nil)
+	err = s.DeleteMeetup(uid, id)
+	assert.NoError(t, err)
+}
+
+func Test_meetupService_RemoveMeetup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockMeetupRepository(ctrl)
+	notificationService := mock.NewMockNotificationService(ctrl)
+	jobScheduler := mock.NewMockJobScheduler(ctrl)
+	hub := mock.NewMockHub(ctrl)
+	s := NewMeetupService(repo, mock.NewMockUserRepository(ctrl), mock.NewMockInvitationRepository(ctrl), mock.NewMockBlockRepository(ctrl), mock.NewMockContentFilter(ctrl), mock.NewMockAbuseService(ctrl), notificationService, jobScheduler, hub, 2*time.Hour)
+
+	id := "m1"
+
+	// GetMeetupByID returns error
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(nil, fiber.ErrNotFound)
+	err := s.RemoveMeetup(id)
+	assert.ErrorIs(t, err, fiber.ErrNotFound)
+
+	// RemoveMeetup successful, the reminder is cancelled and every participant including the owner is notified
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: "1"}, nil)
+	repo.EXPECT().GetParticipantIDs(gomock.Eq(id)).Return([]string{"1", "2"}, nil)
+	repo.EXPECT().DeleteMeetup(gomock.Eq(id)).Return(nil)
+	jobScheduler.EXPECT().Cancel(gomock.Eq(domain.MeetupReminderJobKey(id))).Return(nil)
+	hub.EXPECT().Publish(gomock.Eq(domain.UserTopic("1")), gomock.Any())
+	hub.EXPECT().Publish(gomock.Eq(domain.UserTopic("2")), gomock.Any())
+	notificationService.EXPECT().NotifyLater(gomock.Eq([]string{"1", "2"}), gomock.Eq(domain.NotificationTypeMeetupCancelled), gomock.Any()).Return(nil)
+	err = s.RemoveMeetup(id)
+	assert.NoError(t, err)
+}
+
+func Test_meetupService_JoinMeetup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockMeetupRepository(ctrl)
+	userRepo := mock.NewMockUserRepository(ctrl)
+	invitationRepo := mock.NewMockInvitationRepository(ctrl)
+	notificationService := mock.NewMockNotificationService(ctrl)
+	hub := mock.NewMockHub(ctrl)
+	abuseService := mock.NewMockAbuseService(ctrl)
+	s := NewMeetupService(repo, userRepo, invitationRepo, mock.NewMockBlockRepository(ctrl), mock.NewMockContentFilter(ctrl), abuseService, notificationService, mock.NewMockJobScheduler(ctrl), hub, 2*time.Hour)
+
+	uid := "1"
+	id := "m1"
+
+	// Meetup not found
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(nil, fiber.ErrNotFound)
+	err := s.JoinMeetup(uid, id)
+	assert.ErrorIs(t, err, fiber.ErrNotFound)
+
+	// Already participant
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id}, nil)
+	repo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(true, nil)
+	err = s.JoinMeetup(uid, id)
+	assert.ErrorIs(t, err, domain.ErrAlreadyParticipant)
+
+	// Invite only without invitation
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, InviteOnly: true}, nil)
+	repo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(false, nil)
+	invitationRepo.EXPECT().GetInvitation(gomock.Eq(id), gomock.Eq(uid)).Return(nil, fiber.ErrNotFound)
+	err = s.JoinMeetup(uid, id)
+	assert.ErrorIs(t, err, domain.ErrMeetupInviteOnly)
+
+	// Invite only with invitation
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: "2", InviteOnly: true}, nil)
+	repo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(false, nil)
+	invitationRepo.EXPECT().GetInvitation(gomock.Eq(id), gomock.Eq(uid)).Return(&domain.Invitation{MeetupID: id, UserID: uid}, nil)
+	userRepo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{ID: uid}, nil)
+	abuseService.EXPECT().Check(gomock.Eq(uid), gomock.Eq(domain.AbuseActionMeetupJoin)).Return(nil)
+	repo.EXPECT().AddParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(nil)
+	invitationRepo.EXPECT().DeleteInvitation(gomock.Eq(id), gomock.Eq(uid)).Return(nil)
+	repo.EXPECT().GetParticipantIDs(gomock.Eq(id)).Return([]string{"2", uid}, nil)
+	hub.EXPECT().Publish(gomock.Any(), gomock.Any()).Times(2)
+	notificationService.EXPECT().NotifyLater(gomock.Eq([]string{"2"}), gomock.Eq(domain.NotificationTypeParticipantJoined), gomock.Any()).Return(nil)
+	err = s.JoinMeetup(uid, id)
+	assert.NoError(t, err)
+
+	// Too young, the age is computed from the birthdate
+	birthdate := time.Now().AddDate(-18, 0, 1)
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, MinAge: 18}, nil)
+	repo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(false, nil)
+	invitationRepo.EXPECT().GetInvitation(gomock.Eq(id), gomock.Eq(uid)).Return(nil, fiber.ErrNotFound)
+	userRepo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{ID: uid, Birthdate: &birthdate}, nil)
+	err = s.JoinMeetup(uid, id)
+	assert.ErrorIs(t, err, domain.ErrMeetupAgeRestricted)
+
+	// Verified age required
+	birthdate = time.Now().AddDate(-30, 0, 0)
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, MinAge: 18, RequireVerifiedAge: true}, nil)
+	repo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(false, nil)
+	invitationRepo.EXPECT().GetInvitation(gomock.Eq(id), gomock.Eq(uid)).Return(nil, fiber.ErrNotFound)
+	userRepo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{ID: uid, Birthdate: &birthdate}, nil)
+	err = s.JoinMeetup(uid, id)
+	assert.ErrorIs(t, err, domain.ErrAgeVerificationRequired)
+
+	// The verified age takes precedence over the entered one
+	verifiedBirthdate := time.Now().AddDate(-17, 0, 0)
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, MinAge: 18, RequireVerifiedAge: true}, nil)
+	repo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(false, nil)
+	invitationRepo.EXPECT().GetInvitation(gomock.Eq(id), gomock.Eq(uid)).Return(nil, fiber.ErrNotFound)
+	userRepo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{ID: uid, Birthdate: &birthdate, AgeVerified: true, VerifiedBirthdate: &verifiedBirthdate}, nil)
+	err = s.JoinMeetup(uid, id)
+	assert.ErrorIs(t, err, domain.ErrMeetupAgeRestricted)
+
+	// Join limit exceeded
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: "2"}, nil)
+	repo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(false, nil)
+	invitationRepo.EXPECT().GetInvitation(gomock.Eq(id), gomock.Eq(uid)).Return(nil, fiber.ErrNotFound)
+	userRepo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{ID: uid}, nil)
+	abuseService.EXPECT().Check(gomock.Eq(uid), gomock.Eq(domain.AbuseActionMeetupJoin)).Return(domain.ErrActionLimitExceeded)
+	err = s.JoinMeetup(uid, id)
+	assert.ErrorIs(t, err, domain.ErrActionLimitExceeded)
+
+	// JoinMeetup successful and the owner is notified
+	birthdate = time.Now().AddDate(-18, 0, 0)
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: "2", MinAge: 18}, nil)
+	repo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(false, nil)
+	invitationRepo.EXPECT().GetInvitation(gomock.Eq(id), gomock.Eq(uid)).Return(nil, fiber.ErrNotFound)
+	userRepo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{ID: uid, Birthdate: &birthdate}, nil)
+	abuseService.EXPECT().Check(gomock.Eq(uid), gomock.Eq(domain.AbuseActionMeetupJoin)).Return(nil)
+	repo.EXPECT().AddParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(nil)
+	repo.EXPECT().GetParticipantIDs(gomock.Eq(id)).Return([]string{"2", uid}, nil)
+	hub.EXPECT().Publish(gomock.Eq(domain.UserTopic("2")), gomock.Any())
+	hub.EXPECT().Publish(gomock.Eq(domain.UserTopic(uid)), gomock.Any())
+	notificationService.EXPECT().NotifyLater(gomock.Eq([]string{"2"}), gomock.Eq(domain.NotificationTypeParticipantJoined), gomock.Any()).Return(nil)
+	err = s.JoinMeetup(uid, id)
+	assert.NoError(t, err)
+}
+
+func Test_meetupService_LeaveMeetup(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockMeetupRepository(ctrl)
+	notificationService := mock.NewMockNotificationService(ctrl)
+	hub := mock.NewMockHub(ctrl)
+	s := NewMeetupService(repo, mock.NewMockUserRepository(ctrl), mock.NewMockInvitationRepository(ctrl), mock.NewMockBlockRepository(ctrl), mock.NewMockContentFilter(ctrl), mock.NewMockAbuseService(ctrl), notificationService, mock.NewMockJobScheduler(ctrl), hub, 2*time.Hour)
+
+	uid := "1"
+	id := "m1"
+
+	// Owner can't leave
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: uid}, nil)
+	err := s.LeaveMeetup(uid, id)
+	assert.ErrorIs(t, err, domain.ErrOwnerCannotLeave)
+
+	// Not a participant
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: "2"}, nil)
+	repo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(false, nil)
+	err = s.LeaveMeetup(uid, id)
+	assert.ErrorIs(t, err, domain.ErrNotParticipant)
+
+	// LeaveMeetup successful
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: "2"}, nil)
+	repo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(true, nil)
+	repo.EXPECT().RemoveParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(nil)
+	repo.EXPECT().GetParticipantIDs(gomock.Eq(id)).Return([]string{"2"}, nil)
+	hub.EXPECT().Publish(gomock.Eq(domain.UserTopic("2")), gomock.Any())
+	hub.EXPECT().Publish(gomock.Eq(domain.MeetupChatTopic(id)), gomock.Any())
+	err = s.LeaveMeetup(uid, id)
+	assert.NoError(t, err)
+}
+
+func Test_meetupService_SendMeetupReminder(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockMeetupRepository(ctrl)
+	notificationService := mock.NewMockNotificationService(ctrl)
+	s := NewMeetupService(repo, mock.NewMockUserRepository(ctrl), mock.NewMockInvitationRepository(ctrl), mock.NewMockBlockRepository(ctrl), mock.NewMockContentFilter(ctrl), mock.NewMockAbuseService(ctrl), notificationService, mock.NewMockJobScheduler(ctrl), mock.NewMockHub(ctrl), 2*time.Hour)
+
+	id := "m1"
+	j := &domain.Job{Type: domain.JobTypeMeetupReminder, Payload: json.RawMessage(`{"meetup_id":"m1"}`)}
+
+	// Meetup was deleted
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(nil, fiber.ErrNotFound)
+	err := s.SendMeetupReminder(j)
+	assert.NoError(t, err)
+
+	// GetMeetupByID returns error
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(nil, fiber.ErrInternalServerError)
+	err = s.SendMeetupReminder(j)
+	assert.ErrorIs(t, err, fiber.ErrInternalServerError)
+
+	// SendMeetupReminder successful and every participant including the owner is notified
+	m := &domain.Meetup{ID: id, OwnerID: "1"}
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(m, nil)
+	repo.EXPECT().GetParticipantIDs(gomock.Eq(id)).Return([]string{"1", "2"}, nil)
+	notificationService.EXPECT().NotifyLater(gomock.Eq([]string{"1", "2"}), gomock.Eq(domain.NotificationTypeMeetupReminder), gomock.Eq(m)).Return(nil)
+	err = s.SendMeetupReminder(j)
+	assert.NoError(t, err)
+}
+
+func Test_meetupService_DiscoverMeetups(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockMeetupRepository(ctrl)
+	blockRepo := mock.NewMockBlockRepository(ctrl)
+	s := NewMeetupService(repo, mock.NewMockUserRepository(ctrl), mock.NewMockInvitationRepository(ctrl), blockRepo, mock.NewMockContentFilter(ctrl), mock.NewMockAbuseService(ctrl), mock.NewMockNotificationService(ctrl), mock.NewMockJobScheduler(ctrl), mock.NewMockHub(ctrl), 2*time.Hour)
+
+	uid := "1"
+
+	// Meetups of blocked users are left out and the limit defaults
+	meetups := []*domain.Meetup{{ID: "m1"}}
+	blockRepo.EXPECT().GetBlockedUserIDs(gomock.Eq(uid)).Return([]string{"2"}, nil)
+	repo.EXPECT().GetDiscoverableMeetups(gomock.Any(), gomock.Eq([]string{"2"}), gomock.Eq("m0"), gomock.Eq(domain.MeetupsDefaultLimit)).Return(meetups, nil)
+	m, err := s.DiscoverMeetups(uid, "m0", 1000)
+	assert.NoError(t, err)
+	assert.Equal(t, meetups, m)
+
+	// GetBlockedUserIDs returns error
+	blockRepo.EXPECT().GetBlockedUserIDs(gomock.Eq(uid)).Return(nil, fiber.ErrInternalServerError)
+	m, err = s.DiscoverMeetups(uid, "", 10)
+	assert.ErrorIs(t, err, fiber.ErrInternalServerError)
+	assert.Nil(t, m)
+}
+
+func Test_meetupService_GetMeetupByID(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockMeetupRepository(ctrl)
+	userRepo := mock.NewMockUserRepository(ctrl)
+	s := NewMeetupService(repo, userRepo, mock.NewMockInvitationRepository(ctrl), mock.NewMockBlockRepository(ctrl), mock.NewMockContentFilter(ctrl), mock.NewMockAbuseService(ctrl), mock.NewMockNotificationService(ctrl), mock.NewMockJobScheduler(ctrl), mock.NewMockHub(ctrl), 2*time.Hour)
+
+	uid := "1"
+	id := "m1"
+
+	// Meetup of a banned user
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: "2"}, nil)
+	userRepo.EXPECT().GetUserByID(gomock.Eq("2")).Return(&domain.User{ID: "2", Status: domain.UserStatusBanned}, nil)
+	m, err := s.GetMeetupByID(uid, id)
+	assert.ErrorIs(t, err, fiber.ErrNotFound)
+	assert.Nil(t, m)
+
+	// Meetup of an active user
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: "2"}, nil)
+	userRepo.EXPECT().GetUserByID(gomock.Eq("2")).Return(&domain.User{ID: "2", Status: domain.UserStatusActive}, nil)
+	m, err = s.GetMeetupByID(uid, id)
+	assert.NoError(t, err)
+	assert.Equal(t, id, m.ID)
+
+	// Own meetup
+	repo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: uid}, nil)
+	m, err = s.GetMeetupByID(uid, id)
+	assert.NoError(t, err)
+	assert.Equal(t, id, m.ID)
+}
@@ -0,0 +1,71 @@
+package moderation
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type moderationActionRepository struct {
+	db *gorm.DB
+}
+
+// NewModerationActionRepository creates a new moderation action repository instance.
+func NewModerationActionRepository(db *gorm.DB) domain.ModerationActionRepository {
+	return &moderationActionRepository{
+		db: db,
+	}
+}
+
+func (r *moderationActionRepository) CreateModerationAction(a *domain.ModerationAction) error {
+	err := r.db.Create(a).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to create moderation action", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *moderationActionRepository) GetModerationActionByID(id string) (*domain.ModerationAction, error) {
+	a := &domain.ModerationAction{}
+	err := r.db.Where("id = ?", id).First(a).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fiber.ErrNotFound
+		}
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get moderation action by id", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return a, nil
+}
+
+func (r *moderationActionRepository) GetModerationActions(filter *domain.ModerationActionFilter) ([]*domain.ModerationAction, error) {
+	var actions []*domain.ModerationAction
+	q := r.db
+	if len(filter.ReportID) > 0 {
+		q = q.Where("report_id = ?", filter.ReportID)
+	}
+	if len(filter.AppealID) > 0 {
+		q = q.Where("appeal_id = ?", filter.AppealID)
+	}
+	if len(filter.TargetUserID) > 0 {
+		q = q.Where("target_user_id = ?", filter.TargetUserID)
+	}
+	if len(filter.Actions) > 0 {
+		q = q.Where("action IN ?", filter.Actions)
+	}
+	if len(filter.Before) > 0 {
+		q = q.Where("created_at < (SELECT created_at FROM moderation_actions WHERE id = ?)", filter.Before)
+	}
+	err := q.Order("created_at DESC").Limit(filter.Limit).Find(&actions).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get moderation actions", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return actions, nil
+}
@@ -0,0 +1,89 @@
+package moderation
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type appealRepository struct {
+	db *gorm.DB
+}
+
+// NewAppealRepository creates a new appeal repository instance.
+func NewAppealRepository(db *gorm.DB) domain.AppealRepository {
+	return &appealRepository{
+		db: db,
+	}
+}
+
+func (r *appealRepository) CreateAppeal(a *domain.Appeal) error {
+	err := r.db.Create(a).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to create appeal", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *appealRepository) GetAppealByID(id string) (*domain.Appeal, error) {
+	a := &domain.Appeal{}
+	err := r.db.Where("id = ?", id).First(a).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fiber.ErrNotFound
+		}
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get appeal by id", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return a, nil
+}
+
+func (r *appealRepository) GetAppealByActionID(actionID string) (*domain.Appeal, error) {
+	a := &domain.Appeal{}
+	err := r.db.Where("action_id = ?", actionID).First(a).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fiber.ErrNotFound
+		}
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get appeal by action id", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return a, nil
+}
+
+func (r *appealRepository) GetAppeals(filter *domain.AppealFilter) ([]*domain.Appeal, error) {
+	var appeals []*domain.Appeal
+	q := r.db
+	if len(filter.Status) > 0 {
+		q = q.Where("status = ?", filter.Status)
+	}
+	if len(filter.UserID) > 0 {
+		q = q.Where("user_id = ?", filter.UserID)
+	}
+	if len(filter.Before) > 0 {
+		q = q.Where("created_at < (SELECT created_at FROM appeals WHERE id = ?)", filter.Before)
+	}
+	err := q.Order("created_at DESC").Limit(filter.Limit).Find(&appeals).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get appeals", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return appeals, nil
+}
+
+func (r *appealRepository) UpdateAppeal(a *domain.Appeal) error {
+	err := r.db.Save(a).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to update appeal", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
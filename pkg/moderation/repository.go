@@ -0,0 +1,99 @@
+package moderation
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type reportRepository struct {
+	db *gorm.DB
+}
+
+// NewReportRepository creates a new report repository instance.
+func NewReportRepository(db *gorm.DB) domain.ReportRepository {
+	return &reportRepository{
+		db: db,
+	}
+}
+
+func (r *reportRepository) CreateReport(report *domain.Report) error {
+	err := r.db.Create(report).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to create report", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *reportRepository) GetReportByID(id string) (*domain.Report, error) {
+	report := &domain.Report{}
+	err := r.db.Where("id = ?", id).First(report).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fiber.ErrNotFound
+		}
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get report by id", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return report, nil
+}
+
+func (r *reportRepository) GetOpenReport(reporterID string, targetType string, targetID string) (*domain.Report, error) {
+	report := &domain.Report{}
+	err := r.db.Where("reporter_id = ? AND target_type = ? AND target_id = ? AND status = ?", reporterID, targetType, targetID, domain.ReportStatusOpen).
+		First(report).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fiber.ErrNotFound
+		}
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get open report", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return report, nil
+}
+
+func (r *reportRepository) GetReports(filter *domain.ReportFilter) ([]*domain.Report, error) {
+	var reports []*domain.Report
+	q := r.db
+	if len(filter.Status) > 0 {
+		q = q.Where("status = ?", filter.Status)
+	}
+	if len(filter.TargetType) > 0 {
+		q = q.Where("target_type = ?", filter.TargetType)
+	}
+	if len(filter.Reason) > 0 {
+		q = q.Where("reason = ?", filter.Reason)
+	}
+	if len(filter.AssigneeID) > 0 {
+		q = q.Where("assignee_id = ?", filter.AssigneeID)
+	}
+	if filter.Unassigned {
+		q = q.Where("assignee_id = ''")
+	}
+	if len(filter.Before) > 0 {
+		q = q.Where("created_at < (SELECT created_at FROM reports WHERE id = ?)", filter.Before)
+	}
+	err := q.Order("created_at DESC").Limit(filter.Limit).Find(&reports).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get reports", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return reports, nil
+}
+
+func (r *reportRepository) UpdateReport(report *domain.Report) error {
+	err := r.db.Save(report).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to update report", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
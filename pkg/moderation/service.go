@@ -0,0 +1,590 @@
+package moderation
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"time"
+)
+
+type moderationService struct {
+	reportRepository           domain.ReportRepository
+	moderationActionRepository domain.ModerationActionRepository
+	appealRepository           domain.AppealRepository
+	userRepository             domain.UserRepository
+	meetupRepository           domain.MeetupRepository
+	meetupService              domain.MeetupService
+	messageRepository          domain.MessageRepository
+	conversationRepository     domain.ConversationRepository
+	avatarService              domain.AvatarService
+	notificationService        domain.NotificationService
+	hub                        domain.Hub
+}
+
+// NewModerationService creates a new moderation service instance.
+func NewModerationService(reportRepository domain.ReportRepository, moderationActionRepository domain.ModerationActionRepository, appealRepository domain.AppealRepository, userRepository domain.UserRepository, meetupRepository domain.MeetupRepository, meetupService domain.MeetupService, messageRepository domain.MessageRepository, conversationRepository domain.ConversationRepository, avatarService domain.AvatarService, notificationService domain.NotificationService, hub domain.Hub) domain.ModerationService {
+	return &moderationService{
+		reportRepository:           reportRepository,
+		moderationActionRepository: moderationActionRepository,
+		appealRepository:           appealRepository,
+		userRepository:             userRepository,
+		meetupRepository:           meetupRepository,
+		meetupService:              meetupService,
+		messageRepository:          messageRepository,
+		conversationRepository:     conversationRepository,
+		avatarService:              avatarService,
+		notificationService:        notificationService,
+		hub:                        hub,
+	}
+}
+
+func (s *moderationService) CreateReport(uid string, dto *domain.CreateReportDTO) (*domain.Report, error) {
+	if !isReportReason(dto.Reason) {
+		return nil, domain.ErrInvalidReportReason
+	}
+	if len(dto.Details) > domain.ReportDetailsMaxLength || (dto.Reason == domain.ReportReasonOther && len(dto.Details) == 0) {
+		return nil, domain.ErrInvalidReportDetails
+	}
+
+	targetUserID, err := s.reportTargetUserID(uid, dto.TargetType, dto.TargetID)
+	if err != nil {
+		return nil, err
+	}
+	if targetUserID == uid {
+		return nil, domain.ErrCannotReportSelf
+	}
+
+	_, err = s.reportRepository.GetOpenReport(uid, dto.TargetType, dto.TargetID)
+	if err == nil {
+		return nil, domain.ErrAlreadyReported
+	}
+	if err != fiber.ErrNotFound {
+		return nil, err
+	}
+
+	r := &domain.Report{
+		ID:           uuid.NewString(),
+		ReporterID:   uid,
+		TargetType:   dto.TargetType,
+		TargetID:     dto.TargetID,
+		TargetUserID: targetUserID,
+		Reason:       dto.Reason,
+		Details:      dto.Details,
+		Status:       domain.ReportStatusOpen,
+		CreatedAt:    time.Now(),
+	}
+	err = s.reportRepository.CreateReport(r)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (s *moderationService) GetReports(filter *domain.ReportFilter) ([]*domain.Report, error) {
+	if filter.Limit <= 0 || filter.Limit > domain.ReportsMaxLimit {
+		filter.Limit = domain.ReportsDefaultLimit
+	}
+	return s.reportRepository.GetReports(filter)
+}
+
+func (s *moderationService) GetReport(id string) (*domain.Report, error) {
+	return s.reportRepository.GetReportByID(id)
+}
+
+func (s *moderationService) AssignReport(adminID string, id string, dto *domain.AssignReportDTO) (*domain.Report, error) {
+	r, err := s.getOpenReport(id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.AssigneeID = adminID
+	if len(dto.AssigneeID) > 0 {
+		r.AssigneeID = dto.AssigneeID
+	}
+	err = s.reportRepository.UpdateReport(r)
+	if err != nil {
+		return nil, err
+	}
+	err = s.logAction(adminID, r, domain.ModerationActionAssign, "")
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (s *moderationService) UnassignReport(adminID string, id string) (*domain.Report, error) {
+	r, err := s.getOpenReport(id)
+	if err != nil {
+		return nil, err
+	}
+
+	r.AssigneeID = ""
+	err = s.reportRepository.UpdateReport(r)
+	if err != nil {
+		return nil, err
+	}
+	err = s.logAction(adminID, r, domain.ModerationActionUnassign, "")
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (s *moderationService) ResolveReport(adminID string, id string, dto *domain.ResolveReportDTO) (*domain.Report, error) {
+	if len(dto.Note) > domain.ModerationNoteMaxLength {
+		return nil, domain.ErrInvalidModerationNote
+	}
+	r, err := s.getOpenReport(id)
+	if err != nil {
+		return nil, err
+	}
+
+	switch dto.Action {
+	case domain.ModerationActionDismiss:
+	case domain.ModerationActionWarn:
+		err = s.notificationService.NotifyLater([]string{r.TargetUserID}, domain.NotificationTypeModerationWarning, &domain.ModerationWarning{
+			ReportID:   r.ID,
+			TargetType: r.TargetType,
+			TargetID:   r.TargetID,
+			Reason:     r.Reason,
+			Note:       dto.Note,
+		})
+	case domain.ModerationActionRemoveContent:
+		err = s.removeContent(r)
+	case domain.ModerationActionSuspendUser:
+		var u *domain.User
+		u, err = s.userRepository.GetUserByID(r.TargetUserID)
+		if err != nil {
+			return nil, err
+		}
+		// The suspended user is shown the note, or the reason of the report without one.
+		reason := dto.Note
+		if len(reason) == 0 {
+			reason = r.Reason
+		}
+		err = s.setUserStatus(u, domain.UserStatusSuspended, reason, dto.SuspensionDays)
+	default:
+		return nil, domain.ErrInvalidModerationAction
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	r.Status = domain.ReportStatusResolved
+	r.Resolution = dto.Action
+	r.ResolvedBy = adminID
+	r.ResolvedAt = &now
+	err = s.reportRepository.UpdateReport(r)
+	if err != nil {
+		return nil, err
+	}
+	err = s.logAction(adminID, r, dto.Action, dto.Note)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (s *moderationService) GetModerationActions(filter *domain.ModerationActionFilter) ([]*domain.ModerationAction, error) {
+	if filter.Limit <= 0 || filter.Limit > domain.ReportsMaxLimit {
+		filter.Limit = domain.ReportsDefaultLimit
+	}
+	return s.moderationActionRepository.GetModerationActions(filter)
+}
+
+func (s *moderationService) UpdateUserStatus(adminID string, userID string, dto *domain.UpdateUserStatusDTO) (*domain.User, error) {
+	var action string
+	switch dto.Status {
+	case domain.UserStatusActive:
+		action = domain.ModerationActionReactivateUser
+	case domain.UserStatusSuspended:
+		action = domain.ModerationActionSuspendUser
+	case domain.UserStatusBanned:
+		action = domain.ModerationActionBanUser
+	default:
+		return nil, domain.ErrInvalidUserStatus
+	}
+	u, err := s.userRepository.GetUserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.setUserStatus(u, dto.Status, dto.Reason, dto.SuspensionDays)
+	if err != nil {
+		return nil, err
+	}
+	err = s.moderationActionRepository.CreateModerationAction(&domain.ModerationAction{
+		ID:           uuid.NewString(),
+		ModeratorID:  adminID,
+		Action:       action,
+		TargetUserID: u.ID,
+		Note:         dto.Reason,
+		CreatedAt:    time.Now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// GetUserModerationActions returns the appealable actions taken against the user. Moderators stay anonymous to users.
+func (s *moderationService) GetUserModerationActions(uid string) ([]*domain.ModerationAction, error) {
+	actions, err := s.moderationActionRepository.GetModerationActions(&domain.ModerationActionFilter{
+		TargetUserID: uid,
+		Actions:      domain.AppealableActions,
+		Limit:        domain.ReportsMaxLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range actions {
+		a.ModeratorID = ""
+		a.AssigneeID = ""
+	}
+	return actions, nil
+}
+
+func (s *moderationService) CreateAppeal(uid string, dto *domain.CreateAppealDTO) (*domain.Appeal, error) {
+	if len(dto.Text) == 0 || len(dto.Text) > domain.AppealTextMaxLength {
+		return nil, domain.ErrInvalidAppealText
+	}
+	a, err := s.moderationActionRepository.GetModerationActionByID(dto.ActionID)
+	if err != nil {
+		return nil, err
+	}
+	// Actions against other users are hidden.
+	if a.TargetUserID != uid {
+		return nil, fiber.ErrNotFound
+	}
+	if !isAppealable(a.Action) {
+		return nil, domain.ErrNotAppealable
+	}
+	if time.Since(a.CreatedAt) > domain.AppealWindow {
+		return nil, domain.ErrAppealWindowClosed
+	}
+	_, err = s.appealRepository.GetAppealByActionID(a.ID)
+	if err == nil {
+		return nil, domain.ErrAlreadyAppealed
+	}
+	if err != fiber.ErrNotFound {
+		return nil, err
+	}
+
+	appeal := &domain.Appeal{
+		ID:        uuid.NewString(),
+		UserID:    uid,
+		ActionID:  a.ID,
+		Action:    a.Action,
+		Text:      dto.Text,
+		Status:    domain.AppealStatusPending,
+		CreatedAt: time.Now(),
+	}
+	err = s.appealRepository.CreateAppeal(appeal)
+	if err != nil {
+		return nil, err
+	}
+	return appeal, nil
+}
+
+func (s *moderationService) GetUserAppeals(uid string) ([]*domain.Appeal, error) {
+	appeals, err := s.appealRepository.GetAppeals(&domain.AppealFilter{
+		UserID: uid,
+		Limit:  domain.ReportsMaxLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range appeals {
+		a.ResolvedBy = ""
+	}
+	return appeals, nil
+}
+
+func (s *moderationService) GetAppeals(filter *domain.AppealFilter) ([]*domain.Appeal, error) {
+	if filter.Limit <= 0 || filter.Limit > domain.ReportsMaxLimit {
+		filter.Limit = domain.ReportsDefaultLimit
+	}
+	return s.appealRepository.GetAppeals(filter)
+}
+
+func (s *moderationService) GetAppeal(id string) (*domain.Appeal, error) {
+	return s.appealRepository.GetAppealByID(id)
+}
+
+func (s *moderationService) ResolveAppeal(adminID string, id string, dto *domain.ResolveAppealDTO) (*domain.Appeal, error) {
+	var action string
+	switch dto.Status {
+	case domain.AppealStatusUpheld:
+		action = domain.ModerationActionUpholdAppeal
+	case domain.AppealStatusOverturned:
+		action = domain.ModerationActionOverturnAppeal
+	default:
+		return nil, domain.ErrInvalidAppealStatus
+	}
+	if len(dto.Note) > domain.ModerationNoteMaxLength {
+		return nil, domain.ErrInvalidModerationNote
+	}
+	appeal, err := s.appealRepository.GetAppealByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if appeal.Status != domain.AppealStatusPending {
+		return nil, domain.ErrAppealResolved
+	}
+	a, err := s.moderationActionRepository.GetModerationActionByID(appeal.ActionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if dto.Status == domain.AppealStatusOverturned {
+		err = s.revertAction(a)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	appeal.Status = dto.Status
+	appeal.Note = dto.Note
+	appeal.ResolvedBy = adminID
+	appeal.ResolvedAt = &now
+	err = s.appealRepository.UpdateAppeal(appeal)
+	if err != nil {
+		return nil, err
+	}
+	err = s.moderationActionRepository.CreateModerationAction(&domain.ModerationAction{
+		ID:           uuid.NewString(),
+		ReportID:     a.ReportID,
+		AppealID:     appeal.ID,
+		ModeratorID:  adminID,
+		Action:       action,
+		TargetUserID: appeal.UserID,
+		Note:         dto.Note,
+		CreatedAt:    now,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// The appeal was already resolved, so a failed notification is only logged.
+	_ = s.notificationService.NotifyLater([]string{appeal.UserID}, domain.NotificationTypeAppealResolved, &domain.AppealResolution{
+		AppealID: appeal.ID,
+		ActionID: a.ID,
+		Action:   a.Action,
+		Status:   appeal.Status,
+		Note:     appeal.Note,
+	})
+	return appeal, nil
+}
+
+// revertAction reverts an overturned moderation action as far as possible.
+// Suspensions and bans are lifted unless the status of the user has changed since, removed content is gone for good.
+func (s *moderationService) revertAction(a *domain.ModerationAction) error {
+	var status string
+	switch a.Action {
+	case domain.ModerationActionSuspendUser:
+		status = domain.UserStatusSuspended
+	case domain.ModerationActionBanUser:
+		status = domain.UserStatusBanned
+	default:
+		return nil
+	}
+	u, err := s.userRepository.GetUserByID(a.TargetUserID)
+	if err != nil {
+		return err
+	}
+	if u.Status != status {
+		return nil
+	}
+	return s.setUserStatus(u, domain.UserStatusActive, "", 0)
+}
+
+// reportTargetUserID returns the user responsible for the reported target.
+// Messages can only be reported by users who are able to read them.
+func (s *moderationService) reportTargetUserID(uid string, targetType string, targetID string) (string, error) {
+	switch targetType {
+	case domain.ReportTargetUser:
+		u, err := s.userRepository.GetUserByID(targetID)
+		if err != nil {
+			return "", err
+		}
+		return u.ID, nil
+	case domain.ReportTargetMeetup:
+		m, err := s.meetupRepository.GetMeetupByID(targetID)
+		if err != nil {
+			return "", err
+		}
+		return m.OwnerID, nil
+	case domain.ReportTargetMessage:
+		m, err := s.messageRepository.GetMessageByID(targetID)
+		if err != nil {
+			return "", err
+		}
+		memberIDs, err := s.messageMemberIDs(m)
+		if err != nil {
+			return "", err
+		}
+		for _, memberID := range memberIDs {
+			if memberID == uid {
+				return m.AuthorID, nil
+			}
+		}
+		return "", fiber.ErrNotFound
+	default:
+		return "", domain.ErrInvalidReportTarget
+	}
+}
+
+// removeContent removes the reported content, see domain.ModerationActionRemoveContent.
+func (s *moderationService) removeContent(r *domain.Report) error {
+	switch r.TargetType {
+	case domain.ReportTargetUser:
+		u, err := s.userRepository.GetUserByID(r.TargetID)
+		if err != nil {
+			return err
+		}
+		avatarID := u.AvatarID
+		u.Bio = ""
+		u.ProfilePicture = ""
+		u.AvatarID = ""
+		err = s.userRepository.UpdateUser(u)
+		if err != nil {
+			return err
+		}
+		// The picture is no longer shown, so files that couldn't be deleted are only logged.
+		_ = s.avatarService.DeleteAvatarFiles(u.ID, avatarID)
+		return nil
+	case domain.ReportTargetMeetup:
+		err := s.meetupService.RemoveMeetup(r.TargetID)
+		// The owner may have deleted the meetup in the meantime.
+		if err == fiber.ErrNotFound {
+			return nil
+		}
+		return err
+	case domain.ReportTargetMessage:
+		m, err := s.messageRepository.GetMessageByID(r.TargetID)
+		if err != nil {
+			return err
+		}
+		if m.Deleted {
+			return nil
+		}
+		now := time.Now()
+		m.Content = ""
+		m.Deleted = true
+		m.DeletedAt = &now
+		err = s.messageRepository.UpdateMessage(m)
+		if err != nil {
+			return err
+		}
+		s.publishMessageDeleted(m)
+		return nil
+	default:
+		return domain.ErrInvalidModerationAction
+	}
+}
+
+// setUserStatus sets the status of the user, the reason is required for suspensions and bans.
+func (s *moderationService) setUserStatus(u *domain.User, status string, reason string, suspensionDays int) error {
+	if status != domain.UserStatusActive && (len(reason) == 0 || len(reason) > domain.UserStatusReasonMaxLength) {
+		return domain.ErrInvalidStatusReason
+	}
+	if suspensionDays < 0 || suspensionDays > domain.SuspensionMaxDays {
+		return domain.ErrInvalidSuspensionDuration
+	}
+
+	u.Status = status
+	u.StatusReason = reason
+	u.SuspendedUntil = nil
+	switch status {
+	case domain.UserStatusActive:
+		u.StatusReason = ""
+	case domain.UserStatusSuspended:
+		if suspensionDays == 0 {
+			suspensionDays = domain.SuspensionDefaultDays
+		}
+		until := time.Now().AddDate(0, 0, suspensionDays)
+		u.SuspendedUntil = &until
+	}
+	return s.userRepository.UpdateUserStatus(u)
+}
+
+// messageMemberIDs returns the users who can read the message, the participants of its meetup or the members of its conversation.
+func (s *moderationService) messageMemberIDs(m *domain.Message) ([]string, error) {
+	if len(m.MeetupID) > 0 {
+		return s.meetupRepository.GetParticipantIDs(m.MeetupID)
+	}
+	c, err := s.conversationRepository.GetConversationByID(m.ConversationID)
+	if err != nil {
+		return nil, err
+	}
+	memberIDs := make([]string, 0, len(c.Members))
+	for _, cm := range c.Members {
+		memberIDs = append(memberIDs, cm.UserID)
+	}
+	return memberIDs, nil
+}
+
+// publishMessageDeleted tells the clients showing the chat of the message that it was removed, just like a deletion by its author.
+func (s *moderationService) publishMessageDeleted(m *domain.Message) {
+	e := &domain.RealtimeEvent{
+		Type: domain.RealtimeEventMessageDeleted,
+		Data: m,
+	}
+	if len(m.MeetupID) > 0 {
+		s.hub.Publish(domain.MeetupChatTopic(m.MeetupID), e)
+		return
+	}
+	memberIDs, err := s.messageMemberIDs(m)
+	if err != nil {
+		return
+	}
+	for _, memberID := range memberIDs {
+		s.hub.Publish(domain.UserTopic(memberID), e)
+	}
+}
+
+func (s *moderationService) getOpenReport(id string) (*domain.Report, error) {
+	r, err := s.reportRepository.GetReportByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if r.Status != domain.ReportStatusOpen {
+		return nil, domain.ErrReportResolved
+	}
+	return r, nil
+}
+
+func (s *moderationService) logAction(adminID string, r *domain.Report, action string, note string) error {
+	return s.moderationActionRepository.CreateModerationAction(&domain.ModerationAction{
+		ID:           uuid.NewString(),
+		ReportID:     r.ID,
+		ModeratorID:  adminID,
+		Action:       action,
+		TargetUserID: r.TargetUserID,
+		AssigneeID:   r.AssigneeID,
+		Note:         note,
+		CreatedAt:    time.Now(),
+	})
+}
+
+// isAppealable returns whether users can appeal the moderation action.
+func isAppealable(action string) bool {
+	for _, a := range domain.AppealableActions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+// isReportReason returns whether reason is a known report reason.
+func isReportReason(reason string) bool {
+	for _, r := range domain.ReportReasons {
+		if r == reason {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,397 @@
+package moderation
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/UpMeetApp/server/pkg/domain/mock"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+type mocks struct {
+	reportRepo       *mock.MockReportRepository
+	actionRepo       *mock.MockModerationActionRepository
+	appealRepo       *mock.MockAppealRepository
+	userRepo         *mock.MockUserRepository
+	meetupRepo       *mock.MockMeetupRepository
+	meetups          *mock.MockMeetupService
+	messageRepo      *mock.MockMessageRepository
+	conversationRepo *mock.MockConversationRepository
+	avatars          *mock.MockAvatarService
+	notifications    *mock.MockNotificationService
+	hub              *mock.MockHub
+}
+
+func newTestService(t *testing.T) (domain.ModerationService, *mocks) {
+	ctrl := gomock.NewController(t)
+	m := &mocks{
+		reportRepo:       mock.NewMockReportRepository(ctrl),
+		actionRepo:       mock.NewMockModerationActionRepository(ctrl),
+		appealRepo:       mock.NewMockAppealRepository(ctrl),
+		userRepo:         mock.NewMockUserRepository(ctrl),
+		meetupRepo:       mock.NewMockMeetupRepository(ctrl),
+		meetups:          mock.NewMockMeetupService(ctrl),
+		messageRepo:      mock.NewMockMessageRepository(ctrl),
+		conversationRepo: mock.NewMockConversationRepository(ctrl),
+		avatars:          mock.NewMockAvatarService(ctrl),
+		notifications:    mock.NewMockNotificationService(ctrl),
+		hub:              mock.NewMockHub(ctrl),
+	}
+	s := NewModerationService(m.reportRepo, m.actionRepo, m.appealRepo, m.userRepo, m.meetupRepo, m.meetups, m.messageRepo, m.conversationRepo, m.avatars, m.notifications, m.hub)
+	return s, m
+}
+
+func Test_moderationService_CreateReport(t *testing.T) {
+	s, m := newTestService(t)
+
+	uid := "1"
+
+	// Unknown reason
+	r, err := s.CreateReport(uid, &domain.CreateReportDTO{TargetType: domain.ReportTargetUser, TargetID: "2", Reason: "boring"})
+	assert.ErrorIs(t, err, domain.ErrInvalidReportReason)
+	assert.Nil(t, r)
+
+	// Reason other without details
+	r, err = s.CreateReport(uid, &domain.CreateReportDTO{TargetType: domain.ReportTargetUser, TargetID: "2", Reason: domain.ReportReasonOther})
+	assert.ErrorIs(t, err, domain.ErrInvalidReportDetails)
+	assert.Nil(t, r)
+
+	// Unknown target type
+	r, err = s.CreateReport(uid, &domain.CreateReportDTO{TargetType: "group", TargetID: "2", Reason: domain.ReportReasonSpam})
+	assert.ErrorIs(t, err, domain.ErrInvalidReportTarget)
+	assert.Nil(t, r)
+
+	// Own meetup
+	m.meetupRepo.EXPECT().GetMeetupByID(gomock.Eq("m1")).Return(&domain.Meetup{ID: "m1", OwnerID: uid}, nil)
+	r, err = s.CreateReport(uid, &domain.CreateReportDTO{TargetType: domain.ReportTargetMeetup, TargetID: "m1", Reason: domain.ReportReasonSpam})
+	assert.ErrorIs(t, err, domain.ErrCannotReportSelf)
+	assert.Nil(t, r)
+
+	// Message of a chat the reporter can't read
+	m.messageRepo.EXPECT().GetMessageByID(gomock.Eq("msg1")).Return(&domain.Message{ID: "msg1", MeetupID: "m1", AuthorID: "2"}, nil)
+	m.meetupRepo.EXPECT().GetParticipantIDs(gomock.Eq("m1")).Return([]string{"2", "3"}, nil)
+	r, err = s.CreateReport(uid, &domain.CreateReportDTO{TargetType: domain.ReportTargetMessage, TargetID: "msg1", Reason: domain.ReportReasonHarassment})
+	assert.ErrorIs(t, err, fiber.ErrNotFound)
+	assert.Nil(t, r)
+
+	// Already reported
+	m.userRepo.EXPECT().GetUserByID(gomock.Eq("2")).Return(&domain.User{ID: "2"}, nil)
+	m.reportRepo.EXPECT().GetOpenReport(gomock.Eq(uid), gomock.Eq(domain.ReportTargetUser), gomock.Eq("2")).Return(&domain.Report{ID: "r1"}, nil)
+	r, err = s.CreateReport(uid, &domain.CreateReportDTO{TargetType: domain.ReportTargetUser, TargetID: "2", Reason: domain.ReportReasonSpam})
+	assert.ErrorIs(t, err, domain.ErrAlreadyReported)
+	assert.Nil(t, r)
+
+	// Success, the message author is the reported user
+	m.messageRepo.EXPECT().GetMessageByID(gomock.Eq("msg1")).Return(&domain.Message{ID: "msg1", ConversationID: "c1", AuthorID: "2"}, nil)
+	m.conversationRepo.EXPECT().GetConversationByID(gomock.Eq("c1")).Return(&domain.Conversation{ID: "c1", Members: []*domain.ConversationMember{{UserID: uid}, {UserID: "2"}}}, nil)
+	m.reportRepo.EXPECT().GetOpenReport(gomock.Eq(uid), gomock.Eq(domain.ReportTargetMessage), gomock.Eq("msg1")).Return(nil, fiber.ErrNotFound)
+	m.reportRepo.EXPECT().CreateReport(gomock.Any()).Return(nil)
+	r, err = s.CreateReport(uid, &domain.CreateReportDTO{TargetType: domain.ReportTargetMessage, TargetID: "msg1", Reason: domain.ReportReasonHarassment, Details: "insults"})
+	assert.NoError(t, err)
+	assert.Equal(t, uid, r.ReporterID)
+	assert.Equal(t, "2", r.TargetUserID)
+	assert.Equal(t, domain.ReportStatusOpen, r.Status)
+	assert.NotEmpty(t, r.ID)
+}
+
+func Test_moderationService_AssignReport(t *testing.T) {
+	s, m := newTestService(t)
+
+	adminID := "admin"
+
+	// Resolved report
+	m.reportRepo.EXPECT().GetReportByID(gomock.Eq("r1")).Return(&domain.Report{ID: "r1", Status: domain.ReportStatusResolved}, nil)
+	r, err := s.AssignReport(adminID, "r1", &domain.AssignReportDTO{})
+	assert.ErrorIs(t, err, domain.ErrReportResolved)
+	assert.Nil(t, r)
+
+	// Assigned to the acting admin by default and logged
+	m.reportRepo.EXPECT().GetReportByID(gomock.Eq("r1")).Return(&domain.Report{ID: "r1", Status: domain.ReportStatusOpen, TargetUserID: "2"}, nil)
+	m.reportRepo.EXPECT().UpdateReport(gomock.Any()).Return(nil)
+	m.actionRepo.EXPECT().CreateModerationAction(gomock.Any()).DoAndReturn(func(a *domain.ModerationAction) error {
+		assert.Equal(t, domain.ModerationActionAssign, a.Action)
+		assert.Equal(t, adminID, a.ModeratorID)
+		assert.Equal(t, adminID, a.AssigneeID)
+		assert.Equal(t, "2", a.TargetUserID)
+		return nil
+	})
+	r, err = s.AssignReport(adminID, "r1", &domain.AssignReportDTO{})
+	assert.NoError(t, err)
+	assert.Equal(t, adminID, r.AssigneeID)
+
+	// Unassign
+	m.reportRepo.EXPECT().GetReportByID(gomock.Eq("r1")).Return(&domain.Report{ID: "r1", Status: domain.ReportStatusOpen, AssigneeID: adminID}, nil)
+	m.reportRepo.EXPECT().UpdateReport(gomock.Any()).Return(nil)
+	m.actionRepo.EXPECT().CreateModerationAction(gomock.Any()).Return(nil)
+	r, err = s.UnassignReport(adminID, "r1")
+	assert.NoError(t, err)
+	assert.Empty(t, r.AssigneeID)
+}
+
+func Test_moderationService_ResolveReport(t *testing.T) {
+	s, m := newTestService(t)
+
+	adminID := "admin"
+	open := func(targetType string, targetID string) *domain.Report {
+		return &domain.Report{ID: "r1", Status: domain.ReportStatusOpen, TargetType: targetType, TargetID: targetID, TargetUserID: "2", Reason: domain.ReportReasonSpam}
+	}
+
+	// Unknown action
+	m.reportRepo.EXPECT().GetReportByID(gomock.Eq("r1")).Return(open(domain.ReportTargetUser, "2"), nil)
+	r, err := s.ResolveReport(adminID, "r1", &domain.ResolveReportDTO{Action: "ban"})
+	assert.ErrorIs(t, err, domain.ErrInvalidModerationAction)
+	assert.Nil(t, r)
+
+	// Dismiss
+	m.reportRepo.EXPECT().GetReportByID(gomock.Eq("r1")).Return(open(domain.ReportTargetUser, "2"), nil)
+	m.reportRepo.EXPECT().UpdateReport(gomock.Any()).Return(nil)
+	m.actionRepo.EXPECT().CreateModerationAction(gomock.Any()).Return(nil)
+	r, err = s.ResolveReport(adminID, "r1", &domain.ResolveReportDTO{Action: domain.ModerationActionDismiss})
+	assert.NoError(t, err)
+	assert.Equal(t, domain.ReportStatusResolved, r.Status)
+	assert.Equal(t, domain.ModerationActionDismiss, r.Resolution)
+	assert.Equal(t, adminID, r.ResolvedBy)
+	assert.NotNil(t, r.ResolvedAt)
+
+	// Warn notifies the reported user
+	m.reportRepo.EXPECT().GetReportByID(gomock.Eq("r1")).Return(open(domain.ReportTargetMeetup, "m1"), nil)
+	m.notifications.EXPECT().NotifyLater(gomock.Eq([]string{"2"}), gomock.Eq(domain.NotificationTypeModerationWarning), gomock.Eq(&domain.ModerationWarning{ReportID: "r1", TargetType: domain.ReportTargetMeetup, TargetID: "m1", Reason: domain.ReportReasonSpam, Note: "last chance"})).Return(nil)
+	m.reportRepo.EXPECT().UpdateReport(gomock.Any()).Return(nil)
+	m.actionRepo.EXPECT().CreateModerationAction(gomock.Any()).Return(nil)
+	_, err = s.ResolveReport(adminID, "r1", &domain.ResolveReportDTO{Action: domain.ModerationActionWarn, Note: "last chance"})
+	assert.NoError(t, err)
+
+	// Remove a meetup chat message
+	m.reportRepo.EXPECT().GetReportByID(gomock.Eq("r1")).Return(open(domain.ReportTargetMessage, "msg1"), nil)
+	m.messageRepo.EXPECT().GetMessageByID(gomock.Eq("msg1")).Return(&domain.Message{ID: "msg1", MeetupID: "m1", AuthorID: "2", Content: "buy now"}, nil)
+	m.messageRepo.EXPECT().UpdateMessage(gomock.Any()).DoAndReturn(func(msg *domain.Message) error {
+		assert.True(t, msg.Deleted)
+		assert.Empty(t, msg.Content)
+		return nil
+	})
+	m.hub.EXPECT().Publish(gomock.Eq(domain.MeetupChatTopic("m1")), gomock.Any())
+	m.reportRepo.EXPECT().UpdateReport(gomock.Any()).Return(nil)
+	m.actionRepo.EXPECT().CreateModerationAction(gomock.Any()).Return(nil)
+	_, err = s.ResolveReport(adminID, "r1", &domain.ResolveReportDTO{Action: domain.ModerationActionRemoveContent})
+	assert.NoError(t, err)
+
+	// Remove a meetup through the meetup service, which tells its participants
+	m.reportRepo.EXPECT().GetReportByID(gomock.Eq("r1")).Return(open(domain.ReportTargetMeetup, "m1"), nil)
+	m.meetups.EXPECT().RemoveMeetup(gomock.Eq("m1")).Return(nil)
+	m.reportRepo.EXPECT().UpdateReport(gomock.Any()).Return(nil)
+	m.actionRepo.EXPECT().CreateModerationAction(gomock.Any()).Return(nil)
+	_, err = s.ResolveReport(adminID, "r1", &domain.ResolveReportDTO{Action: domain.ModerationActionRemoveContent})
+	assert.NoError(t, err)
+
+	// Meetup already deleted by its owner
+	m.reportRepo.EXPECT().GetReportByID(gomock.Eq("r1")).Return(open(domain.ReportTargetMeetup, "m1"), nil)
+	m.meetups.EXPECT().RemoveMeetup(gomock.Eq("m1")).Return(fiber.ErrNotFound)
+	m.reportRepo.EXPECT().UpdateReport(gomock.Any()).Return(nil)
+	m.actionRepo.EXPECT().CreateModerationAction(gomock.Any()).Return(nil)
+	_, err = s.ResolveReport(adminID, "r1", &domain.ResolveReportDTO{Action: domain.ModerationActionRemoveContent})
+	assert.NoError(t, err)
+
+	// Remove the bio and avatar of a user profile
+	m.reportRepo.EXPECT().GetReportByID(gomock.Eq("r1")).Return(open(domain.ReportTargetUser, "2"), nil)
+	m.userRepo.EXPECT().GetUserByID(gomock.Eq("2")).Return(&domain.User{ID: "2", Bio: "buy now", ProfilePicture: "https://files.upmeet.app/avatars/2/a1/1024.jpg", AvatarID: "a1"}, nil)
+	m.userRepo.EXPECT().UpdateUser(gomock.Any()).DoAndReturn(func(u *domain.User) error {
+		assert.Empty(t, u.Bio)
+		assert.Empty(t, u.ProfilePicture)
+		assert.Empty(t, u.AvatarID)
+		return nil
+	})
+	m.avatars.EXPECT().DeleteAvatarFiles(gomock.Eq("2"), gomock.Eq("a1")).Return(nil)
+	m.reportRepo.EXPECT().UpdateReport(gomock.Any()).Return(nil)
+	m.actionRepo.EXPECT().CreateModerationAction(gomock.Any()).Return(nil)
+	_, err = s.ResolveReport(adminID, "r1", &domain.ResolveReportDTO{Action: domain.ModerationActionRemoveContent})
+	assert.NoError(t, err)
+
+	// Suspension too long
+	m.reportRepo.EXPECT().GetReportByID(gomock.Eq("r1")).Return(open(domain.ReportTargetUser, "2"), nil)
+	m.userRepo.EXPECT().GetUserByID(gomock.Eq("2")).Return(&domain.User{ID: "2"}, nil)
+	r, err = s.ResolveReport(adminID, "r1", &domain.ResolveReportDTO{Action: domain.ModerationActionSuspendUser, SuspensionDays: domain.SuspensionMaxDays + 1})
+	assert.ErrorIs(t, err, domain.ErrInvalidSuspensionDuration)
+	assert.Nil(t, r)
+
+	// Suspend for the default duration with the report reason
+	m.reportRepo.EXPECT().GetReportByID(gomock.Eq("r1")).Return(open(domain.ReportTargetUser, "2"), nil)
+	m.userRepo.EXPECT().GetUserByID(gomock.Eq("2")).Return(&domain.User{ID: "2", Status: domain.UserStatusActive}, nil)
+	m.userRepo.EXPECT().UpdateUserStatus(gomock.Any()).DoAndReturn(func(u *domain.User) error {
+		assert.Equal(t, domain.UserStatusSuspended, u.Status)
+		assert.Equal(t, domain.ReportReasonSpam, u.StatusReason)
+		if assert.NotNil(t, u.SuspendedUntil) {
+			assert.WithinDuration(t, time.Now().AddDate(0, 0, domain.SuspensionDefaultDays), *u.SuspendedUntil, time.Minute)
+		}
+		return nil
+	})
+	m.reportRepo.EXPECT().UpdateReport(gomock.Any()).Return(nil)
+	m.actionRepo.EXPECT().CreateModerationAction(gomock.Any()).DoAndReturn(func(a *domain.ModerationAction) error {
+		assert.Equal(t, domain.ModerationActionSuspendUser, a.Action)
+		return nil
+	})
+	_, err = s.ResolveReport(adminID, "r1", &domain.ResolveReportDTO{Action: domain.ModerationActionSuspendUser})
+	assert.NoError(t, err)
+}
+
+func Test_moderationService_UpdateUserStatus(t *testing.T) {
+	s, m := newTestService(t)
+
+	adminID := "admin"
+
+	// Unknown status
+	u, err := s.UpdateUserStatus(adminID, "2", &domain.UpdateUserStatusDTO{Status: "deleted"})
+	assert.ErrorIs(t, err, domain.ErrInvalidUserStatus)
+	assert.Nil(t, u)
+
+	// Ban without reason
+	m.userRepo.EXPECT().GetUserByID(gomock.Eq("2")).Return(&domain.User{ID: "2"}, nil)
+	u, err = s.UpdateUserStatus(adminID, "2", &domain.UpdateUserStatusDTO{Status: domain.UserStatusBanned})
+	assert.ErrorIs(t, err, domain.ErrInvalidStatusReason)
+	assert.Nil(t, u)
+
+	// Ban
+	m.userRepo.EXPECT().GetUserByID(gomock.Eq("2")).Return(&domain.User{ID: "2"}, nil)
+	m.userRepo.EXPECT().UpdateUserStatus(gomock.Any()).Return(nil)
+	m.actionRepo.EXPECT().CreateModerationAction(gomock.Any()).DoAndReturn(func(a *domain.ModerationAction) error {
+		assert.Equal(t, domain.ModerationActionBanUser, a.Action)
+		assert.Equal(t, "2", a.TargetUserID)
+		assert.Empty(t, a.ReportID)
+		assert.Equal(t, "scam", a.Note)
+		return nil
+	})
+	u, err = s.UpdateUserStatus(adminID, "2", &domain.UpdateUserStatusDTO{Status: domain.UserStatusBanned, Reason: "scam"})
+	assert.NoError(t, err)
+	assert.Equal(t, domain.UserStatusBanned, u.Status)
+	assert.Equal(t, "scam", u.StatusReason)
+	assert.Nil(t, u.SuspendedUntil)
+
+	// Reactivate clears the reason and end of the suspension
+	until := time.Now().Add(time.Hour)
+	m.userRepo.EXPECT().GetUserByID(gomock.Eq("2")).Return(&domain.User{ID: "2", Status: domain.UserStatusSuspended, StatusReason: "spam", SuspendedUntil: &until}, nil)
+	m.userRepo.EXPECT().UpdateUserStatus(gomock.Any()).Return(nil)
+	m.actionRepo.EXPECT().CreateModerationAction(gomock.Any()).Return(nil)
+	u, err = s.UpdateUserStatus(adminID, "2", &domain.UpdateUserStatusDTO{Status: domain.UserStatusActive})
+	assert.NoError(t, err)
+	assert.Equal(t, domain.UserStatusActive, u.Status)
+	assert.Empty(t, u.StatusReason)
+	assert.Nil(t, u.SuspendedUntil)
+}
+
+func Test_moderationService_CreateAppeal(t *testing.T) {
+	s, m := newTestService(t)
+
+	uid := "2"
+	dto := &domain.CreateAppealDTO{ActionID: "a1", Text: "I didn't do it"}
+
+	// Missing text
+	a, err := s.CreateAppeal(uid, &domain.CreateAppealDTO{ActionID: "a1"})
+	assert.ErrorIs(t, err, domain.ErrInvalidAppealText)
+	assert.Nil(t, a)
+
+	// Action against another user
+	m.actionRepo.EXPECT().GetModerationActionByID(gomock.Eq("a1")).Return(&domain.ModerationAction{ID: "a1", Action: domain.ModerationActionSuspendUser, TargetUserID: "3", CreatedAt: time.Now()}, nil)
+	a, err = s.CreateAppeal(uid, dto)
+	assert.ErrorIs(t, err, fiber.ErrNotFound)
+	assert.Nil(t, a)
+
+	// Warnings can't be appealed
+	m.actionRepo.EXPECT().GetModerationActionByID(gomock.Eq("a1")).Return(&domain.ModerationAction{ID: "a1", Action: domain.ModerationActionWarn, TargetUserID: uid, CreatedAt: time.Now()}, nil)
+	a, err = s.CreateAppeal(uid, dto)
+	assert.ErrorIs(t, err, domain.ErrNotAppealable)
+	assert.Nil(t, a)
+
+	// Appeal window closed
+	m.actionRepo.EXPECT().GetModerationActionByID(gomock.Eq("a1")).Return(&domain.ModerationAction{ID: "a1", Action: domain.ModerationActionSuspendUser, TargetUserID: uid, CreatedAt: time.Now().Add(-domain.AppealWindow - time.Hour)}, nil)
+	a, err = s.CreateAppeal(uid, dto)
+	assert.ErrorIs(t, err, domain.ErrAppealWindowClosed)
+	assert.Nil(t, a)
+
+	// Already appealed
+	m.actionRepo.EXPECT().GetModerationActionByID(gomock.Eq("a1")).Return(&domain.ModerationAction{ID: "a1", Action: domain.ModerationActionSuspendUser, TargetUserID: uid, CreatedAt: time.Now()}, nil)
+	m.appealRepo.EXPECT().GetAppealByActionID(gomock.Eq("a1")).Return(&domain.Appeal{ID: "ap1"}, nil)
+	a, err = s.CreateAppeal(uid, dto)
+	assert.ErrorIs(t, err, domain.ErrAlreadyAppealed)
+	assert.Nil(t, a)
+
+	// CreateAppeal successful
+	m.actionRepo.EXPECT().GetModerationActionByID(gomock.Eq("a1")).Return(&domain.ModerationAction{ID: "a1", Action: domain.ModerationActionSuspendUser, TargetUserID: uid, CreatedAt: time.Now()}, nil)
+	m.appealRepo.EXPECT().GetAppealByActionID(gomock.Eq("a1")).Return(nil, fiber.ErrNotFound)
+	m.appealRepo.EXPECT().CreateAppeal(gomock.Any()).Return(nil)
+	a, err = s.CreateAppeal(uid, dto)
+	assert.NoError(t, err)
+	assert.Equal(t, uid, a.UserID)
+	assert.Equal(t, "a1", a.ActionID)
+	assert.Equal(t, domain.ModerationActionSuspendUser, a.Action)
+	assert.Equal(t, domain.AppealStatusPending, a.Status)
+}
+
+func Test_moderationService_ResolveAppeal(t *testing.T) {
+	s, m := newTestService(t)
+
+	adminID := "admin"
+	suspension := &domain.ModerationAction{ID: "a1", ReportID: "r1", Action: domain.ModerationActionSuspendUser, TargetUserID: "2"}
+
+	// Unknown status
+	a, err := s.ResolveAppeal(adminID, "ap1", &domain.ResolveAppealDTO{Status: domain.AppealStatusPending})
+	assert.ErrorIs(t, err, domain.ErrInvalidAppealStatus)
+	assert.Nil(t, a)
+
+	// Already resolved
+	m.appealRepo.EXPECT().GetAppealByID(gomock.Eq("ap1")).Return(&domain.Appeal{ID: "ap1", Status: domain.AppealStatusUpheld}, nil)
+	a, err = s.ResolveAppeal(adminID, "ap1", &domain.ResolveAppealDTO{Status: domain.AppealStatusOverturned})
+	assert.ErrorIs(t, err, domain.ErrAppealResolved)
+	assert.Nil(t, a)
+
+	// Upheld appeals leave the user suspended
+	m.appealRepo.EXPECT().GetAppealByID(gomock.Eq("ap1")).Return(&domain.Appeal{ID: "ap1", UserID: "2", ActionID: "a1", Status: domain.AppealStatusPending}, nil)
+	m.actionRepo.EXPECT().GetModerationActionByID(gomock.Eq("a1")).Return(suspension, nil)
+	m.appealRepo.EXPECT().UpdateAppeal(gomock.Any()).Return(nil)
+	m.actionRepo.EXPECT().CreateModerationAction(gomock.Any()).DoAndReturn(func(a *domain.ModerationAction) error {
+		assert.Equal(t, domain.ModerationActionUpholdAppeal, a.Action)
+		assert.Equal(t, "ap1", a.AppealID)
+		assert.Equal(t, "r1", a.ReportID)
+		return nil
+	})
+	m.notifications.EXPECT().NotifyLater(gomock.Eq([]string{"2"}), gomock.Eq(domain.NotificationTypeAppealResolved), gomock.Any()).Return(nil)
+	a, err = s.ResolveAppeal(adminID, "ap1", &domain.ResolveAppealDTO{Status: domain.AppealStatusUpheld, Note: "confirmed"})
+	assert.NoError(t, err)
+	assert.Equal(t, domain.AppealStatusUpheld, a.Status)
+	assert.Equal(t, adminID, a.ResolvedBy)
+	assert.NotNil(t, a.ResolvedAt)
+
+	// Overturned appeals lift the suspension
+	until := time.Now().Add(time.Hour)
+	m.appealRepo.EXPECT().GetAppealByID(gomock.Eq("ap2")).Return(&domain.Appeal{ID: "ap2", UserID: "2", ActionID: "a1", Status: domain.AppealStatusPending}, nil)
+	m.actionRepo.EXPECT().GetModerationActionByID(gomock.Eq("a1")).Return(suspension, nil)
+	m.userRepo.EXPECT().GetUserByID(gomock.Eq("2")).Return(&domain.User{ID: "2", Status: domain.UserStatusSuspended, StatusReason: "spam", SuspendedUntil: &until}, nil)
+	m.userRepo.EXPECT().UpdateUserStatus(gomock.Any()).DoAndReturn(func(u *domain.User) error {
+		assert.Equal(t, domain.UserStatusActive, u.Status)
+		assert.Nil(t, u.SuspendedUntil)
+		return nil
+	})
+	m.appealRepo.EXPECT().UpdateAppeal(gomock.Any()).Return(nil)
+	m.actionRepo.EXPECT().CreateModerationAction(gomock.Any()).DoAndReturn(func(a *domain.ModerationAction) error {
+		assert.Equal(t, domain.ModerationActionOverturnAppeal, a.Action)
+		return nil
+	})
+	m.notifications.EXPECT().NotifyLater(gomock.Eq([]string{"2"}), gomock.Eq(domain.NotificationTypeAppealResolved), gomock.Any()).DoAndReturn(func(userIDs []string, notificationType string, data interface{}) error {
+		assert.Equal(t, domain.AppealStatusOverturned, data.(*domain.AppealResolution).Status)
+		return nil
+	})
+	a, err = s.ResolveAppeal(adminID, "ap2", &domain.ResolveAppealDTO{Status: domain.AppealStatusOverturned})
+	assert.NoError(t, err)
+	assert.Equal(t, domain.AppealStatusOverturned, a.Status)
+
+	// Overturned appeals don't lift a status set since, e.g. a later ban
+	m.appealRepo.EXPECT().GetAppealByID(gomock.Eq("ap3")).Return(&domain.Appeal{ID: "ap3", UserID: "2", ActionID: "a1", Status: domain.AppealStatusPending}, nil)
+	m.actionRepo.EXPECT().GetModerationActionByID(gomock.Eq("a1")).Return(suspension, nil)
+	m.userRepo.EXPECT().GetUserByID(gomock.Eq("2")).Return(&domain.User{ID: "2", Status: domain.UserStatusBanned}, nil)
+	m.appealRepo.EXPECT().UpdateAppeal(gomock.Any()).Return(nil)
+	m.actionRepo.EXPECT().CreateModerationAction(gomock.Any()).Return(nil)
+	m.notifications.EXPECT().NotifyLater(gomock.Eq([]string{"2"}), gomock.Eq(domain.NotificationTypeAppealResolved), gomock.Any()).Return(nil)
+	a, err = s.ResolveAppeal(adminID, "ap3", &domain.ResolveAppealDTO{Status: domain.AppealStatusOverturned})
+	assert.NoError(t, err)
+	assert.Equal(t, domain.AppealStatusOverturned, a.Status)
+}
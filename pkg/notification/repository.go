@@ -0,0 +1,129 @@
+package notification
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+// NewNotificationRepository creates a new notification repository instance.
+func NewNotificationRepository(db *gorm.DB) domain.NotificationRepository {
+	return &notificationRepository{
+		db: db,
+	}
+}
+
+func (r *notificationRepository) CreateNotification(n *domain.Notification) error {
+	err := r.db.Create(n).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to create notification", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *notificationRepository) GetNotificationByID(id string) (*domain.Notification, error) {
+	n := &domain.Notification{}
+	err := r.db.Where("id = ?", id).First(n).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fiber.ErrNotFound
+		}
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get notification by id", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return n, nil
+}
+
+func (r *notificationRepository) GetNotificationsByUserID(userID string, before string, limit int) ([]*domain.Notification, error) {
+	var notifications []*domain.Notification
+	q := r.db.Where("user_id = ?", userID)
+	if len(before) > 0 {
+		// The cursor has to be a notification of the same user, notifications created at the same time are ordered by their id.
+		q = q.Where("(created_at, id) < (SELECT created_at, id FROM notifications WHERE id = ? AND user_id = ?)", before, userID)
+	}
+	err := q.Order("created_at DESC, id DESC").Limit(limit).Find(&notifications).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get notifications by user id", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return notifications, nil
+}
+
+func (r *notificationRepository) UpdateNotification(n *domain.Notification) error {
+	err := r.db.Save(n).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to update notification", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *notificationRepository) MarkAllNotificationsRead(userID string, readAt time.Time) error {
+	err := r.db.Model(&domain.Notification{}).
+		Where("user_id = ? AND read = ?", userID, false).
+		Updates(map[string]interface{}{"read": true, "read_at": readAt}).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to mark all notifications read", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *notificationRepository) DeleteNotification(id string) error {
+	err := r.db.Delete(&domain.Notification{ID: id}).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to delete notification", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *notificationRepository) CreateDeferredPush(p *domain.DeferredPush) error {
+	err := r.db.Create(p).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to create deferred push", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *notificationRepository) ClaimDueDeferredPushes(now time.Time, limit int) ([]*domain.DeferredPush, error) {
+	var pushes []*domain.DeferredPush
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// SKIP LOCKED lets every server claim different pushes, the claimed ones are removed so nobody else sends them again.
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("send_at <= ?", now).
+			Order("send_at").Limit(limit).Find(&pushes).Error
+		if err != nil || len(pushes) == 0 {
+			return err
+		}
+
+		ids := make([]string, len(pushes))
+		for i, p := range pushes {
+			ids[i] = p.NotificationID
+		}
+		return tx.Where("notification_id IN ?", ids).Delete(&domain.DeferredPush{}).Error
+	})
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to claim due deferred pushes", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return pushes, nil
+}
@@ -0,0 +1,536 @@
+package notification
+
+import (
+	"encoding/json"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/UpMeetApp/server/pkg/email"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"strings"
+	"time"
+)
+
+// pushTexts holds the push notification title and body of each notification type.
+var pushTexts = map[string][2]string{
+	domain.NotificationTypeMeetupUpdated:           {"Meetup updated", "A meetup you joined has changed."},
+	domain.NotificationTypeMeetupCancelled:         {"Meetup cancelled", "A meetup you joined was cancelled."},
+	domain.NotificationTypeParticipantJoined:       {"New participant", "Someone joined your meetup."},
+	domain.NotificationTypeInvitationReceived:      {"New invitation", "You were invited to a meetup."},
+	domain.NotificationTypeMeetupReminder:          {"Meetup starting soon", "A meetup you joined starts soon."},
+	domain.NotificationTypeModerationWarning:       {"Community guidelines", "A moderator reviewed a report about your content."},
+	domain.NotificationTypeAppealResolved:          {"Appeal reviewed", "A moderator reviewed your appeal."},
+	domain.NotificationTypeAgeVerificationReviewed: {"Age verification", "Your age verification was reviewed."},
+}
+
+// emailTemplates holds the email template of each notification type that is also sent by email.
+var emailTemplates = map[string]string{
+	domain.NotificationTypeMeetupCancelled:    domain.EmailTemplateMeetupCancelled,
+	domain.NotificationTypeInvitationReceived: domain.EmailTemplateInvitation,
+	domain.NotificationTypeMeetupReminder:     domain.EmailTemplateMeetupReminder,
+}
+
+type notificationService struct {
+	notificationRepository         domain.NotificationRepository
+	notificationSettingsRepository domain.NotificationSettingsRepository
+	deviceRepository               domain.DeviceRepository
+	userRepository                 domain.UserRepository
+	meetupRepository               domain.MeetupRepository
+	pushSender                     domain.PushSender
+	emailSender                    domain.EmailSender
+	jobScheduler                   domain.JobScheduler
+	hub                            domain.Hub
+}
+
+// NewNotificationService creates a new notification service instance.
+func NewNotificationService(notificationRepository domain.NotificationRepository, notificationSettingsRepository domain.NotificationSettingsRepository, deviceRepository domain.DeviceRepository, userRepository domain.UserRepository, meetupRepository domain.MeetupRepository, pushSender domain.PushSender, emailSender domain.EmailSender, jobScheduler domain.JobScheduler, hub domain.Hub) domain.NotificationService {
+	return &notificationService{
+		notificationRepository:         notificationRepository,
+		notificationSettingsRepository: notificationSettingsRepository,
+		deviceRepository:               deviceRepository,
+		userRepository:                 userRepository,
+		meetupRepository:               meetupRepository,
+		pushSender:                     pushSender,
+		emailSender:                    emailSender,
+		jobScheduler:                   jobScheduler,
+		hub:                            hub,
+	}
+}
+
+func (s *notificationService) Notify(userID string, notificationType string, payload interface{}) error {
+	settings, err := s.GetNotificationSettings(userID)
+	if err != nil {
+		return err
+	}
+	pref := settings.Preference(notificationType)
+	_, hasEmail := emailTemplates[notificationType]
+	sendEmail := pref.Email && hasEmail
+	if !pref.InApp && !pref.Push && !sendEmail {
+		return nil
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to marshal notification payload", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+
+	n := &domain.Notification{
+		ID:        uuid.NewString(),
+		UserID:    userID,
+		Type:      notificationType,
+		Payload:   data,
+		CreatedAt: time.Now(),
+	}
+	if pref.InApp {
+		err = s.notificationRepository.CreateNotification(n)
+		if err != nil {
+			return err
+		}
+		s.hub.Publish(domain.UserTopic(userID), &domain.RealtimeEvent{
+			Type: domain.RealtimeEventNotificationCreated,
+			Data: n,
+		})
+	}
+
+	if pref.Push {
+		sendAt := quietHoursEnd(settings, n.CreatedAt)
+		if sendAt.IsZero() {
+			s.push(n)
+		} else {
+			err = s.notificationRepository.CreateDeferredPush(&domain.DeferredPush{
+				NotificationID: n.ID,
+				UserID:         n.UserID,
+				Type:           n.Type,
+				Payload:        n.Payload,
+				SendAt:         sendAt,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if sendEmail {
+		s.email(n)
+	}
+	return nil
+}
+
+func (s *notificationService) NotifyLater(userIDs []string, notificationType string, payload interface{}) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to marshal notification payload", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	for start := 0; start < len(userIDs); start += domain.NotificationJobBatchSize {
+		end := start + domain.NotificationJobBatchSize
+		if end > len(userIDs) {
+			end = len(userIDs)
+		}
+		err = s.jobScheduler.Schedule(domain.JobTypeNotification, "", time.Now(), &domain.NotificationJob{UserIDs: userIDs[start:end], Type: notificationType, Payload: data})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *notificationService) SendNotifications(j *domain.Job) error {
+	p := &domain.NotificationJob{}
+	err := json.Unmarshal(j.Payload, p)
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to unmarshal notification job payload", zap.Error(err))
+		return err
+	}
+
+	var failed []string
+	for _, userID := range p.UserIDs {
+		err = s.Notify(userID, p.Type, p.Payload)
+		if err != nil {
+			failed = append(failed, userID)
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	// Nobody was notified yet, so the job can simply be retried.
+	if len(failed) == len(p.UserIDs) {
+		return err
+	}
+	// Retrying would notify some users twice, the failed ones are notified by a new job instead.
+	return s.jobScheduler.Schedule(domain.JobTypeNotification, "", time.Now(), &domain.NotificationJob{UserIDs: failed, Type: p.Type, Payload: p.Payload})
+}
+
+func (s *notificationService) GetNotifications(uid string, before string, limit int) ([]*domain.Notification, error) {
+	if limit <= 0 || limit > domain.NotificationsMaxLimit {
+		limit = domain.NotificationsDefaultLimit
+	}
+	return s.notificationRepository.GetNotificationsByUserID(uid, before, limit)
+}
+
+func (s *notificationService) MarkNotificationRead(uid string, id string) (*domain.Notification, error) {
+	n, err := s.getOwnNotification(uid, id)
+	if err != nil {
+		return nil, err
+	}
+	if n.Read {
+		return n, nil
+	}
+
+	now := time.Now()
+	n.Read = true
+	n.ReadAt = &now
+	err = s.notificationRepository.UpdateNotification(n)
+	if err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func (s *notificationService) MarkAllNotificationsRead(uid string) error {
+	return s.notificationRepository.MarkAllNotificationsRead(uid, time.Now())
+}
+
+func (s *notificationService) DeleteNotification(uid string, id string) error {
+	_, err := s.getOwnNotification(uid, id)
+	if err != nil {
+		return err
+	}
+	return s.notificationRepository.DeleteNotification(id)
+}
+
+func (s *notificationService) GetNotificationSettings(uid string) (*domain.NotificationSettings, error) {
+	settings, err := s.notificationSettingsRepository.GetNotificationSettingsByUserID(uid)
+	if err == fiber.ErrNotFound {
+		return &domain.NotificationSettings{
+			UserID:   uid,
+			Timezone: domain.NotificationDefaultTimezone,
+		}, nil
+	}
+	return settings, err
+}
+
+func (s *notificationService) UpdateNotificationSettings(uid string, dto *domain.UpdateNotificationSettingsDTO) (*domain.NotificationSettings, error) {
+	settings := &domain.NotificationSettings{
+		UserID:            uid,
+		Timezone:          domain.NotificationDefaultTimezone,
+		QuietHoursEnabled: dto.QuietHoursEnabled,
+		UpdatedAt:         time.Now(),
+	}
+
+	// Update Timezone
+	if len(dto.Timezone) > 0 {
+		_, err := time.LoadLocation(dto.Timezone)
+		if err != nil {
+			return nil, domain.ErrInvalidTimezone
+		}
+		settings.Timezone = dto.Timezone
+	}
+
+	// Update Quiet hours
+	if dto.QuietHoursEnabled || len(dto.QuietHoursStart) > 0 || len(dto.QuietHoursEnd) > 0 {
+		_, err := time.Parse(domain.QuietHoursLayout, dto.QuietHoursStart)
+		if err != nil {
+			return nil, domain.ErrInvalidQuietHours
+		}
+		_, err = time.Parse(domain.QuietHoursLayout, dto.QuietHoursEnd)
+		if err != nil {
+			return nil, domain.ErrInvalidQuietHours
+		}
+		settings.QuietHoursStart = dto.QuietHoursStart
+		settings.QuietHoursEnd = dto.QuietHoursEnd
+	}
+
+	// Update Preferences
+	seen := make(map[string]bool)
+	for _, p := range dto.Preferences {
+		if !isNotificationType(p.Type) || seen[p.Type] {
+			return nil, domain.ErrInvalidNotificationType
+		}
+		seen[p.Type] = true
+		settings.Preferences = append(settings.Preferences, &domain.NotificationPreference{
+			UserID: uid,
+			Type:   p.Type,
+			InApp:  p.InApp,
+			Push:   p.Push,
+			Email:  p.Email,
+		})
+	}
+
+	err := s.notificationSettingsRepository.SaveNotificationSettings(settings)
+	if err != nil {
+		return nil, err
+	}
+	return settings, nil
+}
+
+func (s *notificationService) SendDeferredPushes() error {
+	for {
+		// Claimed pushes are removed right away, so a push is never sent twice, not even by different servers.
+		pushes, err := s.notificationRepository.ClaimDueDeferredPushes(time.Now(), domain.DeferredPushesBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, p := range pushes {
+			s.push(&domain.Notification{
+				ID:      p.NotificationID,
+				UserID:  p.UserID,
+				Type:    p.Type,
+				Payload: p.Payload,
+			})
+		}
+		if len(pushes) < domain.DeferredPushesBatchSize {
+			return nil
+		}
+	}
+}
+
+func (s *notificationService) ScheduleWeeklyDigest() error {
+	dueAt := nextWeeklyDigest(time.Now())
+	return s.jobScheduler.Schedule(domain.JobTypeWeeklyDigest, domain.WeeklyDigestJobKey(dueAt), dueAt, &domain.WeeklyDigestJob{DueAt: dueAt})
+}
+
+func (s *notificationService) SendWeeklyDigests(j *domain.Job) error {
+	p := &domain.WeeklyDigestJob{}
+	err := json.Unmarshal(j.Payload, p)
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to unmarshal weekly digest job payload", zap.Error(err))
+		return err
+	}
+	// The following digest is scheduled before sending this one, so a failing run can't end the weekly schedule.
+	if len(p.AfterID) == 0 {
+		nextDueAt := nextWeeklyDigest(p.DueAt)
+		err = s.jobScheduler.Schedule(domain.JobTypeWeeklyDigest, domain.WeeklyDigestJobKey(nextDueAt), nextDueAt, &domain.WeeklyDigestJob{DueAt: nextDueAt})
+		if err != nil {
+			return err
+		}
+	}
+
+	now := time.Now()
+	afterID := p.AfterID
+	failed := 0
+	outOfTime := false
+	for {
+		users, err := s.userRepository.GetUsersWithEmail(afterID, domain.DigestUsersBatchSize)
+		if err != nil {
+			// Nothing was sent yet, so the job can simply be retried.
+			if afterID == p.AfterID {
+				return err
+			}
+			// Retrying would send the digest to some users twice, the remaining users are handled by a new job instead.
+			return s.jobScheduler.Schedule(domain.JobTypeWeeklyDigest, domain.WeeklyDigestJobKey(p.DueAt)+":"+afterID, now, &domain.WeeklyDigestJob{DueAt: p.DueAt, AfterID: afterID})
+		}
+		for _, u := range users {
+			// A single user failing is only logged, so the remaining users still receive their digest.
+			if s.sendWeeklyDigest(u, now) != nil {
+				failed++
+			}
+		}
+		if len(users) < domain.DigestUsersBatchSize {
+			break
+		}
+		afterID = users[len(users)-1].ID
+		if time.Since(now) >= domain.DigestRunDuration {
+			outOfTime = true
+			break
+		}
+	}
+	if failed > 0 {
+		zap.L().Warn("failed to send some weekly digests", zap.Int("failed", failed))
+	}
+	if outOfTime {
+		// The job ran out of time, so the remaining users are handled by a new job before its lease expires.
+		return s.jobScheduler.Schedule(domain.JobTypeWeeklyDigest, domain.WeeklyDigestJobKey(p.DueAt)+":"+afterID, time.Now(), &domain.WeeklyDigestJob{DueAt: p.DueAt, AfterID: afterID})
+	}
+	return nil
+}
+
+// sendWeeklyDigest emails the user their meetups of the coming week, unless they have none or disabled the digest.
+// Failing to deliver the email itself is only logged like other notification emails.
+func (s *notificationService) sendWeeklyDigest(u *domain.User, now time.Time) error {
+	settings, err := s.GetNotificationSettings(u.ID)
+	if err != nil {
+		return err
+	}
+	if !settings.Preference(domain.NotificationTypeWeeklyDigest).Email {
+		return nil
+	}
+	meetups, err := s.meetupRepository.GetUpcomingMeetupsByParticipant(u.ID, now, now.Add(domain.DigestPeriod))
+	if err != nil {
+		return err
+	}
+	if len(meetups) == 0 {
+		return nil
+	}
+
+	e, err := email.Render(domain.EmailTemplateWeeklyDigest, &domain.EmailData{User: u, Meetups: meetups})
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to render weekly digest", zap.Error(err))
+		return nil
+	}
+	_ = s.emailSender.Send(e)
+	return nil
+}
+
+// getOwnNotification returns the notification if it was addressed to the user.
+func (s *notificationService) getOwnNotification(uid string, id string) (*domain.Notification, error) {
+	n, err := s.notificationRepository.GetNotificationByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if n.UserID != uid {
+		return nil, domain.ErrNotNotificationRecipient
+	}
+	return n, nil
+}
+
+// push delivers the notification to all devices of its recipient and removes the devices with invalid tokens.
+// The notification is already in the inbox at this point, so delivery failures are only logged.
+func (s *notificationService) push(n *domain.Notification) {
+	devices, err := s.deviceRepository.GetDevicesByUserID(n.UserID)
+	if err != nil || len(devices) == 0 {
+		return
+	}
+	tokens := make([]string, len(devices))
+	for i, d := range devices {
+		tokens[i] = d.Token
+	}
+
+	texts := pushTexts[n.Type]
+	invalidTokens, _ := s.pushSender.Send(tokens, &domain.PushMessage{
+		Title: texts[0],
+		Body:  texts[1],
+		Data:  pushData(n),
+	})
+	if len(invalidTokens) > 0 {
+		_ = s.deviceRepository.DeleteDevices(invalidTokens...)
+	}
+}
+
+// email sends the notification by email if its recipient has a verified email address.
+// Like push, the notification was already handled at this point, so failures are only logged.
+func (s *notificationService) email(n *domain.Notification) {
+	u, err := s.userRepository.GetUserByID(n.UserID)
+	if err != nil || len(u.Email) == 0 || !u.EmailVerified {
+		return
+	}
+	m, err := s.notificationMeetup(n)
+	if err != nil {
+		return
+	}
+
+	e, err := email.Render(emailTemplates[n.Type], &domain.EmailData{User: u, Meetup: m})
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to render email", zap.String("type", n.Type), zap.Error(err))
+		return
+	}
+	_ = s.emailSender.Send(e)
+}
+
+// notificationMeetup returns the meetup the notification is about.
+// Meetup notifications carry the meetup itself, which matters for cancelled meetups that no longer exist.
+func (s *notificationService) notificationMeetup(n *domain.Notification) (*domain.Meetup, error) {
+	if n.Type == domain.NotificationTypeInvitationReceived {
+		i := &domain.Invitation{}
+		err := json.Unmarshal(n.Payload, i)
+		if err != nil {
+			sentry.CaptureException(err)
+			zap.L().Error("failed to unmarshal invitation notification payload", zap.Error(err))
+			return nil, fiber.ErrInternalServerError
+		}
+		return s.meetupRepository.GetMeetupByID(i.MeetupID)
+	}
+
+	m := &domain.Meetup{}
+	err := json.Unmarshal(n.Payload, m)
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to unmarshal meetup notification payload", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return m, nil
+}
+
+// pushData returns the data sent along with the push notification of n.
+// Push messages pass through third parties and are size limited, so only the ids of the payload are sent,
+// the client fetches the rest from the inbox.
+func pushData(n *domain.Notification) map[string]string {
+	data := map[string]string{
+		"notification_id": n.ID,
+		"type":            n.Type,
+	}
+	var payload map[string]interface{}
+	_ = json.Unmarshal(n.Payload, &payload)
+	for k, v := range payload {
+		id, ok := v.(string)
+		if ok && len(id) > 0 && (k == "id" || strings.HasSuffix(k, "_id")) {
+			data[k] = id
+		}
+	}
+	return data
+}
+
+// nextWeeklyDigest returns when the next weekly digest after now is due, which is every Monday at 08:00 UTC.
+func nextWeeklyDigest(now time.Time) time.Time {
+	now = now.UTC()
+	next := time.Date(now.Year(), now.Month(), now.Day(), 8, 0, 0, 0, time.UTC)
+	next = next.AddDate(0, 0, (int(time.Monday)-int(next.Weekday())+7)%7)
+	if !next.After(now) {
+		next = next.AddDate(0, 0, 7)
+	}
+	return next
+}
+
+// isNotificationType returns whether t is a known notification type.
+func isNotificationType(t string) bool {
+	for _, notificationType := range domain.NotificationTypes {
+		if notificationType == t {
+			return true
+		}
+	}
+	return false
+}
+
+// quietHoursEnd returns when the quiet hours of the user end if now is within them, otherwise it returns the zero time.
+// Quiet hours starting later in the day than they end last over midnight.
+func quietHoursEnd(settings *domain.NotificationSettings, now time.Time) time.Time {
+	if !settings.QuietHoursEnabled {
+		return time.Time{}
+	}
+	start, err := time.Parse(domain.QuietHoursLayout, settings.QuietHoursStart)
+	if err != nil {
+		return time.Time{}
+	}
+	end, err := time.Parse(domain.QuietHoursLayout, settings.QuietHoursEnd)
+	if err != nil {
+		return time.Time{}
+	}
+	loc, err := time.LoadLocation(settings.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+
+	local := now.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+	startMinute := start.Hour()*60 + start.Minute()
+	endMinute := end.Hour()*60 + end.Minute()
+	endsAt := time.Date(local.Year(), local.Month(), local.Day(), end.Hour(), end.Minute(), 0, 0, loc)
+
+	switch {
+	case startMinute < endMinute && minute >= startMinute && minute < endMinute:
+		return endsAt
+	case startMinute > endMinute && minute >= startMinute:
+		return endsAt.AddDate(0, 0, 1)
+	case startMinute > endMinute && minute < endMinute:
+		return endsAt
+	}
+	return time.Time{}
+}
@@ -0,0 +1,478 @@
+package notification
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/UpMeetApp/server/pkg/domain/mock"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func Test_notificationService_Notify(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockNotificationRepository(ctrl)
+	settingsRepo := mock.NewMockNotificationSettingsRepository(ctrl)
+	deviceRepo := mock.NewMockDeviceRepository(ctrl)
+	pushSender := mock.NewMockPushSender(ctrl)
+	hub := mock.NewMockHub(ctrl)
+	userRepo := mock.NewMockUserRepository(ctrl)
+	meetupRepo := mock.NewMockMeetupRepository(ctrl)
+	emailSender := mock.NewMockEmailSender(ctrl)
+	s := NewNotificationService(repo, settingsRepo, deviceRepo, userRepo, meetupRepo, pushSender, emailSender, mock.NewMockJobScheduler(ctrl), hub)
+
+	uid := "1"
+
+	// All channels disabled
+	settingsRepo.EXPECT().GetNotificationSettingsByUserID(gomock.Eq(uid)).Return(&domain.NotificationSettings{UserID: uid, Preferences: []*domain.NotificationPreference{
+		{UserID: uid, Type: domain.NotificationTypeMeetupUpdated},
+	}}, nil)
+	err := s.Notify(uid, domain.NotificationTypeMeetupUpdated, &domain.Meetup{})
+	assert.NoError(t, err)
+
+	// Push only
+	settingsRepo.EXPECT().GetNotificationSettingsByUserID(gomock.Eq(uid)).Return(&domain.NotificationSettings{UserID: uid, Preferences: []*domain.NotificationPreference{
+		{UserID: uid, Type: domain.NotificationTypeMeetupUpdated, Push: true},
+	}}, nil)
+	deviceRepo.EXPECT().GetDevicesByUserID(gomock.Eq(uid)).Return([]*domain.Device{{Token: "t1"}}, nil)
+	pushSender.EXPECT().Send(gomock.Eq([]string{"t1"}), gomock.Any()).Return(nil, nil)
+	err = s.Notify(uid, domain.NotificationTypeMeetupUpdated, &domain.Meetup{})
+	assert.NoError(t, err)
+
+	// Push deferred during quiet hours
+	now := time.Now().UTC()
+	settingsRepo.EXPECT().GetNotificationSettingsByUserID(gomock.Eq(uid)).Return(&domain.NotificationSettings{
+		UserID:            uid,
+		Timezone:          "UTC",
+		QuietHoursEnabled: true,
+		QuietHoursStart:   now.Add(-time.Hour).Format(domain.QuietHoursLayout),
+		QuietHoursEnd:     now.Add(time.Hour).Format(domain.QuietHoursLayout),
+	}, nil)
+	repo.EXPECT().CreateNotification(gomock.Any()).Return(nil)
+	hub.EXPECT().Publish(gomock.Eq(domain.UserTopic(uid)), gomock.Any())
+	repo.EXPECT().CreateDeferredPush(gomock.Any()).DoAndReturn(func(p *domain.DeferredPush) error {
+		assert.Equal(t, uid, p.UserID)
+		assert.True(t, p.SendAt.After(now))
+		return nil
+	})
+	err = s.Notify(uid, domain.NotificationTypeMeetupUpdated, &domain.Meetup{})
+	assert.NoError(t, err)
+
+	// Notify successful without devices
+	settingsRepo.EXPECT().GetNotificationSettingsByUserID(gomock.Eq(uid)).Return(nil, fiber.ErrNotFound)
+	repo.EXPECT().CreateNotification(gomock.Any()).Return(nil)
+	hub.EXPECT().Publish(gomock.Eq(domain.UserTopic(uid)), gomock.Any())
+	deviceRepo.EXPECT().GetDevicesByUserID(gomock.Eq(uid)).Return([]*domain.Device{}, nil)
+	err = s.Notify(uid, domain.NotificationTypeMeetupUpdated, &domain.Meetup{})
+	assert.NoError(t, err)
+
+	// Notify successful, pushed to the users' devices, invalid tokens pruned and sent by email
+	settingsRepo.EXPECT().GetNotificationSettingsByUserID(gomock.Eq(uid)).Return(nil, fiber.ErrNotFound)
+	repo.EXPECT().CreateNotification(gomock.Any()).DoAndReturn(func(n *domain.Notification) error {
+		assert.Equal(t, uid, n.UserID)
+		assert.Equal(t, domain.NotificationTypeInvitationReceived, n.Type)
+		assert.JSONEq(t, `{"meetup_id":"m1"}`, string(n.Payload))
+		assert.False(t, n.Read)
+		return nil
+	})
+	hub.EXPECT().Publish(gomock.Eq(domain.UserTopic(uid)), gomock.Any())
+	deviceRepo.EXPECT().GetDevicesByUserID(gomock.Eq(uid)).Return([]*domain.Device{{Token: "t1"}, {Token: "t2"}}, nil)
+	pushSender.EXPECT().Send(gomock.Eq([]string{"t1", "t2"}), gomock.Any()).DoAndReturn(func(tokens []string, msg *domain.PushMessage) ([]string, error) {
+		assert.Equal(t, domain.NotificationTypeInvitationReceived, msg.Data["type"])
+		assert.Equal(t, "m1", msg.Data["meetup_id"])
+		assert.NotContains(t, msg.Data, "payload")
+		assert.NotEmpty(t, msg.Title)
+		return []string{"t2"}, nil
+	})
+	deviceRepo.EXPECT().DeleteDevices(gomock.Eq("t2")).Return(nil)
+	userRepo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{ID: uid, Name: "Test", Email: "test@upmeet.app", EmailVerified: true}, nil)
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq("m1")).Return(&domain.Meetup{ID: "m1", Name: "Board games"}, nil)
+	emailSender.EXPECT().Send(gomock.Any()).DoAndReturn(func(e *domain.Email) error {
+		assert.Equal(t, "test@upmeet.app", e.To)
+		assert.Contains(t, e.Subject, "Board games")
+		return nil
+	})
+	err = s.Notify(uid, domain.NotificationTypeInvitationReceived, map[string]string{"meetup_id": "m1"})
+	assert.NoError(t, err)
+
+	// Email only for a user without email address
+	settingsRepo.EXPECT().GetNotificationSettingsByUserID(gomock.Eq(uid)).Return(&domain.NotificationSettings{UserID: uid, Preferences: []*domain.NotificationPreference{
+		{UserID: uid, Type: domain.NotificationTypeMeetupCancelled, Email: true},
+	}}, nil)
+	userRepo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{ID: uid}, nil)
+	err = s.Notify(uid, domain.NotificationTypeMeetupCancelled, &domain.Meetup{})
+	assert.NoError(t, err)
+}
+
+func Test_notificationService_NotifyLater(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	jobScheduler := mock.NewMockJobScheduler(ctrl)
+	s := NewNotificationService(mock.NewMockNotificationRepository(ctrl), mock.NewMockNotificationSettingsRepository(ctrl), mock.NewMockDeviceRepository(ctrl), mock.NewMockUserRepository(ctrl), mock.NewMockMeetupRepository(ctrl), mock.NewMockPushSender(ctrl), mock.NewMockEmailSender(ctrl), jobScheduler, mock.NewMockHub(ctrl))
+
+	userIDs := make([]string, domain.NotificationJobBatchSize+1)
+	for i := range userIDs {
+		userIDs[i] = fmt.Sprint(i)
+	}
+
+	// Schedule returns error
+	jobScheduler.EXPECT().Schedule(gomock.Eq(domain.JobTypeNotification), gomock.Eq(""), gomock.Any(), gomock.Any()).Return(fiber.ErrInternalServerError)
+	err := s.NotifyLater(userIDs, domain.NotificationTypeMeetupUpdated, &domain.Meetup{ID: "m1"})
+	assert.ErrorIs(t, err, fiber.ErrInternalServerError)
+
+	// Users are notified in batches
+	var batches [][]string
+	jobScheduler.EXPECT().Schedule(gomock.Eq(domain.JobTypeNotification), gomock.Eq(""), gomock.Any(), gomock.Any()).DoAndReturn(func(jobType string, key string, runAt time.Time, payload interface{}) error {
+		p := payload.(*domain.NotificationJob)
+		assert.Equal(t, domain.NotificationTypeMeetupUpdated, p.Type)
+		m := &domain.Meetup{}
+		assert.NoError(t, json.Unmarshal(p.Payload, m))
+		assert.Equal(t, "m1", m.ID)
+		batches = append(batches, p.UserIDs)
+		return nil
+	}).Times(2)
+	err = s.NotifyLater(userIDs, domain.NotificationTypeMeetupUpdated, &domain.Meetup{ID: "m1"})
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{userIDs[:domain.NotificationJobBatchSize], userIDs[domain.NotificationJobBatchSize:]}, batches)
+}
+
+func Test_notificationService_SendNotifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockNotificationRepository(ctrl)
+	settingsRepo := mock.NewMockNotificationSettingsRepository(ctrl)
+	jobScheduler := mock.NewMockJobScheduler(ctrl)
+	hub := mock.NewMockHub(ctrl)
+	s := NewNotificationService(repo, settingsRepo, mock.NewMockDeviceRepository(ctrl), mock.NewMockUserRepository(ctrl), mock.NewMockMeetupRepository(ctrl), mock.NewMockPushSender(ctrl), mock.NewMockEmailSender(ctrl), jobScheduler, hub)
+
+	settings := func(uid string) (*domain.NotificationSettings, error) {
+		return &domain.NotificationSettings{UserID: uid, Preferences: []*domain.NotificationPreference{
+			{UserID: uid, Type: domain.NotificationTypeMeetupUpdated, InApp: true},
+		}}, nil
+	}
+	settingsRepo.EXPECT().GetNotificationSettingsByUserID(gomock.Any()).DoAndReturn(settings).AnyTimes()
+	hub.EXPECT().Publish(gomock.Any(), gomock.Any()).AnyTimes()
+	payload, _ := json.Marshal(&domain.NotificationJob{UserIDs: []string{"1", "2"}, Type: domain.NotificationTypeMeetupUpdated, Payload: json.RawMessage(`{"id":"m1"}`)})
+	j := &domain.Job{Payload: payload}
+
+	// Every user is notified with the payload
+	repo.EXPECT().CreateNotification(gomock.Any()).DoAndReturn(func(n *domain.Notification) error {
+		assert.Equal(t, domain.NotificationTypeMeetupUpdated, n.Type)
+		assert.JSONEq(t, `{"id":"m1"}`, string(n.Payload))
+		return nil
+	}).Times(2)
+	err := s.SendNotifications(j)
+	assert.NoError(t, err)
+
+	// Failing for every user is retried
+	repo.EXPECT().CreateNotification(gomock.Any()).Return(fiber.ErrInternalServerError).Times(2)
+	err = s.SendNotifications(j)
+	assert.ErrorIs(t, err, fiber.ErrInternalServerError)
+
+	// Users who failed while others were notified are notified by a new job
+	repo.EXPECT().CreateNotification(gomock.Any()).DoAndReturn(func(n *domain.Notification) error {
+		if n.UserID == "2" {
+			return fiber.ErrInternalServerError
+		}
+		return nil
+	}).Times(2)
+	jobScheduler.EXPECT().Schedule(gomock.Eq(domain.JobTypeNotification), gomock.Eq(""), gomock.Any(), gomock.Any()).DoAndReturn(func(jobType string, key string, runAt time.Time, payload interface{}) error {
+		assert.Equal(t, []string{"2"}, payload.(*domain.NotificationJob).UserIDs)
+		return nil
+	})
+	err = s.SendNotifications(j)
+	assert.NoError(t, err)
+}
+
+func Test_notificationService_GetNotifications(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockNotificationRepository(ctrl)
+	s := NewNotificationService(repo, mock.NewMockNotificationSettingsRepository(ctrl), mock.NewMockDeviceRepository(ctrl), mock.NewMockUserRepository(ctrl), mock.NewMockMeetupRepository(ctrl), mock.NewMockPushSender(ctrl), mock.NewMockEmailSender(ctrl), mock.NewMockJobScheduler(ctrl), mock.NewMockHub(ctrl))
+
+	uid := "1"
+
+	// Limit out of range
+	repo.EXPECT().GetNotificationsByUserID(gomock.Eq(uid), gomock.Eq(""), gomock.Eq(domain.NotificationsDefaultLimit)).Return([]*domain.Notification{}, nil)
+	_, err := s.GetNotifications(uid, "", domain.NotificationsMaxLimit+1)
+	assert.NoError(t, err)
+
+	// GetNotifications successful
+	repo.EXPECT().GetNotificationsByUserID(gomock.Eq(uid), gomock.Eq("n1"), gomock.Eq(10)).Return([]*domain.Notification{{ID: "n0"}}, nil)
+	n, err := s.GetNotifications(uid, "n1", 10)
+	assert.NoError(t, err)
+	assert.Len(t, n, 1)
+}
+
+func Test_notificationService_MarkNotificationRead(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockNotificationRepository(ctrl)
+	s := NewNotificationService(repo, mock.NewMockNotificationSettingsRepository(ctrl), mock.NewMockDeviceRepository(ctrl), mock.NewMockUserRepository(ctrl), mock.NewMockMeetupRepository(ctrl), mock.NewMockPushSender(ctrl), mock.NewMockEmailSender(ctrl), mock.NewMockJobScheduler(ctrl), mock.NewMockHub(ctrl))
+
+	uid := "1"
+	id := "n1"
+
+	// Not the recipient
+	repo.EXPECT().GetNotificationByID(gomock.Eq(id)).Return(&domain.Notification{ID: id, UserID: "2"}, nil)
+	n, err := s.MarkNotificationRead(uid, id)
+	assert.ErrorIs(t, err, domain.ErrNotNotificationRecipient)
+	assert.Nil(t, n)
+
+	// Already read
+	repo.EXPECT().GetNotificationByID(gomock.Eq(id)).Return(&domain.Notification{ID: id, UserID: uid, Read: true}, nil)
+	n, err = s.MarkNotificationRead(uid, id)
+	assert.NoError(t, err)
+	assert.True(t, n.Read)
+
+	// MarkNotificationRead successful
+	repo.EXPECT().GetNotificationByID(gomock.Eq(id)).Return(&domain.Notification{ID: id, UserID: uid}, nil)
+	repo.EXPECT().UpdateNotification(gomock.Any()).Return(nil)
+	n, err = s.MarkNotificationRead(uid, id)
+	assert.NoError(t, err)
+	assert.True(t, n.Read)
+	assert.NotNil(t, n.ReadAt)
+}
+
+func Test_notificationService_DeleteNotification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockNotificationRepository(ctrl)
+	s := NewNotificationService(repo, mock.NewMockNotificationSettingsRepository(ctrl), mock.NewMockDeviceRepository(ctrl), mock.NewMockUserRepository(ctrl), mock.NewMockMeetupRepository(ctrl), mock.NewMockPushSender(ctrl), mock.NewMockEmailSender(ctrl), mock.NewMockJobScheduler(ctrl), mock.NewMockHub(ctrl))
+
+	uid := "1"
+	id := "n1"
+
+	// Not the recipient
+	repo.EXPECT().GetNotificationByID(gomock.Eq(id)).Return(&domain.Notification{ID: id, UserID: "2"}, nil)
+	err := s.DeleteNotification(uid, id)
+	assert.ErrorIs(t, err, domain.ErrNotNotificationRecipient)
+
+	// DeleteNotification successful
+	repo.EXPECT().GetNotificationByID(gomock.Eq(id)).Return(&domain.Notification{ID: id, UserID: uid}, nil)
+	repo.EXPECT().DeleteNotification(gomock.Eq(id)).Return(nil)
+	err = s.DeleteNotification(uid, id)
+	assert.NoError(t, err)
+}
+
+func Test_notificationService_UpdateNotificationSettings(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	settingsRepo := mock.NewMockNotificationSettingsRepository(ctrl)
+	s := NewNotificationService(mock.NewMockNotificationRepository(ctrl), settingsRepo, mock.NewMockDeviceRepository(ctrl), mock.NewMockUserRepository(ctrl), mock.NewMockMeetupRepository(ctrl), mock.NewMockPushSender(ctrl), mock.NewMockEmailSender(ctrl), mock.NewMockJobScheduler(ctrl), mock.NewMockHub(ctrl))
+
+	uid := "1"
+
+	// Invalid timezone
+	settings, err := s.UpdateNotificationSettings(uid, &domain.UpdateNotificationSettingsDTO{Timezone: "Mars/Olympus"})
+	assert.ErrorIs(t, err, domain.ErrInvalidTimezone)
+	assert.Nil(t, settings)
+
+	// Invalid quiet hours
+	settings, err = s.UpdateNotificationSettings(uid, &domain.UpdateNotificationSettingsDTO{QuietHoursEnabled: true, QuietHoursStart: "25:00", QuietHoursEnd: "07:00"})
+	assert.ErrorIs(t, err, domain.ErrInvalidQuietHours)
+	assert.Nil(t, settings)
+
+	// Invalid notification type
+	settings, err = s.UpdateNotificationSettings(uid, &domain.UpdateNotificationSettingsDTO{Preferences: []*domain.NotificationPreference{{Type: "unknown"}}})
+	assert.ErrorIs(t, err, domain.ErrInvalidNotificationType)
+	assert.Nil(t, settings)
+
+	// Duplicate notification type
+	settings, err = s.UpdateNotificationSettings(uid, &domain.UpdateNotificationSettingsDTO{Preferences: []*domain.NotificationPreference{
+		{Type: domain.NotificationTypeMeetupUpdated},
+		{Type: domain.NotificationTypeMeetupUpdated},
+	}})
+	assert.ErrorIs(t, err, domain.ErrInvalidNotificationType)
+	assert.Nil(t, settings)
+
+	// UpdateNotificationSettings successful
+	settingsRepo.EXPECT().SaveNotificationSettings(gomock.Any()).Return(nil)
+	settings, err = s.UpdateNotificationSettings(uid, &domain.UpdateNotificationSettingsDTO{
+		Timezone:          "Europe/Berlin",
+		QuietHoursEnabled: true,
+		QuietHoursStart:   "22:00",
+		QuietHoursEnd:     "07:00",
+		Preferences:       []*domain.NotificationPreference{{Type: domain.NotificationTypeParticipantJoined, InApp: true}},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Europe/Berlin", settings.Timezone)
+	assert.False(t, settings.Preference(domain.NotificationTypeParticipantJoined).Push)
+	assert.True(t, settings.Preference(domain.NotificationTypeMeetupUpdated).Push)
+}
+
+func Test_notificationService_SendDeferredPushes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockNotificationRepository(ctrl)
+	deviceRepo := mock.NewMockDeviceRepository(ctrl)
+	pushSender := mock.NewMockPushSender(ctrl)
+	s := NewNotificationService(repo, mock.NewMockNotificationSettingsRepository(ctrl), deviceRepo, mock.NewMockUserRepository(ctrl), mock.NewMockMeetupRepository(ctrl), pushSender, mock.NewMockEmailSender(ctrl), mock.NewMockJobScheduler(ctrl), mock.NewMockHub(ctrl))
+
+	// SendDeferredPushes successful
+	repo.EXPECT().ClaimDueDeferredPushes(gomock.Any(), gomock.Eq(domain.DeferredPushesBatchSize)).Return([]*domain.DeferredPush{{NotificationID: "n1", UserID: "1", Type: domain.NotificationTypeMeetupUpdated}}, nil)
+	deviceRepo.EXPECT().GetDevicesByUserID(gomock.Eq("1")).Return([]*domain.Device{{Token: "t1"}}, nil)
+	pushSender.EXPECT().Send(gomock.Eq([]string{"t1"}), gomock.Any()).Return(nil, nil)
+	err := s.SendDeferredPushes()
+	assert.NoError(t, err)
+
+	// Full batches are followed by further claims until all due pushes are sent
+	full := make([]*domain.DeferredPush, domain.DeferredPushesBatchSize)
+	for i := range full {
+		full[i] = &domain.DeferredPush{NotificationID: fmt.Sprintf("n%d", i), UserID: "1", Type: domain.NotificationTypeMeetupUpdated}
+	}
+	gomock.InOrder(
+		repo.EXPECT().ClaimDueDeferredPushes(gomock.Any(), gomock.Eq(domain.DeferredPushesBatchSize)).Return(full, nil),
+		repo.EXPECT().ClaimDueDeferredPushes(gomock.Any(), gomock.Eq(domain.DeferredPushesBatchSize)).Return(nil, nil),
+	)
+	deviceRepo.EXPECT().GetDevicesByUserID(gomock.Eq("1")).Return(nil, nil).Times(domain.DeferredPushesBatchSize)
+	err = s.SendDeferredPushes()
+	assert.NoError(t, err)
+}
+
+func Test_quietHoursEnd(t *testing.T) {
+	berlin, _ := time.LoadLocation("Europe/Berlin")
+	settings := func(start string, end string) *domain.NotificationSettings {
+		return &domain.NotificationSettings{Timezone: "Europe/Berlin", QuietHoursEnabled: true, QuietHoursStart: start, QuietHoursEnd: end}
+	}
+
+	tests := []struct {
+		name     string
+		settings *domain.NotificationSettings
+		now      time.Time
+		want     time.Time
+	}{
+		{"disabled", &domain.NotificationSettings{Timezone: "UTC", QuietHoursStart: "00:00", QuietHoursEnd: "23:59"}, time.Date(2022, 4, 1, 12, 0, 0, 0, time.UTC), time.Time{}},
+		{"same day inside", settings("13:00", "15:00"), time.Date(2022, 4, 1, 14, 0, 0, 0, berlin), time.Date(2022, 4, 1, 15, 0, 0, 0, berlin)},
+		{"same day outside", settings("13:00", "15:00"), time.Date(2022, 4, 1, 15, 0, 0, 0, berlin), time.Time{}},
+		{"overnight before midnight", settings("22:00", "07:00"), time.Date(2022, 4, 1, 23, 30, 0, 0, berlin), time.Date(2022, 4, 2, 7, 0, 0, 0, berlin)},
+		{"overnight after midnight", settings("22:00", "07:00"), time.Date(2022, 4, 2, 6, 59, 0, 0, berlin), time.Date(2022, 4, 2, 7, 0, 0, 0, berlin)},
+		{"overnight outside", settings("22:00", "07:00"), time.Date(2022, 4, 2, 12, 0, 0, 0, berlin), time.Time{}},
+		{"users' timezone", settings("22:00", "07:00"), time.Date(2022, 4, 1, 21, 0, 0, 0, time.UTC), time.Date(2022, 4, 2, 7, 0, 0, 0, berlin)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.True(t, tt.want.Equal(quietHoursEnd(tt.settings, tt.now)), "got %v", quietHoursEnd(tt.settings, tt.now))
+		})
+	}
+}
+
+func Test_notificationService_SendWeeklyDigests(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	settingsRepo := mock.NewMockNotificationSettingsRepository(ctrl)
+	userRepo := mock.NewMockUserRepository(ctrl)
+	meetupRepo := mock.NewMockMeetupRepository(ctrl)
+	emailSender := mock.NewMockEmailSender(ctrl)
+	jobScheduler := mock.NewMockJobScheduler(ctrl)
+	s := NewNotificationService(mock.NewMockNotificationRepository(ctrl), settingsRepo, mock.NewMockDeviceRepository(ctrl), userRepo, meetupRepo, mock.NewMockPushSender(ctrl), emailSender, jobScheduler, mock.NewMockHub(ctrl))
+
+	dueAt := time.Date(2022, 4, 4, 8, 0, 0, 0, time.UTC)
+	nextDueAt := time.Date(2022, 4, 11, 8, 0, 0, 0, time.UTC)
+	payload, _ := json.Marshal(&domain.WeeklyDigestJob{DueAt: dueAt})
+	j := &domain.Job{Type: domain.JobTypeWeeklyDigest, Key: domain.WeeklyDigestJobKey(dueAt), Payload: payload}
+
+	// Users with meetups, without meetups, with the digest disabled and failing, the next digest is scheduled
+	jobScheduler.EXPECT().Schedule(gomock.Eq(domain.JobTypeWeeklyDigest), gomock.Eq("digest.weekly:2022-04-11"), gomock.Eq(nextDueAt), gomock.Eq(&domain.WeeklyDigestJob{DueAt: nextDueAt})).Return(nil)
+	userRepo.EXPECT().GetUsersWithEmail(gomock.Eq(""), gomock.Eq(domain.DigestUsersBatchSize)).Return([]*domain.User{
+		{ID: "1", Email: "one@upmeet.app"},
+		{ID: "2", Email: "two@upmeet.app"},
+		{ID: "3", Email: "three@upmeet.app"},
+		{ID: "4", Email: "four@upmeet.app"},
+		{ID: "5", Email: "five@upmeet.app"},
+	}, nil)
+	settingsRepo.EXPECT().GetNotificationSettingsByUserID(gomock.Eq("1")).Return(nil, fiber.ErrInternalServerError)
+	settingsRepo.EXPECT().GetNotificationSettingsByUserID(gomock.Eq("2")).Return(nil, fiber.ErrNotFound)
+	meetupRepo.EXPECT().GetUpcomingMeetupsByParticipant(gomock.Eq("2"), gomock.Any(), gomock.Any()).Return([]*domain.Meetup{{Name: "Board games"}, {Name: "Hiking"}}, nil)
+	emailSender.EXPECT().Send(gomock.Any()).DoAndReturn(func(e *domain.Email) error {
+		assert.Equal(t, "two@upmeet.app", e.To)
+		assert.Contains(t, e.Text, "Board games")
+		assert.Contains(t, e.HTML, "Hiking")
+		return nil
+	})
+	settingsRepo.EXPECT().GetNotificationSettingsByUserID(gomock.Eq("3")).Return(nil, fiber.ErrNotFound)
+	meetupRepo.EXPECT().GetUpcomingMeetupsByParticipant(gomock.Eq("3"), gomock.Any(), gomock.Any()).Return([]*domain.Meetup{}, nil)
+	settingsRepo.EXPECT().GetNotificationSettingsByUserID(gomock.Eq("4")).Return(&domain.NotificationSettings{UserID: "4", Preferences: []*domain.NotificationPreference{
+		{UserID: "4", Type: domain.NotificationTypeWeeklyDigest},
+	}}, nil)
+	settingsRepo.EXPECT().GetNotificationSettingsByUserID(gomock.Eq("5")).Return(nil, fiber.ErrNotFound)
+	meetupRepo.EXPECT().GetUpcomingMeetupsByParticipant(gomock.Eq("5"), gomock.Any(), gomock.Any()).Return([]*domain.Meetup{{Name: "Chess"}}, nil)
+	emailSender.EXPECT().Send(gomock.Any()).DoAndReturn(func(e *domain.Email) error {
+		assert.Equal(t, "five@upmeet.app", e.To)
+		return nil
+	})
+	err := s.SendWeeklyDigests(j)
+	assert.NoError(t, err)
+
+	// Failing before any digest was sent is retried
+	jobScheduler.EXPECT().Schedule(gomock.Eq(domain.JobTypeWeeklyDigest), gomock.Eq("digest.weekly:2022-04-11"), gomock.Any(), gomock.Any()).Return(nil)
+	userRepo.EXPECT().GetUsersWithEmail(gomock.Eq(""), gomock.Eq(domain.DigestUsersBatchSize)).Return(nil, fiber.ErrInternalServerError)
+	err = s.SendWeeklyDigests(j)
+	assert.ErrorIs(t, err, fiber.ErrInternalServerError)
+
+	// Failing part way through continues after the last handled user in a new job
+	users := make([]*domain.User, domain.DigestUsersBatchSize)
+	for i := range users {
+		users[i] = &domain.User{ID: fmt.Sprintf("u%03d", i)}
+	}
+	jobScheduler.EXPECT().Schedule(gomock.Eq(domain.JobTypeWeeklyDigest), gomock.Eq("digest.weekly:2022-04-11"), gomock.Any(), gomock.Any()).Return(nil)
+	userRepo.EXPECT().GetUsersWithEmail(gomock.Eq(""), gomock.Eq(domain.DigestUsersBatchSize)).Return(users, nil)
+	settingsRepo.EXPECT().GetNotificationSettingsByUserID(gomock.Any()).Return(&domain.NotificationSettings{Preferences: []*domain.NotificationPreference{
+		{Type: domain.NotificationTypeWeeklyDigest},
+	}}, nil).Times(domain.DigestUsersBatchSize)
+	userRepo.EXPECT().GetUsersWithEmail(gomock.Eq("u099"), gomock.Eq(domain.DigestUsersBatchSize)).Return(nil, fiber.ErrInternalServerError)
+	jobScheduler.EXPECT().Schedule(gomock.Eq(domain.JobTypeWeeklyDigest), gomock.Eq("digest.weekly:2022-04-04:u099"), gomock.Any(), gomock.Eq(&domain.WeeklyDigestJob{DueAt: dueAt, AfterID: "u099"})).Return(nil)
+	err = s.SendWeeklyDigests(j)
+	assert.NoError(t, err)
+
+	// A continuation doesn't schedule the next digest again
+	payload, _ = json.Marshal(&domain.WeeklyDigestJob{DueAt: dueAt, AfterID: "u099"})
+	userRepo.EXPECT().GetUsersWithEmail(gomock.Eq("u099"), gomock.Eq(domain.DigestUsersBatchSize)).Return(nil, nil)
+	err = s.SendWeeklyDigests(&domain.Job{Type: domain.JobTypeWeeklyDigest, Payload: payload})
+	assert.NoError(t, err)
+}
+
+func Test_notificationService_ScheduleWeeklyDigest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	jobScheduler := mock.NewMockJobScheduler(ctrl)
+	s := NewNotificationService(mock.NewMockNotificationRepository(ctrl), mock.NewMockNotificationSettingsRepository(ctrl), mock.NewMockDeviceRepository(ctrl), mock.NewMockUserRepository(ctrl), mock.NewMockMeetupRepository(ctrl), mock.NewMockPushSender(ctrl), mock.NewMockEmailSender(ctrl), jobScheduler, mock.NewMockHub(ctrl))
+
+	dueAt := nextWeeklyDigest(time.Now())
+	jobScheduler.EXPECT().Schedule(gomock.Eq(domain.JobTypeWeeklyDigest), gomock.Eq(domain.WeeklyDigestJobKey(dueAt)), gomock.Eq(dueAt), gomock.Eq(&domain.WeeklyDigestJob{DueAt: dueAt})).Return(nil)
+	err := s.ScheduleWeeklyDigest()
+	assert.NoError(t, err)
+}
+
+func Test_pushTexts(t *testing.T) {
+	types := append([]string{
+		domain.NotificationTypeModerationWarning,
+		domain.NotificationTypeAppealResolved,
+		domain.NotificationTypeAgeVerificationReviewed,
+	}, domain.NotificationTypes...)
+	for _, notificationType := range types {
+		// The weekly digest is only sent by email
+		if notificationType == domain.NotificationTypeWeeklyDigest {
+			continue
+		}
+		assert.NotEmpty(t, pushTexts[notificationType][0], notificationType)
+		assert.NotEmpty(t, pushTexts[notificationType][1], notificationType)
+	}
+}
+
+func Test_pushData(t *testing.T) {
+	n := &domain.Notification{
+		ID:      "n1",
+		Type:    domain.NotificationTypeModerationWarning,
+		Payload: []byte(`{"report_id":"r1","target_type":"message","target_id":"m1","reason":"spam","note":"Please stop"}`),
+	}
+	assert.Equal(t, map[string]string{
+		"notification_id": "n1",
+		"type":            domain.NotificationTypeModerationWarning,
+		"report_id":       "r1",
+		"target_id":       "m1",
+	}, pushData(n))
+}
+
+func Test_nextWeeklyDigest(t *testing.T) {
+	// Friday
+	assert.Equal(t, time.Date(2022, 4, 4, 8, 0, 0, 0, time.UTC), nextWeeklyDigest(time.Date(2022, 4, 1, 12, 0, 0, 0, time.UTC)))
+	// Monday before the digest
+	assert.Equal(t, time.Date(2022, 4, 4, 8, 0, 0, 0, time.UTC), nextWeeklyDigest(time.Date(2022, 4, 4, 7, 0, 0, 0, time.UTC)))
+	// Monday after the digest
+	assert.Equal(t, time.Date(2022, 4, 11, 8, 0, 0, 0, time.UTC), nextWeeklyDigest(time.Date(2022, 4, 4, 8, 0, 0, 0, time.UTC)))
+}
@@ -0,0 +1,57 @@
+package notification
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type notificationSettingsRepository struct {
+	db *gorm.DB
+}
+
+// NewNotificationSettingsRepository creates a new notification settings repository instance.
+func NewNotificationSettingsRepository(db *gorm.DB) domain.NotificationSettingsRepository {
+	return &notificationSettingsRepository{
+		db: db,
+	}
+}
+
+func (r *notificationSettingsRepository) GetNotificationSettingsByUserID(userID string) (*domain.NotificationSettings, error) {
+	s := &domain.NotificationSettings{}
+	err := r.db.Preload("Preferences").Where("user_id = ?", userID).First(s).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fiber.ErrNotFound
+		}
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get notification settings by user id", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return s, nil
+}
+
+func (r *notificationSettingsRepository) SaveNotificationSettings(s *domain.NotificationSettings) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Omit("Preferences").Save(s).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Delete(&domain.NotificationPreference{}, "user_id = ?", s.UserID).Error
+		if err != nil {
+			return err
+		}
+		if len(s.Preferences) == 0 {
+			return nil
+		}
+		return tx.Create(s.Preferences).Error
+	})
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to save notification settings", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
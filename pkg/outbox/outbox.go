@@ -0,0 +1,25 @@
+package outbox
+
+import (
+	"encoding/json"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+	"time"
+)
+
+// Append writes an event to the outbox using the transaction of the change it describes.
+// The error is returned as is, so the repository running the transaction can handle it like its own.
+func Append(tx *gorm.DB, eventType string, aggregateID string, payload interface{}) error {
+	b, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	return tx.Create(&domain.Event{
+		ID:          uuid.NewString(),
+		Type:        eventType,
+		AggregateID: aggregateID,
+		Payload:     b,
+		CreatedAt:   time.Now(),
+	}).Error
+}
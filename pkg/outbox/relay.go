@@ -0,0 +1,104 @@
+package outbox
+
+import (
+	"fmt"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"go.uber.org/zap"
+	"sync"
+	"time"
+)
+
+type relay struct {
+	outboxRepository domain.OutboxRepository
+	handlers         map[string][]domain.EventHandler
+	mu               sync.RWMutex
+}
+
+// NewRelay creates a new outbox relay instance.
+func NewRelay(outboxRepository domain.OutboxRepository) domain.EventRelay {
+	return &relay{
+		outboxRepository: outboxRepository,
+		handlers:         make(map[string][]domain.EventHandler),
+	}
+}
+
+func (r *relay) Subscribe(eventType string, handler domain.EventHandler) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.handlers[eventType] = append(r.handlers[eventType], handler)
+}
+
+func (r *relay) DispatchPendingEvents() error {
+	for {
+		now := time.Now()
+		events, err := r.outboxRepository.ClaimEvents(now, now.Add(domain.OutboxLease), domain.OutboxBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, e := range events {
+			r.dispatch(e)
+		}
+		if len(events) < domain.OutboxBatchSize {
+			return nil
+		}
+	}
+}
+
+func (r *relay) Start() {
+	go func() {
+		for range time.Tick(domain.OutboxPollInterval) {
+			_ = r.DispatchPendingEvents()
+		}
+	}()
+	go func() {
+		for range time.Tick(time.Hour) {
+			_ = r.outboxRepository.DeleteDispatchedEvents(time.Now().Add(-domain.OutboxRetention))
+			_ = r.outboxRepository.DeleteFailedEvents(time.Now().Add(-domain.OutboxFailedRetention))
+		}
+	}()
+}
+
+// dispatch hands the event to all of its subscribers and marks it as dispatched if none of them failed.
+// Otherwise the event is delivered to every subscriber again once its lease expired, including the ones that already handled it,
+// until it is marked as failed after OutboxMaxAttempts.
+func (r *relay) dispatch(e *domain.Event) {
+	r.mu.RLock()
+	handlers := append(append([]domain.EventHandler{}, r.handlers[e.Type]...), r.handlers[domain.EventTypeAll]...)
+	r.mu.RUnlock()
+
+	var failed error
+	for _, handler := range handlers {
+		err := r.handle(handler, e)
+		if err != nil {
+			zap.L().Warn("event subscriber failed", zap.String("id", e.ID), zap.String("type", e.Type), zap.Int("attempt", e.Attempts), zap.Error(err))
+			failed = err
+		}
+	}
+
+	now := time.Now()
+	if failed != nil {
+		e.LastError = failed.Error()
+		if e.Attempts >= domain.OutboxMaxAttempts {
+			sentry.CaptureException(failed)
+			zap.L().Error("giving up event", zap.String("id", e.ID), zap.String("type", e.Type), zap.Error(failed))
+			e.LockedUntil = nil
+			e.FailedAt = &now
+		}
+	} else {
+		e.LastError = ""
+		e.LockedUntil = nil
+		e.DispatchedAt = &now
+	}
+	_ = r.outboxRepository.UpdateEvent(e)
+}
+
+// handle calls the subscriber and turns a panic into an error, so a single broken subscriber can't stop the relay.
+func (r *relay) handle(handler domain.EventHandler, e *domain.Event) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			err = fmt.Errorf("event subscriber panicked: %v", rec)
+		}
+	}()
+	return handler(e)
+}
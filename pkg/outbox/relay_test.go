@@ -0,0 +1,101 @@
+package outbox
+
+import (
+	"errors"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/UpMeetApp/server/pkg/domain/mock"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+func Test_relay_DispatchPendingEvents(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockOutboxRepository(ctrl)
+	r := NewRelay(repo)
+
+	var created, all []string
+	failing := errors.New("failed")
+	r.Subscribe(domain.EventTypeUserCreated, func(e *domain.Event) error {
+		created = append(created, e.ID)
+		return nil
+	})
+	r.Subscribe(domain.EventTypeAll, func(e *domain.Event) error {
+		all = append(all, e.ID)
+		return nil
+	})
+	r.Subscribe(domain.EventTypeMeetupCreated, func(e *domain.Event) error {
+		return failing
+	})
+	r.Subscribe(domain.EventTypeMeetupDeleted, func(e *domain.Event) error {
+		panic("panicked")
+	})
+
+	// ClaimEvents returns error
+	repo.EXPECT().ClaimEvents(gomock.Any(), gomock.Any(), gomock.Eq(domain.OutboxBatchSize)).Return(nil, fiber.ErrInternalServerError)
+	err := r.DispatchPendingEvents()
+	assert.ErrorIs(t, err, fiber.ErrInternalServerError)
+
+	// Events are dispatched to their subscribers and the ones subscribed to all events
+	repo.EXPECT().ClaimEvents(gomock.Any(), gomock.Any(), gomock.Eq(domain.OutboxBatchSize)).Return([]*domain.Event{
+		{ID: "1", Type: domain.EventTypeUserCreated, Attempts: 1},
+		{ID: "2", Type: domain.EventTypeUserUpdated, Attempts: 1},
+	}, nil)
+	repo.EXPECT().UpdateEvent(gomock.Any()).DoAndReturn(func(e *domain.Event) error {
+		assert.NotNil(t, e.DispatchedAt)
+		assert.Nil(t, e.LockedUntil)
+		return nil
+	}).Times(2)
+	err = r.DispatchPendingEvents()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1"}, created)
+	assert.Equal(t, []string{"1", "2"}, all)
+
+	// Failing subscriber keeps the event pending
+	repo.EXPECT().ClaimEvents(gomock.Any(), gomock.Any(), gomock.Eq(domain.OutboxBatchSize)).Return([]*domain.Event{{ID: "3", Type: domain.EventTypeMeetupCreated, Attempts: 1}}, nil)
+	repo.EXPECT().UpdateEvent(gomock.Any()).DoAndReturn(func(e *domain.Event) error {
+		assert.Nil(t, e.DispatchedAt)
+		assert.Equal(t, "failed", e.LastError)
+		return nil
+	})
+	err = r.DispatchPendingEvents()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"1", "2", "3"}, all)
+
+	// Panicking subscriber keeps the event pending
+	repo.EXPECT().ClaimEvents(gomock.Any(), gomock.Any(), gomock.Eq(domain.OutboxBatchSize)).Return([]*domain.Event{{ID: "4", Type: domain.EventTypeMeetupDeleted, Attempts: 1}}, nil)
+	repo.EXPECT().UpdateEvent(gomock.Any()).DoAndReturn(func(e *domain.Event) error {
+		assert.Nil(t, e.DispatchedAt)
+		assert.Nil(t, e.FailedAt)
+		assert.Contains(t, e.LastError, "panicked")
+		return nil
+	})
+	err = r.DispatchPendingEvents()
+	assert.NoError(t, err)
+
+	// Event failing on its last attempt is given up
+	lockedUntil := time.Now().Add(domain.OutboxLease)
+	repo.EXPECT().ClaimEvents(gomock.Any(), gomock.Any(), gomock.Eq(domain.OutboxBatchSize)).Return([]*domain.Event{{ID: "5", Type: domain.EventTypeMeetupCreated, Attempts: domain.OutboxMaxAttempts, LockedUntil: &lockedUntil}}, nil)
+	repo.EXPECT().UpdateEvent(gomock.Any()).DoAndReturn(func(e *domain.Event) error {
+		assert.Nil(t, e.DispatchedAt)
+		assert.NotNil(t, e.FailedAt)
+		assert.Nil(t, e.LockedUntil)
+		assert.Equal(t, "failed", e.LastError)
+		return nil
+	})
+	err = r.DispatchPendingEvents()
+	assert.NoError(t, err)
+
+	// Full batches are followed by another claim
+	batch := make([]*domain.Event, domain.OutboxBatchSize)
+	for i := range batch {
+		batch[i] = &domain.Event{ID: "batch", Type: domain.EventTypeUserUpdated, Attempts: 1}
+	}
+	repo.EXPECT().ClaimEvents(gomock.Any(), gomock.Any(), gomock.Eq(domain.OutboxBatchSize)).Return(batch, nil)
+	repo.EXPECT().UpdateEvent(gomock.Any()).Return(nil).Times(domain.OutboxBatchSize)
+	repo.EXPECT().ClaimEvents(gomock.Any(), gomock.Any(), gomock.Eq(domain.OutboxBatchSize)).Return(nil, nil)
+	err = r.DispatchPendingEvents()
+	assert.NoError(t, err)
+}
@@ -0,0 +1,82 @@
+package outbox
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+	"time"
+)
+
+type outboxRepository struct {
+	db *gorm.DB
+}
+
+// NewOutboxRepository creates a new outbox repository instance.
+func NewOutboxRepository(db *gorm.DB) domain.OutboxRepository {
+	return &outboxRepository{
+		db: db,
+	}
+}
+
+func (r *outboxRepository) ClaimEvents(now time.Time, lockedUntil time.Time, limit int) ([]*domain.Event, error) {
+	var events []*domain.Event
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// SKIP LOCKED lets the relays of all servers claim different events without waiting for each other.
+		err := tx.Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+			Where("dispatched_at IS NULL AND failed_at IS NULL AND (locked_until IS NULL OR locked_until <= ?) AND attempts < ?", now, domain.OutboxMaxAttempts).
+			Order("created_at").Limit(limit).Find(&events).Error
+		if err != nil || len(events) == 0 {
+			return err
+		}
+
+		ids := make([]string, len(events))
+		for i, e := range events {
+			e.Attempts++
+			e.LockedUntil = &lockedUntil
+			ids[i] = e.ID
+		}
+		return tx.Model(&domain.Event{}).Where("id IN ?", ids).Updates(map[string]interface{}{
+			"attempts":     gorm.Expr("attempts + 1"),
+			"locked_until": lockedUntil,
+		}).Error
+	})
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to claim events", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return events, nil
+}
+
+func (r *outboxRepository) UpdateEvent(e *domain.Event) error {
+	err := r.db.Model(e).Select("last_error", "locked_until", "dispatched_at", "failed_at").Updates(e).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to update event", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *outboxRepository) DeleteDispatchedEvents(before time.Time) error {
+	err := r.db.Where("dispatched_at < ?", before).Delete(&domain.Event{}).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to delete dispatched events", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *outboxRepository) DeleteFailedEvents(before time.Time) error {
+	err := r.db.Where("failed_at < ?", before).Delete(&domain.Event{}).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to delete failed events", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package push
+
+import (
+	"context"
+	firebase "firebase.google.com/go"
+	"firebase.google.com/go/messaging"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"go.uber.org/zap"
+	"strings"
+	"time"
+)
+
+// fcmMaxTokens is the maximum number of tokens of a single FCM multicast message.
+const fcmMaxTokens = 500
+
+type fcmSender struct {
+	client *messaging.Client
+}
+
+// NewFCMSender creates a push sender delivering through Firebase Cloud Messaging.
+func NewFCMSender(fbApp *firebase.App) domain.PushSender {
+	client, err := fbApp.Messaging(context.Background())
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Fatal("failed to create firebase messaging client", zap.Error(err))
+	}
+	return &fcmSender{
+		client: client,
+	}
+}
+
+func (s *fcmSender) Send(tokens []string, msg *domain.PushMessage) ([]string, error) {
+	var invalidTokens []string
+	for start := 0; start < len(tokens); start += fcmMaxTokens {
+		end := start + fcmMaxTokens
+		if end > len(tokens) {
+			end = len(tokens)
+		}
+		batch := tokens[start:end]
+
+		c, ccl := context.WithTimeout(context.Background(), time.Second*10)
+		res, err := s.client.SendMulticast(c, &messaging.MulticastMessage{
+			Tokens: batch,
+			Data:   msg.Data,
+			Notification: &messaging.Notification{
+				Title: msg.Title,
+				Body:  msg.Body,
+			},
+		})
+		ccl()
+		if err != nil {
+			sentry.CaptureException(err)
+			zap.L().Error("failed to send push notification", zap.Error(err))
+			return invalidTokens, err
+		}
+
+		for i, r := range res.Responses {
+			if r.Success {
+				continue
+			}
+			if isInvalidToken(r.Error) {
+				invalidTokens = append(invalidTokens, batch[i])
+				continue
+			}
+			zap.L().Warn("failed to deliver push notification", zap.Error(r.Error))
+		}
+	}
+	return invalidTokens, nil
+}
+
+// isInvalidToken returns whether the error means the token will never work again.
+// An invalid argument is also returned for messages FCM rejects, like oversized ones, for all tokens at once,
+// so it only counts when FCM complains about the registration token itself.
+func isInvalidToken(err error) bool {
+	if messaging.IsRegistrationTokenNotRegistered(err) {
+		return true
+	}
+	return messaging.IsInvalidArgument(err) && strings.Contains(err.Error(), "registration token")
+}
@@ -0,0 +1,75 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	firebase "firebase.google.com/go"
+	"fmt"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/option"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"testing"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// batchResponse returns a FCM batch response with one part per given status and body.
+func batchResponse(parts ...[2]string) roundTripFunc {
+	return func(req *http.Request) (*http.Response, error) {
+		body := &bytes.Buffer{}
+		w := multipart.NewWriter(body)
+		for i, p := range parts {
+			pw, err := w.CreatePart(map[string][]string{
+				"Content-Type": {"application/http"},
+				"Content-ID":   {fmt.Sprintf("response-%d", i+1)},
+			})
+			if err != nil {
+				return nil, err
+			}
+			_, _ = fmt.Fprintf(pw, "HTTP/1.1 %s\r\nContent-Type: application/json\r\n\r\n%s", p[0], p[1])
+		}
+		_ = w.Close()
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     http.Header{"Content-Type": {"multipart/mixed; boundary=" + w.Boundary()}},
+			Body:       io.NopCloser(body),
+			Request:    req,
+		}, nil
+	}
+}
+
+func newTestFCMSender(t *testing.T, rt roundTripFunc) domain.PushSender {
+	fbApp, err := firebase.NewApp(context.Background(), &firebase.Config{ProjectID: "test"},
+		option.WithHTTPClient(&http.Client{Transport: rt}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewFCMSender(fbApp)
+}
+
+func Test_fcmSender_Send(t *testing.T) {
+	ok := [2]string{"200 OK", `{"name": "projects/test/messages/1"}`}
+	unregistered := [2]string{"404 Not Found", `{"error": {"status": "NOT_FOUND", "message": "Requested entity was not found.", "details": [{"@type": "type.googleapis.com/google.firebase.fcm.v1.FcmError", "errorCode": "UNREGISTERED"}]}}`}
+	invalidToken := [2]string{"400 Bad Request", `{"error": {"status": "INVALID_ARGUMENT", "message": "The registration token is not a valid FCM registration token", "details": [{"@type": "type.googleapis.com/google.firebase.fcm.v1.FcmError", "errorCode": "INVALID_ARGUMENT"}]}}`}
+	tooBig := [2]string{"400 Bad Request", `{"error": {"status": "INVALID_ARGUMENT", "message": "Message is too big", "details": [{"@type": "type.googleapis.com/google.firebase.fcm.v1.FcmError", "errorCode": "INVALID_ARGUMENT"}]}}`}
+	msg := &domain.PushMessage{Title: "Title", Body: "Body"}
+
+	// Unregistered and invalid tokens are returned
+	s := newTestFCMSender(t, batchResponse(unregistered, invalidToken, ok))
+	invalidTokens, err := s.Send([]string{"a", "b", "c"}, msg)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, invalidTokens)
+
+	// A rejected message doesn't invalidate the tokens
+	s = newTestFCMSender(t, batchResponse(tooBig, tooBig))
+	invalidTokens, err = s.Send([]string{"a", "b"}, msg)
+	assert.NoError(t, err)
+	assert.Empty(t, invalidTokens)
+}
@@ -0,0 +1,24 @@
+package push
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"go.uber.org/zap"
+)
+
+type logSender struct{}
+
+// NewLogSender creates a push sender that only logs the messages instead of delivering them.
+// It is meant for local development without access to Firebase Cloud Messaging.
+func NewLogSender() domain.PushSender {
+	return &logSender{}
+}
+
+func (s *logSender) Send(tokens []string, msg *domain.PushMessage) ([]string, error) {
+	zap.L().Info("push notification",
+		zap.Strings("tokens", tokens),
+		zap.String("title", msg.Title),
+		zap.String("body", msg.Body),
+		zap.Any("data", msg.Data),
+	)
+	return nil, nil
+}
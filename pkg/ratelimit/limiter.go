@@ -0,0 +1,62 @@
+package ratelimit
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+	"time"
+)
+
+// KeyFunc identifies the client of a request, e.g. by its user or IP.
+type KeyFunc func(ctx *fiber.Ctx) string
+
+// Limiter limits the requests clients can send to the routes of the server.
+type Limiter struct {
+	store domain.RateLimitStore
+	key   KeyFunc
+}
+
+// NewLimiter creates a new limiter instance counting the requests of each client in the store.
+func NewLimiter(store domain.RateLimitStore, key KeyFunc) *Limiter {
+	return &Limiter{
+		store: store,
+		key:   key,
+	}
+}
+
+// Limit returns a handler that allows each client limit.Requests per limit.Window to the routes it is used on.
+// Routes sharing a name share their budget. The budget is reported in the RateLimit-Limit, RateLimit-Remaining and
+// RateLimit-Reset headers, when a limiter further down the chain runs, its headers replace the ones of the earlier limiters.
+// Clients over the budget are rejected with domain.ErrRateLimited and a Retry-After header.
+// If the store fails, requests are let through rather than taking the server down with it.
+func (l *Limiter) Limit(name string, limit domain.RateLimit) fiber.Handler {
+	return func(ctx *fiber.Ctx) error {
+		count, reset, err := l.store.Increment("ratelimit:"+name+":"+l.key(ctx), limit.Window)
+		if err != nil {
+			return ctx.Next()
+		}
+
+		remaining := limit.Requests - count
+		if remaining < 0 {
+			remaining = 0
+		}
+		resetSeconds := strconv.Itoa(secondsUntil(reset))
+		ctx.Set("RateLimit-Limit", strconv.Itoa(limit.Requests))
+		ctx.Set("RateLimit-Remaining", strconv.Itoa(remaining))
+		ctx.Set("RateLimit-Reset", resetSeconds)
+		if count > limit.Requests {
+			ctx.Set(fiber.HeaderRetryAfter, resetSeconds)
+			return domain.ErrRateLimited
+		}
+		return ctx.Next()
+	}
+}
+
+// secondsUntil returns the whole seconds until t, rounded up so that clients don't retry too early.
+func secondsUntil(t time.Time) int {
+	d := time.Until(t)
+	if d <= 0 {
+		return 0
+	}
+	return int((d + time.Second - 1) / time.Second)
+}
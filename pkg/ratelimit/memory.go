@@ -0,0 +1,55 @@
+package ratelimit
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"sync"
+	"time"
+)
+
+// memorySweepInterval is the interval in which expired counters are removed from the memory store.
+const memorySweepInterval = time.Minute
+
+type memoryCounter struct {
+	count int
+	reset time.Time
+}
+
+type memoryStore struct {
+	counters  map[string]*memoryCounter
+	lastSweep time.Time
+	mu        sync.Mutex
+}
+
+// NewMemoryStore creates a new in-memory rate limit store instance.
+// The counters are local to the process, so it is only meant for single instances and tests.
+func NewMemoryStore() domain.RateLimitStore {
+	return &memoryStore{
+		counters:  make(map[string]*memoryCounter),
+		lastSweep: time.Now(),
+	}
+}
+
+func (s *memoryStore) Increment(key string, window time.Duration) (int, time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(s.lastSweep) > memorySweepInterval {
+		for k, c := range s.counters {
+			if !now.Before(c.reset) {
+				delete(s.counters, k)
+			}
+		}
+		s.lastSweep = now
+	}
+
+	c, ok := s.counters[key]
+	if !ok || !now.Before(c.reset) {
+		c = &memoryCounter{reset: now.Add(window)}
+		s.counters[key] = c
+	}
+	c.count++
+	return c.count, c.reset, nil
+}
+
+func (s *memoryStore) Close() {}
@@ -0,0 +1,111 @@
+package ratelimit
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestLimiter(t *testing.T) {
+	l := NewLimiter(NewMemoryStore(), func(ctx *fiber.Ctx) string {
+		return ctx.Get("X-Client")
+	})
+	app := fiber.New()
+	app.Use(l.Limit("global", domain.RateLimit{Requests: 10, Window: time.Minute}))
+	app.Post("/reports", l.Limit("reports", domain.RateLimit{Requests: 2, Window: time.Minute}), func(ctx *fiber.Ctx) error {
+		return ctx.SendStatus(200)
+	})
+	app.Get("/meetups", func(ctx *fiber.Ctx) error {
+		return ctx.SendStatus(200)
+	})
+	request := func(method string, path string, client string) (int, map[string]string) {
+		req := httptest.NewRequest(method, path, nil)
+		req.Header.Set("X-Client", client)
+		res, err := app.Test(req)
+		assert.NoError(t, err)
+		return res.StatusCode, map[string]string{
+			"limit":       res.Header.Get("RateLimit-Limit"),
+			"remaining":   res.Header.Get("RateLimit-Remaining"),
+			"reset":       res.Header.Get("RateLimit-Reset"),
+			"retry-after": res.Header.Get("Retry-After"),
+		}
+	}
+
+	// The route budget is reported
+	status, headers := request("POST", "/reports", "a")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "2", headers["limit"])
+	assert.Equal(t, "1", headers["remaining"])
+	assert.Equal(t, "60", headers["reset"])
+	assert.Empty(t, headers["retry-after"])
+
+	// Over the route budget
+	status, _ = request("POST", "/reports", "a")
+	assert.Equal(t, 200, status)
+	status, headers = request("POST", "/reports", "a")
+	assert.Equal(t, fiber.StatusTooManyRequests, status)
+	assert.Equal(t, "0", headers["remaining"])
+	assert.Equal(t, "60", headers["retry-after"])
+
+	// Other clients have their own budget
+	status, _ = request("POST", "/reports", "b")
+	assert.Equal(t, 200, status)
+
+	// Other routes only count against the global budget
+	status, headers = request("GET", "/meetups", "a")
+	assert.Equal(t, 200, status)
+	assert.Equal(t, "10", headers["limit"])
+	assert.Equal(t, "6", headers["remaining"])
+}
+
+func TestMemoryStore(t *testing.T) {
+	s := NewMemoryStore()
+
+	count, reset, err := s.Increment("a", 50*time.Millisecond)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.WithinDuration(t, time.Now().Add(50*time.Millisecond), reset, 10*time.Millisecond)
+
+	count, _, err = s.Increment("a", 50*time.Millisecond)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	// A new window starts after the reset
+	time.Sleep(60 * time.Millisecond)
+	count, _, err = s.Increment("a", 50*time.Millisecond)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+}
+
+func TestRedisStore(t *testing.T) {
+	mr := miniredis.RunT(t)
+	s, err := NewRedisStore("redis://" + mr.Addr())
+	assert.NoError(t, err)
+	defer s.Close()
+
+	count, reset, err := s.Increment("a", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), reset, time.Second)
+
+	// The window isn't extended by further requests
+	mr.FastForward(30 * time.Second)
+	count, reset, err = s.Increment("a", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, count)
+	assert.WithinDuration(t, time.Now().Add(30*time.Second), reset, time.Second)
+
+	// A new window starts after the reset
+	mr.FastForward(31 * time.Second)
+	count, _, err = s.Increment("a", time.Minute)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, count)
+
+	// Unreachable servers fail
+	_, err = NewRedisStore("redis://127.0.0.1:1")
+	assert.Error(t, err)
+}
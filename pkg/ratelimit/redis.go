@@ -0,0 +1,64 @@
+package ratelimit
+
+import (
+	"context"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/redis/go-redis/v9"
+	"go.uber.org/zap"
+	"time"
+)
+
+// redisTimeout is how long a request waits for Redis before it is let through without counting.
+const redisTimeout = time.Second
+
+// incrementScript increments the counter and starts its window on the first request, atomically so that concurrent
+// requests of the other replicas can't leave a counter without expiry.
+var incrementScript = redis.NewScript(`
+local count = redis.call("INCR", KEYS[1])
+local ttl = redis.call("PTTL", KEYS[1])
+if ttl < 0 then
+	redis.call("PEXPIRE", KEYS[1], ARGV[1])
+	ttl = tonumber(ARGV[1])
+end
+return {count, ttl}
+`)
+
+type redisStore struct {
+	client *redis.Client
+}
+
+// NewRedisStore creates a new Redis rate limit store instance connected to the server at the url, e.g. redis://localhost:6379/0.
+func NewRedisStore(url string) (domain.RateLimitStore, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, err
+	}
+	client := redis.NewClient(opts)
+	c, ccl := context.WithTimeout(context.Background(), 5*time.Second)
+	defer ccl()
+	err = client.Ping(c).Err()
+	if err != nil {
+		_ = client.Close()
+		return nil, err
+	}
+	return &redisStore{
+		client: client,
+	}, nil
+}
+
+func (s *redisStore) Increment(key string, window time.Duration) (int, time.Time, error) {
+	c, ccl := context.WithTimeout(context.Background(), redisTimeout)
+	defer ccl()
+	res, err := incrementScript.Run(c, s.client, []string{key}, window.Milliseconds()).Int64Slice()
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to increment rate limit counter", zap.String("key", key), zap.Error(err))
+		return 0, time.Time{}, err
+	}
+	return int(res[0]), time.Now().Add(time.Duration(res[1]) * time.Millisecond), nil
+}
+
+func (s *redisStore) Close() {
+	_ = s.client.Close()
+}
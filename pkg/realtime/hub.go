@@ -0,0 +1,81 @@
+package realtime
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"go.uber.org/zap"
+	"sync"
+)
+
+// subscriptionBufferSize is the number of events buffered per subscription before events get dropped.
+const subscriptionBufferSize = 64
+
+type hub struct {
+	mu     sync.RWMutex
+	topics map[string]map[*subscription]struct{}
+}
+
+// NewHub creates a new in-process real-time hub instance.
+func NewHub() domain.Hub {
+	return &hub{
+		topics: make(map[string]map[*subscription]struct{}),
+	}
+}
+
+func (h *hub) Publish(topic string, e *domain.RealtimeEvent) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+	for sub := range h.topics[topic] {
+		select {
+		case sub.events <- e:
+		default:
+			zap.L().Warn("dropped real-time event for slow subscriber", zap.String("topic", topic), zap.String("type", e.Type))
+		}
+	}
+}
+
+func (h *hub) Subscribe(topics ...string) domain.Subscription {
+	sub := &subscription{
+		hub:    h,
+		topics: topics,
+		events: make(chan *domain.RealtimeEvent, subscriptionBufferSize),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, topic := range topics {
+		if h.topics[topic] == nil {
+			h.topics[topic] = make(map[*subscription]struct{})
+		}
+		h.topics[topic][sub] = struct{}{}
+	}
+	return sub
+}
+
+func (h *hub) unsubscribe(sub *subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for _, topic := range sub.topics {
+		delete(h.topics[topic], sub)
+		if len(h.topics[topic]) == 0 {
+			delete(h.topics, topic)
+		}
+	}
+	close(sub.events)
+}
+
+type subscription struct {
+	hub    *hub
+	topics []string
+	events chan *domain.RealtimeEvent
+	once   sync.Once
+}
+
+func (s *subscription) Events() <-chan *domain.RealtimeEvent {
+	return s.events
+}
+
+func (s *subscription) Close() {
+	s.once.Do(func() {
+		s.hub.unsubscribe(s)
+	})
+}
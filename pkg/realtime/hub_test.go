@@ -0,0 +1,47 @@
+package realtime
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func Test_hub_Publish(t *testing.T) {
+	h := NewHub()
+
+	sub1 := h.Subscribe("a")
+	sub2 := h.Subscribe("a", "b")
+	defer sub2.Close()
+
+	e := &domain.RealtimeEvent{Type: "test"}
+
+	// Every subscriber of a topic receives the event
+	h.Publish("a", e)
+	assert.Equal(t, e, <-sub1.Events())
+	assert.Equal(t, e, <-sub2.Events())
+
+	// Only subscribers of the topic receive the event
+	h.Publish("b", e)
+	assert.Equal(t, e, <-sub2.Events())
+	assert.Len(t, sub1.Events(), 0)
+
+	// Closed subscriptions don't receive events anymore
+	sub1.Close()
+	sub1.Close()
+	h.Publish("a", e)
+	_, ok := <-sub1.Events()
+	assert.False(t, ok)
+	assert.Equal(t, e, <-sub2.Events())
+}
+
+func Test_hub_Publish_slowSubscriber(t *testing.T) {
+	h := NewHub()
+	sub := h.Subscribe("a")
+	defer sub.Close()
+
+	// Publishing never blocks, events for full subscriptions are dropped
+	for i := 0; i < subscriptionBufferSize+10; i++ {
+		h.Publish("a", &domain.RealtimeEvent{Type: "test"})
+	}
+	assert.Len(t, sub.Events(), subscriptionBufferSize)
+}
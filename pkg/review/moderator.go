@@ -0,0 +1,23 @@
+package review
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+)
+
+type contentFilterModerator struct {
+	contentFilter domain.ContentFilter
+}
+
+// NewContentFilterModerator creates a review moderator rejecting reviews whose text contains a blocked word of the content filter.
+func NewContentFilterModerator(contentFilter domain.ContentFilter) domain.ReviewModerator {
+	return &contentFilterModerator{
+		contentFilter: contentFilter,
+	}
+}
+
+func (m *contentFilterModerator) ModerateReview(r *domain.Review) error {
+	if len(r.Text) == 0 {
+		return nil
+	}
+	return m.contentFilter.CheckText(r.Text)
+}
@@ -0,0 +1,91 @@
+package review
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type reviewRepository struct {
+	db *gorm.DB
+}
+
+// NewReviewRepository creates a new review repository instance.
+func NewReviewRepository(db *gorm.DB) domain.ReviewRepository {
+	return &reviewRepository{
+		db: db,
+	}
+}
+
+func (r *reviewRepository) CreateReview(rv *domain.Review) error {
+	err := r.db.Create(rv).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to create review", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *reviewRepository) GetReview(meetupID string, authorID string) (*domain.Review, error) {
+	rv := &domain.Review{}
+	err := r.db.Where("meetup_id = ? AND author_id = ?", meetupID, authorID).First(rv).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fiber.ErrNotFound
+		}
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get review", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return rv, nil
+}
+
+func (r *reviewRepository) GetReviewsByMeetupID(meetupID string) ([]*domain.Review, error) {
+	var reviews []*domain.Review
+	err := r.db.Where("meetup_id = ? AND hidden = ?", meetupID, false).
+		Where("author_id NOT IN (SELECT id FROM users WHERE status = ?)", domain.UserStatusBanned).Order("created_at DESC").Find(&reviews).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get reviews by meetup id", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return reviews, nil
+}
+
+func (r *reviewRepository) GetRatingSummaryByHostID(hostID string) (*domain.RatingSummary, error) {
+	summary := &domain.RatingSummary{}
+	err := r.db.Model(&domain.Review{}).
+		Select("COUNT(*) AS count, COALESCE(AVG(rating), 0) AS average").
+		Where("host_id = ? AND hidden = ?", hostID, false).
+		Where("author_id NOT IN (SELECT id FROM users WHERE status = ?)", domain.UserStatusBanned).
+		Scan(summary).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get rating summary by host id", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return summary, nil
+}
+
+func (r *reviewRepository) UpdateReview(rv *domain.Review) error {
+	err := r.db.Save(rv).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to update review", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *reviewRepository) DeleteReview(id string) error {
+	err := r.db.Delete(&domain.Review{}, "id = ?", id).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to delete review", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
@@ -0,0 +1,159 @@
+package review
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"time"
+)
+
+type reviewService struct {
+	reviewRepository     domain.ReviewRepository
+	meetupRepository     domain.MeetupRepository
+	attendanceRepository domain.AttendanceRepository
+	moderators           []domain.ReviewModerator
+}
+
+// NewReviewService creates a new review service instance.
+// Every review is passed through the given moderators before it is stored.
+func NewReviewService(reviewRepository domain.ReviewRepository, meetupRepository domain.MeetupRepository, attendanceRepository domain.AttendanceRepository, moderators ...domain.ReviewModerator) domain.ReviewService {
+	return &reviewService{
+		reviewRepository:     reviewRepository,
+		meetupRepository:     meetupRepository,
+		attendanceRepository: attendanceRepository,
+		moderators:           moderators,
+	}
+}
+
+func (s *reviewService) GetMeetupReviews(uid string, meetupID string) ([]*domain.Review, error) {
+	_, err := s.meetupRepository.GetMeetupByID(meetupID)
+	if err != nil {
+		return nil, err
+	}
+	return s.reviewRepository.GetReviewsByMeetupID(meetupID)
+}
+
+func (s *reviewService) CreateReview(uid string, meetupID string, dto *domain.CreateReviewDTO) (*domain.Review, error) {
+	if dto.Rating < domain.ReviewMinRating || dto.Rating > domain.ReviewMaxRating {
+		return nil, domain.ErrInvalidRating
+	}
+	if len(dto.Text) > domain.ReviewTextMaxLength {
+		return nil, domain.ErrInvalidReviewText
+	}
+
+	m, err := s.meetupRepository.GetMeetupByID(meetupID)
+	if err != nil {
+		return nil, err
+	}
+	if time.Now().Before(m.EndsAt) {
+		return nil, domain.ErrMeetupNotEnded
+	}
+	err = s.checkConfirmedParticipant(m, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.reviewRepository.GetReview(meetupID, uid)
+	if err != fiber.ErrNotFound {
+		if err != nil {
+			return nil, err
+		}
+		return nil, domain.ErrAlreadyReviewed
+	}
+
+	r := &domain.Review{
+		ID:        uuid.NewString(),
+		MeetupID:  meetupID,
+		AuthorID:  uid,
+		HostID:    m.OwnerID,
+		Rating:    dto.Rating,
+		Text:      dto.Text,
+		CreatedAt: time.Now(),
+		UpdatedAt: time.Now(),
+	}
+	err = s.moderate(r)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.reviewRepository.CreateReview(r)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (s *reviewService) UpdateReview(uid string, meetupID string, dto *domain.UpdateReviewDTO) (*domain.Review, error) {
+	r, err := s.reviewRepository.GetReview(meetupID, uid)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update Rating
+	if dto.Rating != 0 {
+		if dto.Rating < domain.ReviewMinRating || dto.Rating > domain.ReviewMaxRating {
+			return nil, domain.ErrInvalidRating
+		}
+		r.Rating = dto.Rating
+	}
+
+	// Update Text
+	if len(dto.Text) > 0 {
+		if len(dto.Text) > domain.ReviewTextMaxLength {
+			return nil, domain.ErrInvalidReviewText
+		}
+		r.Text = dto.Text
+	}
+
+	r.UpdatedAt = time.Now()
+	err = s.moderate(r)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.reviewRepository.UpdateReview(r)
+	if err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func (s *reviewService) DeleteReview(uid string, meetupID string) error {
+	r, err := s.reviewRepository.GetReview(meetupID, uid)
+	if err != nil {
+		return err
+	}
+	return s.reviewRepository.DeleteReview(r.ID)
+}
+
+// checkConfirmedParticipant makes sure the user took part in the meetup as a guest and was not marked as absent by the host.
+func (s *reviewService) checkConfirmedParticipant(m *domain.Meetup, uid string) error {
+	if m.OwnerID == uid {
+		return domain.ErrReviewNotAllowed
+	}
+	ok, err := s.meetupRepository.IsParticipant(m.ID, uid)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return domain.ErrReviewNotAllowed
+	}
+	a, err := s.attendanceRepository.GetAttendance(m.ID, uid)
+	if err != nil && err != fiber.ErrNotFound {
+		return err
+	}
+	if a != nil && !a.Attended {
+		return domain.ErrReviewNotAllowed
+	}
+	return nil
+}
+
+func (s *reviewService) moderate(r *domain.Review) error {
+	for _, m := range s.moderators {
+		err := m.ModerateReview(r)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,192 @@
+package review
+
+import (
+	"errors"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/UpMeetApp/server/pkg/domain/mock"
+	"github.com/UpMeetApp/server/pkg/filter"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+type moderatorFunc func(r *domain.Review) error
+
+func (f moderatorFunc) ModerateReview(r *domain.Review) error {
+	return f(r)
+}
+
+func Test_reviewService_CreateReview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockReviewRepository(ctrl)
+	meetupRepo := mock.NewMockMeetupRepository(ctrl)
+	attendanceRepo := mock.NewMockAttendanceRepository(ctrl)
+	errRejected := errors.New("rejected")
+	s := NewReviewService(repo, meetupRepo, attendanceRepo, moderatorFunc(func(r *domain.Review) error {
+		if r.Text == "spam" {
+			return errRejected
+		}
+		return nil
+	}))
+
+	uid := "1"
+	id := "m1"
+	ended := &domain.Meetup{ID: id, OwnerID: "2", EndsAt: time.Now().Add(-time.Hour)}
+
+	// Rating out of range
+	r, err := s.CreateReview(uid, id, &domain.CreateReviewDTO{Rating: 6})
+	assert.ErrorIs(t, err, domain.ErrInvalidRating)
+	assert.Nil(t, r)
+
+	// Meetup not ended
+	dto := &domain.CreateReviewDTO{Rating: 5}
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: "2", EndsAt: time.Now().Add(time.Hour)}, nil)
+	r, err = s.CreateReview(uid, id, dto)
+	assert.ErrorIs(t, err, domain.ErrMeetupNotEnded)
+	assert.Nil(t, r)
+
+	// Host can't review own meetup
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(&domain.Meetup{ID: id, OwnerID: uid}, nil)
+	r, err = s.CreateReview(uid, id, dto)
+	assert.ErrorIs(t, err, domain.ErrReviewNotAllowed)
+	assert.Nil(t, r)
+
+	// Not a participant
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(ended, nil)
+	meetupRepo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(false, nil)
+	r, err = s.CreateReview(uid, id, dto)
+	assert.ErrorIs(t, err, domain.ErrReviewNotAllowed)
+	assert.Nil(t, r)
+
+	// Marked as absent
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(ended, nil)
+	meetupRepo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(true, nil)
+	attendanceRepo.EXPECT().GetAttendance(gomock.Eq(id), gomock.Eq(uid)).Return(&domain.Attendance{Attended: false}, nil)
+	r, err = s.CreateReview(uid, id, dto)
+	assert.ErrorIs(t, err, domain.ErrReviewNotAllowed)
+	assert.Nil(t, r)
+
+	// Already reviewed
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(ended, nil)
+	meetupRepo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(true, nil)
+	attendanceRepo.EXPECT().GetAttendance(gomock.Eq(id), gomock.Eq(uid)).Return(nil, fiber.ErrNotFound)
+	repo.EXPECT().GetReview(gomock.Eq(id), gomock.Eq(uid)).Return(&domain.Review{}, nil)
+	r, err = s.CreateReview(uid, id, dto)
+	assert.ErrorIs(t, err, domain.ErrAlreadyReviewed)
+	assert.Nil(t, r)
+
+	// Rejected by moderator
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(ended, nil)
+	meetupRepo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(true, nil)
+	attendanceRepo.EXPECT().GetAttendance(gomock.Eq(id), gomock.Eq(uid)).Return(&domain.Attendance{Attended: true}, nil)
+	repo.EXPECT().GetReview(gomock.Eq(id), gomock.Eq(uid)).Return(nil, fiber.ErrNotFound)
+	r, err = s.CreateReview(uid, id, &domain.CreateReviewDTO{Rating: 1, Text: "spam"})
+	assert.ErrorIs(t, err, errRejected)
+	assert.Nil(t, r)
+
+	// CreateReview successful
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(ended, nil)
+	meetupRepo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(true, nil)
+	attendanceRepo.EXPECT().GetAttendance(gomock.Eq(id), gomock.Eq(uid)).Return(&domain.Attendance{Attended: true}, nil)
+	repo.EXPECT().GetReview(gomock.Eq(id), gomock.Eq(uid)).Return(nil, fiber.ErrNotFound)
+	repo.EXPECT().CreateReview(gomock.Any()).Return(nil)
+	r, err = s.CreateReview(uid, id, dto)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, r.ID)
+	assert.Equal(t, "2", r.HostID)
+	assert.Equal(t, dto.Rating, r.Rating)
+}
+
+// Test_reviewService_ContentFilter wires the review service with the content filter moderator like the server does.
+func Test_reviewService_ContentFilter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockReviewRepository(ctrl)
+	meetupRepo := mock.NewMockMeetupRepository(ctrl)
+	attendanceRepo := mock.NewMockAttendanceRepository(ctrl)
+	words := filepath.Join(t.TempDir(), "words.txt")
+	assert.NoError(t, os.WriteFile(words, []byte("badword\n"), 0o600))
+	contentFilter, err := filter.NewContentFilter(words, "")
+	if !assert.NoError(t, err) {
+		return
+	}
+	s := NewReviewService(repo, meetupRepo, attendanceRepo, NewContentFilterModerator(contentFilter))
+
+	uid := "1"
+	id := "m1"
+	ended := &domain.Meetup{ID: id, OwnerID: "2", EndsAt: time.Now().Add(-time.Hour)}
+
+	// Blocked word in a new review
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(id)).Return(ended, nil)
+	meetupRepo.EXPECT().IsParticipant(gomock.Eq(id), gomock.Eq(uid)).Return(true, nil)
+	attendanceRepo.EXPECT().GetAttendance(gomock.Eq(id), gomock.Eq(uid)).Return(nil, fiber.ErrNotFound)
+	repo.EXPECT().GetReview(gomock.Eq(id), gomock.Eq(uid)).Return(nil, fiber.ErrNotFound)
+	r, err := s.CreateReview(uid, id, &domain.CreateReviewDTO{Rating: 1, Text: "what a b4dword host"})
+	assert.ErrorIs(t, err, domain.ErrInappropriateContent)
+	assert.Nil(t, r)
+
+	// Blocked word in an edited review
+	repo.EXPECT().GetReview(gomock.Eq(id), gomock.Eq(uid)).Return(&domain.Review{Rating: 3, Text: "ok"}, nil)
+	r, err = s.UpdateReview(uid, id, &domain.UpdateReviewDTO{Text: "badword"})
+	assert.ErrorIs(t, err, domain.ErrInappropriateContent)
+	assert.Nil(t, r)
+
+	// Clean text passes
+	repo.EXPECT().GetReview(gomock.Eq(id), gomock.Eq(uid)).Return(&domain.Review{Rating: 3, Text: "ok"}, nil)
+	repo.EXPECT().UpdateReview(gomock.Any()).Return(nil)
+	r, err = s.UpdateReview(uid, id, &domain.UpdateReviewDTO{Text: "great host"})
+	assert.NoError(t, err)
+	assert.Equal(t, "great host", r.Text)
+}
+
+func Test_reviewService_UpdateReview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockReviewRepository(ctrl)
+	s := NewReviewService(repo, mock.NewMockMeetupRepository(ctrl), mock.NewMockAttendanceRepository(ctrl))
+
+	uid := "1"
+	id := "m1"
+
+	// Review not found
+	repo.EXPECT().GetReview(gomock.Eq(id), gomock.Eq(uid)).Return(nil, fiber.ErrNotFound)
+	r, err := s.UpdateReview(uid, id, &domain.UpdateReviewDTO{})
+	assert.ErrorIs(t, err, fiber.ErrNotFound)
+	assert.Nil(t, r)
+
+	// Rating out of range
+	repo.EXPECT().GetReview(gomock.Eq(id), gomock.Eq(uid)).Return(&domain.Review{Rating: 3}, nil)
+	r, err = s.UpdateReview(uid, id, &domain.UpdateReviewDTO{Rating: -1})
+	assert.ErrorIs(t, err, domain.ErrInvalidRating)
+	assert.Nil(t, r)
+
+	// UpdateReview successful
+	repo.EXPECT().GetReview(gomock.Eq(id), gomock.Eq(uid)).Return(&domain.Review{Rating: 3, Text: "ok"}, nil)
+	repo.EXPECT().UpdateReview(gomock.Any()).Return(nil)
+	r, err = s.UpdateReview(uid, id, &domain.UpdateReviewDTO{Rating: 4})
+	assert.NoError(t, err)
+	assert.Equal(t, 4, r.Rating)
+	assert.Equal(t, "ok", r.Text)
+}
+
+func Test_reviewService_DeleteReview(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockReviewRepository(ctrl)
+	s := NewReviewService(repo, mock.NewMockMeetupRepository(ctrl), mock.NewMockAttendanceRepository(ctrl))
+
+	uid := "1"
+	id := "m1"
+
+	// Review not found
+	repo.EXPECT().GetReview(gomock.Eq(id), gomock.Eq(uid)).Return(nil, fiber.ErrNotFound)
+	err := s.DeleteReview(uid, id)
+	assert.ErrorIs(t, err, fiber.ErrNotFound)
+
+	// DeleteReview successful
+	repo.EXPECT().GetReview(gomock.Eq(id), gomock.Eq(uid)).Return(&domain.Review{ID: "r1"}, nil)
+	repo.EXPECT().DeleteReview(gomock.Eq("r1")).Return(nil)
+	err = s.DeleteReview(uid, id)
+	assert.NoError(t, err)
+}
@@ -0,0 +1,26 @@
+package server
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+// HandleGetAbuseSignals handles GET /admin/abuse-signals
+func (s *Server) HandleGetAbuseSignals(ctx *fiber.Ctx) error {
+	_, err := s.AdminAuth(ctx)
+	if err != nil {
+		return err
+	}
+	limit, _ := strconv.Atoi(ctx.Query("limit"))
+	signals, err := s.abuseService.GetAbuseSignals(&domain.AbuseSignalFilter{
+		UserID:   ctx.Query("user_id"),
+		Decision: ctx.Query("decision"),
+		Before:   ctx.Query("before"),
+		Limit:    limit,
+	})
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(signals)
+}
@@ -0,0 +1,101 @@
+package server
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+// HandleGetUserMeModerationActions handles GET /users/@me/moderation-actions
+func (s *Server) HandleGetUserMeModerationActions(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuthAnyStatus(ctx)
+	if err != nil {
+		return err
+	}
+	a, err := s.moderationService.GetUserModerationActions(uid)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(a)
+}
+
+// HandleGetUserMeAppeals handles GET /users/@me/appeals
+func (s *Server) HandleGetUserMeAppeals(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuthAnyStatus(ctx)
+	if err != nil {
+		return err
+	}
+	a, err := s.moderationService.GetUserAppeals(uid)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(a)
+}
+
+// HandleCreateAppeal handles POST /appeals
+func (s *Server) HandleCreateAppeal(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuthAnyStatus(ctx)
+	if err != nil {
+		return err
+	}
+	var dto domain.CreateAppealDTO
+	err = ctx.BodyParser(&dto)
+	if err != nil {
+		return fiber.ErrBadRequest
+	}
+	a, err := s.moderationService.CreateAppeal(uid, &dto)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(a)
+}
+
+// HandleGetAppeals handles GET /admin/appeals
+func (s *Server) HandleGetAppeals(ctx *fiber.Ctx) error {
+	_, err := s.AdminAuth(ctx)
+	if err != nil {
+		return err
+	}
+	limit, _ := strconv.Atoi(ctx.Query("limit"))
+	a, err := s.moderationService.GetAppeals(&domain.AppealFilter{
+		Status: ctx.Query("status"),
+		UserID: ctx.Query("user_id"),
+		Before: ctx.Query("before"),
+		Limit:  limit,
+	})
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(a)
+}
+
+// HandleGetAppeal handles GET /admin/appeals/:id
+func (s *Server) HandleGetAppeal(ctx *fiber.Ctx) error {
+	_, err := s.AdminAuth(ctx)
+	if err != nil {
+		return err
+	}
+	a, err := s.moderationService.GetAppeal(ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(a)
+}
+
+// HandleResolveAppeal handles POST /admin/appeals/:id/resolve
+func (s *Server) HandleResolveAppeal(ctx *fiber.Ctx) error {
+	uid, err := s.AdminAuth(ctx)
+	if err != nil {
+		return err
+	}
+	var dto domain.ResolveAppealDTO
+	err = ctx.BodyParser(&dto)
+	if err != nil {
+		return fiber.ErrBadRequest
+	}
+	a, err := s.moderationService.ResolveAppeal(uid, ctx.Params("id"), &dto)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(a)
+}
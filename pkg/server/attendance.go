@@ -0,0 +1,50 @@
+package server
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+)
+
+// HandleGetMeetupAttendance handles GET /meetups/:id/attendance
+func (s *Server) HandleGetMeetupAttendance(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	a, err := s.attendanceService.GetMeetupAttendance(uid, ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(a)
+}
+
+// HandleMarkMeetupAttendance handles PUT /meetups/:id/attendance
+func (s *Server) HandleMarkMeetupAttendance(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	var dto domain.MarkAttendanceDTO
+	err = ctx.BodyParser(&dto)
+	if err != nil {
+		return fiber.ErrBadRequest
+	}
+	a, err := s.attendanceService.MarkAttendance(uid, ctx.Params("id"), &dto)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(a)
+}
+
+// HandleGetUserMeAttendance handles GET /users/@me/attendance
+func (s *Server) HandleGetUserMeAttendance(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	stats, err := s.attendanceService.GetAttendanceStats(uid)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(stats)
+}
@@ -2,24 +2,77 @@ package server
 
 import (
 	"context"
+	"firebase.google.com/go/auth"
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/websocket/v2"
 	"strings"
 	"time"
 )
 
 // FirebaseAuth is a middleware that validates Firebase ID Tokens passed in the Authorization HTTP header.
+// Since browsers can't set headers on WebSocket connections, upgrade requests may pass the token in the access_token query parameter instead.
+// Suspended and banned users are rejected with a domain.UserStatusError.
 func (s *Server) FirebaseAuth(ctx *fiber.Ctx) (uid string, err error) {
-	h := ctx.Get("Authorization")
-	parts := strings.Split(h, " ")
-	if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
-		return "", fiber.ErrBadRequest
+	t, err := s.FirebaseToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	err = s.userService.CheckUserStatus(t.UID)
+	if err != nil {
+		return "", err
+	}
+	return t.UID, nil
+}
+
+// FirebaseAuthAnyStatus validates the Firebase ID Token like FirebaseAuth, but lets suspended and banned users through.
+// It is only meant for the endpoints they need to appeal moderation decisions.
+func (s *Server) FirebaseAuthAnyStatus(ctx *fiber.Ctx) (uid string, err error) {
+	t, err := s.FirebaseToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	return t.UID, nil
+}
+
+// FirebaseToken validates the Firebase ID Token like FirebaseAuth, but returns the whole token including its claims.
+// The token is verified once per request, later calls return the token verified first.
+func (s *Server) FirebaseToken(ctx *fiber.Ctx) (*auth.Token, error) {
+	if t := cachedToken(ctx); t != nil {
+		return t, nil
+	}
+	token := ctx.Query("access_token")
+	if len(token) == 0 || !websocket.IsWebSocketUpgrade(ctx) {
+		h := ctx.Get("Authorization")
+		parts := strings.Split(h, " ")
+		if len(parts) != 2 || strings.ToLower(parts[0]) != "bearer" {
+			return nil, fiber.ErrBadRequest
+		}
+		token = parts[1]
 	}
 
 	c, ccl := context.WithTimeout(context.Background(), time.Second*10)
 	defer ccl()
-	t, err := s.fbAuth.VerifyIDToken(c, parts[1])
+	t, err := s.fbAuth.VerifyIDToken(c, token)
+	if err != nil {
+		return nil, fiber.ErrUnauthorized
+	}
+	ctx.Locals("token", t)
+	return t, nil
+}
+
+// AdminAuth validates the Firebase ID Token like FirebaseAuth and additionally requires the admin custom claim.
+// Admins are moderators of the whole application, the claim is set through the Firebase Admin SDK.
+func (s *Server) AdminAuth(ctx *fiber.Ctx) (uid string, err error) {
+	t, err := s.FirebaseToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	if admin, _ := t.Claims["admin"].(bool); !admin {
+		return "", fiber.ErrForbidden
+	}
+	err = s.userService.CheckUserStatus(t.UID)
 	if err != nil {
-		return "", fiber.ErrUnauthorized
+		return "", err
 	}
 	return t.UID, nil
 }
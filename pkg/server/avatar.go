@@ -0,0 +1,35 @@
+package server
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+	"io"
+)
+
+// HandleUpdateUserMeAvatar handles PUT /users/@me/avatar
+// The request is a multipart form with the image as the avatar file.
+func (s *Server) HandleUpdateUserMeAvatar(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	fh, err := ctx.FormFile("avatar")
+	if err != nil {
+		return domain.ErrInvalidAvatar
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return domain.ErrInvalidAvatar
+	}
+	defer f.Close()
+	// One byte more than allowed is read, so the service can tell an image that is too large.
+	data, err := io.ReadAll(io.LimitReader(f, domain.AvatarMaxSize+1))
+	if err != nil {
+		return domain.ErrInvalidAvatar
+	}
+	a, err := s.avatarService.UpdateAvatar(uid, data)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(a)
+}
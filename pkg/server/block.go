@@ -0,0 +1,44 @@
+package server
+
+import (
+	"github.com/gofiber/fiber/v2"
+)
+
+// HandleGetUserMeBlocks handles GET /users/@me/blocks
+func (s *Server) HandleGetUserMeBlocks(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	b, err := s.blockService.GetBlockedUsers(uid)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(b)
+}
+
+// HandleBlockUser handles PUT /users/@me/blocks/:username
+func (s *Server) HandleBlockUser(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	err = s.blockService.BlockUser(uid, ctx.Params("username"))
+	if err != nil {
+		return err
+	}
+	return ctx.SendStatus(200)
+}
+
+// HandleUnblockUser handles DELETE /users/@me/blocks/:username
+func (s *Server) HandleUnblockUser(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	err = s.blockService.UnblockUser(uid, ctx.Params("username"))
+	if err != nil {
+		return err
+	}
+	return ctx.SendStatus(200)
+}
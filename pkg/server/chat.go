@@ -0,0 +1,97 @@
+package server
+
+import (
+	"encoding/json"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/websocket/v2"
+	"strconv"
+)
+
+// HandleGetMeetupMessages handles GET /meetups/:id/messages
+func (s *Server) HandleGetMeetupMessages(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	limit, _ := strconv.Atoi(ctx.Query("limit"))
+	m, err := s.chatService.GetMeetupMessages(uid, ctx.Params("id"), ctx.Query("before"), limit)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(m)
+}
+
+// HandleCreateMeetupMessage handles POST /meetups/:id/messages
+func (s *Server) HandleCreateMeetupMessage(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	var dto domain.CreateMessageDTO
+	err = ctx.BodyParser(&dto)
+	if err != nil {
+		return fiber.ErrBadRequest
+	}
+	m, err := s.chatService.CreateMeetupMessage(uid, ctx.Params("id"), &dto)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(m)
+}
+
+// HandleGetMeetupReadMarkers handles GET /meetups/:id/read-markers
+func (s *Server) HandleGetMeetupReadMarkers(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	rm, err := s.chatService.GetMeetupReadMarkers(uid, ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(rm)
+}
+
+// HandleMeetupChatUpgrade handles GET /meetups/:id/chat before the connection is upgraded to a WebSocket.
+func (s *Server) HandleMeetupChatUpgrade(ctx *fiber.Ctx) error {
+	if !websocket.IsWebSocketUpgrade(ctx) {
+		return fiber.ErrUpgradeRequired
+	}
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	err = s.chatService.CheckMeetupChatAccess(uid, ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	ctx.Locals("uid", uid)
+	return ctx.Next()
+}
+
+// HandleMeetupChat handles WebSocket connections on GET /meetups/:id/chat
+func (s *Server) HandleMeetupChat(conn *websocket.Conn) {
+	uid := conn.Locals("uid").(string)
+	meetupID := conn.Params("id")
+
+	sub, err := s.chatService.SubscribeMeetupChat(uid, meetupID)
+	if err != nil {
+		_ = conn.WriteJSON(commandError(err))
+		return
+	}
+	serveWebSocket(conn, sub, func(cmd *domain.RealtimeCommand) error {
+		switch cmd.Type {
+		case domain.RealtimeCommandMessageCreate:
+			var dto domain.CreateMessageDTO
+			err := json.Unmarshal(cmd.Data, &dto)
+			if err != nil {
+				return fiber.ErrBadRequest
+			}
+			_, err = s.chatService.CreateMeetupMessage(uid, meetupID, &dto)
+			return err
+		default:
+			return s.handleMessageCommand(uid, cmd)
+		}
+	})
+}
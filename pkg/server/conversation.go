@@ -0,0 +1,96 @@
+package server
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+// HandleStartConversation handles POST /conversations
+func (s *Server) HandleStartConversation(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	var dto domain.StartConversationDTO
+	err = ctx.BodyParser(&dto)
+	if err != nil {
+		return fiber.ErrBadRequest
+	}
+	c, err := s.conversationService.StartConversation(uid, &dto)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(c)
+}
+
+// HandleGetConversations handles GET /conversations
+func (s *Server) HandleGetConversations(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	c, err := s.conversationService.GetConversations(uid)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(c)
+}
+
+// HandleGetConversationMessages handles GET /conversations/:id/messages
+func (s *Server) HandleGetConversationMessages(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	limit, _ := strconv.Atoi(ctx.Query("limit"))
+	m, err := s.conversationService.GetConversationMessages(uid, ctx.Params("id"), ctx.Query("before"), limit)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(m)
+}
+
+// HandleCreateConversationMessage handles POST /conversations/:id/messages
+func (s *Server) HandleCreateConversationMessage(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	var dto domain.CreateMessageDTO
+	err = ctx.BodyParser(&dto)
+	if err != nil {
+		return fiber.ErrBadRequest
+	}
+	m, err := s.conversationService.CreateConversationMessage(uid, ctx.Params("id"), &dto)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(m)
+}
+
+// HandleGetConversationReadMarkers handles GET /conversations/:id/read-markers
+func (s *Server) HandleGetConversationReadMarkers(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	rm, err := s.conversationService.GetConversationReadMarkers(uid, ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(rm)
+}
+
+// HandleMarkConversationRead handles PUT /conversations/:id/read
+func (s *Server) HandleMarkConversationRead(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	rm, err := s.conversationService.MarkConversationRead(uid, ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(rm)
+}
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+)
+
+// HandleGetUserMeDevices handles GET /users/@me/devices
+func (s *Server) HandleGetUserMeDevices(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	d, err := s.deviceService.GetDevices(uid)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(d)
+}
+
+// HandleRegisterUserMeDevice handles POST /users/@me/devices
+func (s *Server) HandleRegisterUserMeDevice(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	var dto domain.RegisterDeviceDTO
+	err = ctx.BodyParser(&dto)
+	if err != nil {
+		return fiber.ErrBadRequest
+	}
+	d, err := s.deviceService.RegisterDevice(uid, &dto)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(d)
+}
+
+// HandleUnregisterUserMeDevice handles DELETE /users/@me/devices/:token
+func (s *Server) HandleUnregisterUserMeDevice(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	err = s.deviceService.UnregisterDevice(uid, ctx.Params("token"))
+	if err != nil {
+		return err
+	}
+	return ctx.SendStatus(200)
+}
@@ -0,0 +1,57 @@
+package server
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"time"
+)
+
+// eventStreamHeartbeatInterval is the interval in which comments are sent to keep idle event streams alive and detect closed connections.
+const eventStreamHeartbeatInterval = 15 * time.Second
+
+// HandleEventStream handles GET /events/stream
+// It streams all real-time events addressed to the user as Server-Sent Events.
+func (s *Server) HandleEventStream(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+
+	ctx.Set(fiber.HeaderContentType, "text/event-stream")
+	ctx.Set(fiber.HeaderCacheControl, "no-cache")
+	ctx.Set(fiber.HeaderConnection, "keep-alive")
+	ctx.Set("X-Accel-Buffering", "no")
+
+	sub := s.hub.Subscribe(domain.UserTopic(uid))
+	ctx.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer sub.Close()
+
+		heartbeat := time.NewTicker(eventStreamHeartbeatInterval)
+		defer heartbeat.Stop()
+		for {
+			select {
+			case e, ok := <-sub.Events():
+				if !ok {
+					return
+				}
+				data, err := json.Marshal(e.Data)
+				if err != nil {
+					zap.L().Error("failed to marshal event", zap.String("type", e.Type), zap.Error(err))
+					continue
+				}
+				_, _ = fmt.Fprintf(w, "event: %s\ndata: %s\n\n", e.Type, data)
+			case <-heartbeat.C:
+				_, _ = fmt.Fprint(w, ": heartbeat\n\n")
+			}
+			// Flushing fails once the client disconnected.
+			if w.Flush() != nil {
+				return
+			}
+		}
+	})
+	return nil
+}
@@ -0,0 +1,50 @@
+package server
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+)
+
+// HandleCreateInvitation handles POST /meetups/:id/invitations
+func (s *Server) HandleCreateInvitation(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	var dto domain.CreateInvitationDTO
+	err = ctx.BodyParser(&dto)
+	if err != nil {
+		return fiber.ErrBadRequest
+	}
+	i, err := s.invitationService.CreateInvitation(uid, ctx.Params("id"), &dto)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(i)
+}
+
+// HandleDeclineInvitation handles DELETE /meetups/:id/invitations/@me
+func (s *Server) HandleDeclineInvitation(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	err = s.invitationService.DeclineInvitation(uid, ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	return ctx.SendStatus(200)
+}
+
+// HandleGetUserMeInvitations handles GET /users/@me/invitations
+func (s *Server) HandleGetUserMeInvitations(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	i, err := s.invitationService.GetInvitations(uid)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(i)
+}
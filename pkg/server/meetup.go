@@ -0,0 +1,109 @@
+package server
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+// HandleDiscoverMeetups handles GET /meetups
+func (s *Server) HandleDiscoverMeetups(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	limit, _ := strconv.Atoi(ctx.Query("limit"))
+	m, err := s.meetupService.DiscoverMeetups(uid, ctx.Query("after"), limit)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(m)
+}
+
+// HandleCreateMeetup handles POST /meetups
+func (s *Server) HandleCreateMeetup(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	var dto domain.CreateMeetupDTO
+	err = ctx.BodyParser(&dto)
+	if err != nil {
+		return fiber.ErrBadRequest
+	}
+	m, err := s.meetupService.CreateMeetup(uid, &dto)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(m)
+}
+
+// HandleGetMeetup handles GET /meetups/:id
+func (s *Server) HandleGetMeetup(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	m, err := s.meetupService.GetMeetupByID(uid, ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(m)
+}
+
+// HandleUpdateMeetup handles PATCH /meetups/:id
+func (s *Server) HandleUpdateMeetup(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	var dto domain.UpdateMeetupDTO
+	err = ctx.BodyParser(&dto)
+	if err != nil {
+		return fiber.ErrBadRequest
+	}
+	m, err := s.meetupService.UpdateMeetup(uid, ctx.Params("id"), &dto)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(m)
+}
+
+// HandleDeleteMeetup handles DELETE /meetups/:id
+func (s *Server) HandleDeleteMeetup(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	err = s.meetupService.DeleteMeetup(uid, ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	return ctx.SendStatus(200)
+}
+
+// HandleJoinMeetup handles PUT /meetups/:id/participants/@me
+func (s *Server) HandleJoinMeetup(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	err = s.meetupService.JoinMeetup(uid, ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	return ctx.SendStatus(200)
+}
+
+// HandleLeaveMeetup handles DELETE /meetups/:id/participants/@me
+func (s *Server) HandleLeaveMeetup(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	err = s.meetupService.LeaveMeetup(uid, ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	return ctx.SendStatus(200)
+}
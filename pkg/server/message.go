@@ -0,0 +1,111 @@
+package server
+
+import (
+	"encoding/json"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+	"net/url"
+)
+
+// HandleUpdateMessage handles PATCH /messages/:id
+func (s *Server) HandleUpdateMessage(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	var dto domain.UpdateMessageDTO
+	err = ctx.BodyParser(&dto)
+	if err != nil {
+		return fiber.ErrBadRequest
+	}
+	m, err := s.chatService.UpdateMessage(uid, ctx.Params("id"), &dto)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(m)
+}
+
+// HandleDeleteMessage handles DELETE /messages/:id
+func (s *Server) HandleDeleteMessage(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	err = s.chatService.DeleteMessage(uid, ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	return ctx.SendStatus(200)
+}
+
+// HandleAddReaction handles PUT /messages/:id/reactions/:emoji
+func (s *Server) HandleAddReaction(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	emoji, err := url.PathUnescape(ctx.Params("emoji"))
+	if err != nil {
+		return domain.ErrInvalidEmoji
+	}
+	err = s.chatService.AddReaction(uid, ctx.Params("id"), emoji)
+	if err != nil {
+		return err
+	}
+	return ctx.SendStatus(200)
+}
+
+// HandleRemoveReaction handles DELETE /messages/:id/reactions/:emoji
+func (s *Server) HandleRemoveReaction(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	emoji, err := url.PathUnescape(ctx.Params("emoji"))
+	if err != nil {
+		return domain.ErrInvalidEmoji
+	}
+	err = s.chatService.RemoveReaction(uid, ctx.Params("id"), emoji)
+	if err != nil {
+		return err
+	}
+	return ctx.SendStatus(200)
+}
+
+// HandleMarkMessageRead handles PUT /messages/:id/read
+func (s *Server) HandleMarkMessageRead(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	rm, err := s.chatService.MarkRead(uid, ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(rm)
+}
+
+// handleMessageCommand handles the real-time commands targeting existing messages, which are the same for every chat.
+func (s *Server) handleMessageCommand(uid string, cmd *domain.RealtimeCommand) error {
+	var dto domain.MessageCommandDTO
+	err := json.Unmarshal(cmd.Data, &dto)
+	if err != nil {
+		return fiber.ErrBadRequest
+	}
+
+	switch cmd.Type {
+	case domain.RealtimeCommandMessageUpdate:
+		_, err = s.chatService.UpdateMessage(uid, dto.MessageID, &domain.UpdateMessageDTO{Content: dto.Content})
+	case domain.RealtimeCommandMessageDelete:
+		err = s.chatService.DeleteMessage(uid, dto.MessageID)
+	case domain.RealtimeCommandReactionAdd:
+		err = s.chatService.AddReaction(uid, dto.MessageID, dto.Emoji)
+	case domain.RealtimeCommandReactionRemove:
+		err = s.chatService.RemoveReaction(uid, dto.MessageID, dto.Emoji)
+	case domain.RealtimeCommandMessageRead:
+		_, err = s.chatService.MarkRead(uid, dto.MessageID)
+	default:
+		err = domain.ErrUnknownCommand
+	}
+	return err
+}
@@ -0,0 +1,156 @@
+package server
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+// HandleCreateReport handles POST /reports
+func (s *Server) HandleCreateReport(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	var dto domain.CreateReportDTO
+	err = ctx.BodyParser(&dto)
+	if err != nil {
+		return fiber.ErrBadRequest
+	}
+	r, err := s.moderationService.CreateReport(uid, &dto)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(r)
+}
+
+// HandleGetReports handles GET /admin/reports
+func (s *Server) HandleGetReports(ctx *fiber.Ctx) error {
+	uid, err := s.AdminAuth(ctx)
+	if err != nil {
+		return err
+	}
+	limit, _ := strconv.Atoi(ctx.Query("limit"))
+	filter := &domain.ReportFilter{
+		Status:     ctx.Query("status"),
+		TargetType: ctx.Query("target_type"),
+		Reason:     ctx.Query("reason"),
+		AssigneeID: ctx.Query("assignee"),
+		Before:     ctx.Query("before"),
+		Limit:      limit,
+	}
+	switch filter.AssigneeID {
+	case "@me":
+		filter.AssigneeID = uid
+	case "none":
+		filter.AssigneeID = ""
+		filter.Unassigned = true
+	}
+	r, err := s.moderationService.GetReports(filter)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(r)
+}
+
+// HandleGetReport handles GET /admin/reports/:id
+func (s *Server) HandleGetReport(ctx *fiber.Ctx) error {
+	_, err := s.AdminAuth(ctx)
+	if err != nil {
+		return err
+	}
+	r, err := s.moderationService.GetReport(ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(r)
+}
+
+// HandleAssignReport handles PUT /admin/reports/:id/assignee
+func (s *Server) HandleAssignReport(ctx *fiber.Ctx) error {
+	uid, err := s.AdminAuth(ctx)
+	if err != nil {
+		return err
+	}
+	var dto domain.AssignReportDTO
+	if len(ctx.Body()) > 0 {
+		err = ctx.BodyParser(&dto)
+		if err != nil {
+			return fiber.ErrBadRequest
+		}
+	}
+	r, err := s.moderationService.AssignReport(uid, ctx.Params("id"), &dto)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(r)
+}
+
+// HandleUnassignReport handles DELETE /admin/reports/:id/assignee
+func (s *Server) HandleUnassignReport(ctx *fiber.Ctx) error {
+	uid, err := s.AdminAuth(ctx)
+	if err != nil {
+		return err
+	}
+	r, err := s.moderationService.UnassignReport(uid, ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(r)
+}
+
+// HandleResolveReport handles POST /admin/reports/:id/resolve
+func (s *Server) HandleResolveReport(ctx *fiber.Ctx) error {
+	uid, err := s.AdminAuth(ctx)
+	if err != nil {
+		return err
+	}
+	var dto domain.ResolveReportDTO
+	err = ctx.BodyParser(&dto)
+	if err != nil {
+		return fiber.ErrBadRequest
+	}
+	r, err := s.moderationService.ResolveReport(uid, ctx.Params("id"), &dto)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(r)
+}
+
+// HandleGetModerationActions handles GET /admin/moderation-actions
+func (s *Server) HandleGetModerationActions(ctx *fiber.Ctx) error {
+	_, err := s.AdminAuth(ctx)
+	if err != nil {
+		return err
+	}
+	limit, _ := strconv.Atoi(ctx.Query("limit"))
+	a, err := s.moderationService.GetModerationActions(&domain.ModerationActionFilter{
+		ReportID:     ctx.Query("report_id"),
+		AppealID:     ctx.Query("appeal_id"),
+		TargetUserID: ctx.Query("user_id"),
+		Before:       ctx.Query("before"),
+		Limit:        limit,
+	})
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(a)
+}
+
+// HandleUpdateUserStatus handles PUT /admin/users/:id/status
+func (s *Server) HandleUpdateUserStatus(ctx *fiber.Ctx) error {
+	uid, err := s.AdminAuth(ctx)
+	if err != nil {
+		return err
+	}
+	var dto domain.UpdateUserStatusDTO
+	err = ctx.BodyParser(&dto)
+	if err != nil {
+		return fiber.ErrBadRequest
+	}
+	u, err := s.moderationService.UpdateUserStatus(uid, ctx.Params("id"), &dto)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(u)
+}
@@ -0,0 +1,91 @@
+package server
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+// HandleGetNotifications handles GET /notifications
+func (s *Server) HandleGetNotifications(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	limit, _ := strconv.Atoi(ctx.Query("limit"))
+	n, err := s.notificationService.GetNotifications(uid, ctx.Query("before"), limit)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(n)
+}
+
+// HandleMarkAllNotificationsRead handles PUT /notifications/read
+func (s *Server) HandleMarkAllNotificationsRead(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	err = s.notificationService.MarkAllNotificationsRead(uid)
+	if err != nil {
+		return err
+	}
+	return ctx.SendStatus(200)
+}
+
+// HandleMarkNotificationRead handles PUT /notifications/:id/read
+func (s *Server) HandleMarkNotificationRead(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	n, err := s.notificationService.MarkNotificationRead(uid, ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(n)
+}
+
+// HandleDeleteNotification handles DELETE /notifications/:id
+func (s *Server) HandleDeleteNotification(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	err = s.notificationService.DeleteNotification(uid, ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	return ctx.SendStatus(200)
+}
+
+// HandleGetUserMeNotificationSettings handles GET /users/@me/notification-settings
+func (s *Server) HandleGetUserMeNotificationSettings(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	settings, err := s.notificationService.GetNotificationSettings(uid)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(settings)
+}
+
+// HandleUpdateUserMeNotificationSettings handles PUT /users/@me/notification-settings
+func (s *Server) HandleUpdateUserMeNotificationSettings(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	var dto domain.UpdateNotificationSettingsDTO
+	err = ctx.BodyParser(&dto)
+	if err != nil {
+		return fiber.ErrBadRequest
+	}
+	settings, err := s.notificationService.UpdateNotificationSettings(uid, &dto)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(settings)
+}
@@ -0,0 +1,52 @@
+package server
+
+import (
+	"firebase.google.com/go/auth"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+	"strings"
+	"time"
+)
+
+// Budgets of the routes that are more expensive or more attractive for floods than the default budget of the config allows.
+var (
+	rateLimitSignUp           = domain.RateLimit{Requests: 10, Window: time.Hour}
+	rateLimitEmail            = domain.RateLimit{Requests: 5, Window: time.Hour}
+	rateLimitSearch           = domain.RateLimit{Requests: 60, Window: time.Minute}
+	rateLimitMeetups          = domain.RateLimit{Requests: 30, Window: time.Hour}
+	rateLimitInvitations      = domain.RateLimit{Requests: 100, Window: time.Hour}
+	rateLimitConversations    = domain.RateLimit{Requests: 30, Window: time.Hour}
+	rateLimitMessages         = domain.RateLimit{Requests: 60, Window: time.Minute}
+	rateLimitReports          = domain.RateLimit{Requests: 20, Window: time.Hour}
+	rateLimitAppeals          = domain.RateLimit{Requests: 5, Window: time.Hour}
+	rateLimitAgeVerifications = domain.RateLimit{Requests: 5, Window: 24 * time.Hour}
+	rateLimitAvatars          = domain.RateLimit{Requests: 10, Window: time.Hour}
+)
+
+// rateLimitKey identifies clients sending a valid ID token by their uid and everybody else by their IP.
+// The verified token is kept for the handler, so it isn't verified twice.
+func (s *Server) rateLimitKey(ctx *fiber.Ctx) string {
+	if len(ctx.Get(fiber.HeaderAuthorization)) > 0 || len(ctx.Query("access_token")) > 0 {
+		t, err := s.FirebaseToken(ctx)
+		if err == nil {
+			return "user:" + t.UID
+		}
+	}
+	return "ip:" + clientIP(ctx)
+}
+
+// clientIP returns the IP of the client. Proxies appending to a list like X-Forwarded-For keep what the client sent,
+// so only the last address, the one added by the trusted proxy itself, is used.
+func clientIP(ctx *fiber.Ctx) string {
+	ip := ctx.IP()
+	if i := strings.LastIndexByte(ip, ','); i >= 0 {
+		ip = ip[i+1:]
+	}
+	return strings.TrimSpace(ip)
+}
+
+// cachedToken returns the ID token verified earlier in the request, if any.
+func cachedToken(ctx *fiber.Ctx) *auth.Token {
+	t, _ := ctx.Locals("token").(*auth.Token)
+	return t
+}
@@ -0,0 +1,45 @@
+package server
+
+import (
+	"github.com/UpMeetApp/server/pkg/config"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net/http/httptest"
+	"testing"
+)
+
+// rateLimitKeyOf returns the rate limit key of a request with the given X-Forwarded-For header, sent from 0.0.0.0 by app.Test.
+func rateLimitKeyOf(t *testing.T, cfg *config.Config, forwardedFor string) string {
+	s := &Server{}
+	app := fiber.New(appConfig(cfg))
+	app.Get("/", func(ctx *fiber.Ctx) error {
+		return ctx.SendString(s.rateLimitKey(ctx))
+	})
+	req := httptest.NewRequest("GET", "/", nil)
+	if len(forwardedFor) > 0 {
+		req.Header.Set(fiber.HeaderXForwardedFor, forwardedFor)
+	}
+	res, err := app.Test(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := io.ReadAll(res.Body)
+	return string(b)
+}
+
+func Test_Server_rateLimitKey(t *testing.T) {
+	// Header of a client that isn't a trusted proxy is ignored
+	cfg := &config.Config{ProxyHeader: fiber.HeaderXForwardedFor, TrustedProxies: []string{"10.0.0.0/8"}}
+	assert.Equal(t, "ip:0.0.0.0", rateLimitKeyOf(t, cfg, ""))
+	assert.Equal(t, "ip:0.0.0.0", rateLimitKeyOf(t, cfg, "1.2.3.4"))
+
+	// Header is ignored without trusted proxies
+	cfg = &config.Config{ProxyHeader: fiber.HeaderXForwardedFor}
+	assert.Equal(t, "ip:0.0.0.0", rateLimitKeyOf(t, cfg, "1.2.3.4"))
+
+	// Header of a trusted proxy is used, addresses sent by the client in front of the proxy are ignored
+	cfg = &config.Config{ProxyHeader: fiber.HeaderXForwardedFor, TrustedProxies: []string{"0.0.0.0"}}
+	assert.Equal(t, "ip:1.2.3.4", rateLimitKeyOf(t, cfg, "1.2.3.4"))
+	assert.Equal(t, "ip:1.2.3.4", rateLimitKeyOf(t, cfg, "5.6.7.8, 1.2.3.4"))
+}
@@ -0,0 +1,43 @@
+package server
+
+import (
+	"encoding/json"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/websocket/v2"
+)
+
+// HandleRealtimeUpgrade handles GET /realtime before the connection is upgraded to a WebSocket.
+func (s *Server) HandleRealtimeUpgrade(ctx *fiber.Ctx) error {
+	if !websocket.IsWebSocketUpgrade(ctx) {
+		return fiber.ErrUpgradeRequired
+	}
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	ctx.Locals("uid", uid)
+	return ctx.Next()
+}
+
+// HandleRealtime handles WebSocket connections on GET /realtime.
+// The connection receives all events addressed to the user, such as direct messages.
+func (s *Server) HandleRealtime(conn *websocket.Conn) {
+	uid := conn.Locals("uid").(string)
+
+	sub := s.hub.Subscribe(domain.UserTopic(uid))
+	serveWebSocket(conn, sub, func(cmd *domain.RealtimeCommand) error {
+		switch cmd.Type {
+		case domain.RealtimeCommandMessageCreate:
+			var dto domain.CreateConversationMessageDTO
+			err := json.Unmarshal(cmd.Data, &dto)
+			if err != nil {
+				return fiber.ErrBadRequest
+			}
+			_, err = s.conversationService.CreateConversationMessage(uid, dto.ConversationID, &dto.CreateMessageDTO)
+			return err
+		default:
+			return s.handleMessageCommand(uid, cmd)
+		}
+	})
+}
@@ -0,0 +1,68 @@
+package server
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+)
+
+// HandleGetMeetupReviews handles GET /meetups/:id/reviews
+func (s *Server) HandleGetMeetupReviews(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	r, err := s.reviewService.GetMeetupReviews(uid, ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(r)
+}
+
+// HandleCreateReview handles POST /meetups/:id/reviews
+func (s *Server) HandleCreateReview(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	var dto domain.CreateReviewDTO
+	err = ctx.BodyParser(&dto)
+	if err != nil {
+		return fiber.ErrBadRequest
+	}
+	r, err := s.reviewService.CreateReview(uid, ctx.Params("id"), &dto)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(r)
+}
+
+// HandleUpdateReviewMe handles PATCH /meetups/:id/reviews/@me
+func (s *Server) HandleUpdateReviewMe(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	var dto domain.UpdateReviewDTO
+	err = ctx.BodyParser(&dto)
+	if err != nil {
+		return fiber.ErrBadRequest
+	}
+	r, err := s.reviewService.UpdateReview(uid, ctx.Params("id"), &dto)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(r)
+}
+
+// HandleDeleteReviewMe handles DELETE /meetups/:id/reviews/@me
+func (s *Server) HandleDeleteReviewMe(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	err = s.reviewService.DeleteReview(uid, ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	return ctx.SendStatus(200)
+}
@@ -1,29 +1,50 @@
 package server
 
 import (
+	"bytes"
 	"context"
 	"encoding/base64"
+	"errors"
 	firebase "firebase.google.com/go"
 	"firebase.google.com/go/auth"
 	"github.com/UpMeetApp/server/pkg/config"
 	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/UpMeetApp/server/pkg/ratelimit"
 	"github.com/getsentry/sentry-go"
 	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/websocket/v2"
+	"github.com/valyala/fasthttp"
 	"go.uber.org/zap"
 	"google.golang.org/api/option"
 )
 
 // Server is the main server struct.
 type Server struct {
-	app         *fiber.App
-	fbApp       *firebase.App
-	fbAuth      *auth.Client
-	cfg         *config.Config
-	userService domain.UserService
+	app                    *fiber.App
+	fbApp                  *firebase.App
+	fbAuth                 *auth.Client
+	cfg                    *config.Config
+	hub                    domain.Hub
+	userService            domain.UserService
+	meetupService          domain.MeetupService
+	attendanceService      domain.AttendanceService
+	reviewService          domain.ReviewService
+	chatService            domain.ChatService
+	conversationService    domain.ConversationService
+	invitationService      domain.InvitationService
+	notificationService    domain.NotificationService
+	deviceService          domain.DeviceService
+	webhookService         domain.WebhookService
+	blockService           domain.BlockService
+	moderationService      domain.ModerationService
+	abuseService           domain.AbuseService
+	ageVerificationService domain.AgeVerificationService
+	avatarService          domain.AvatarService
 }
 
-// New created a new (web) server instance.
-func New(cfg *config.Config, userService domain.UserService) *Server {
+// NewFirebaseApp creates the firebase app from the service account key in the config.
+// It is shared by the server for authentication and by other components like push notifications.
+func NewFirebaseApp(cfg *config.Config) *firebase.App {
 	creds, err := base64.StdEncoding.DecodeString(cfg.FirebaseCredentials)
 	if err != nil {
 		sentry.CaptureException(err)
@@ -34,32 +55,191 @@ func New(cfg *config.Config, userService domain.UserService) *Server {
 		sentry.CaptureException(err)
 		zap.L().Fatal("failed to create firebase app", zap.Error(err))
 	}
+	return fbApp
+}
+
+// appConfig returns the fiber config of the server.
+// The proxy header is only trusted from the configured proxies, anybody else could use it to pick their own IP.
+func appConfig(cfg *config.Config) fiber.Config {
+	return fiber.Config{
+		ErrorHandler:            errorHandler,
+		ProxyHeader:             cfg.ProxyHeader,
+		EnableTrustedProxyCheck: true,
+		TrustedProxies:          cfg.TrustedProxies,
+	}
+}
+
+// uploadBodyLimits are the body limits of the upload routes by method and path, all other routes keep the default limit of fiber.
+// Each leaves room for the rest of the multipart form next to the largest allowed file.
+var uploadBodyLimits = map[string]int{
+	"PUT /api/v1/users/@me/avatar":            domain.AvatarMaxSize + 1<<20,
+	"POST /api/v1/users/@me/age-verification": domain.AgeVerificationDocumentMaxSize + 1<<20,
+}
+
+// requestConfig raises the body limit for the upload routes. It runs once the headers are received, before the body is read.
+func requestConfig(header *fasthttp.RequestHeader) fasthttp.RequestConfig {
+	path := header.RequestURI()
+	if i := bytes.IndexByte(path, '?'); i >= 0 {
+		path = path[:i]
+	}
+	return fasthttp.RequestConfig{
+		MaxRequestBodySize: uploadBodyLimits[string(header.Method())+" "+string(path)],
+	}
+}
+
+// New created a new (web) server instance.
+func New(cfg *config.Config, fbApp *firebase.App, hub domain.Hub, userService domain.UserService, meetupService domain.MeetupService, attendanceService domain.AttendanceService, reviewService domain.ReviewService, chatService domain.ChatService, conversationService domain.ConversationService, invitationService domain.InvitationService, notificationService domain.NotificationService, deviceService domain.DeviceService, webhookService domain.WebhookService, blockService domain.BlockService, moderationService domain.ModerationService, abuseService domain.AbuseService, ageVerificationService domain.AgeVerificationService, avatarService domain.AvatarService, rateLimitStore domain.RateLimitStore) *Server {
 	fbAuth, err := fbApp.Auth(context.Background())
 	if err != nil {
 		sentry.CaptureException(err)
 		zap.L().Fatal("failed to create firebase auth client", zap.Error(err))
 	}
-	app := fiber.New()
+	if len(cfg.ProxyHeader) > 0 && len(cfg.TrustedProxies) == 0 {
+		zap.L().Warn("proxy header is ignored without trusted proxies", zap.String("proxy_header", cfg.ProxyHeader))
+	}
+	app := fiber.New(appConfig(cfg))
+	app.Server().HeaderReceived = requestConfig
 
 	s := &Server{
-		app:         app,
-		fbApp:       fbApp,
-		fbAuth:      fbAuth,
-		cfg:         cfg,
-		userService: userService,
+		app:                    app,
+		fbApp:                  fbApp,
+		fbAuth:                 fbAuth,
+		cfg:                    cfg,
+		hub:                    hub,
+		userService:            userService,
+		meetupService:          meetupService,
+		attendanceService:      attendanceService,
+		reviewService:          reviewService,
+		chatService:            chatService,
+		conversationService:    conversationService,
+		invitationService:      invitationService,
+		notificationService:    notificationService,
+		deviceService:          deviceService,
+		webhookService:         webhookService,
+		blockService:           blockService,
+		moderationService:      moderationService,
+		abuseService:           abuseService,
+		ageVerificationService: ageVerificationService,
+		avatarService:          avatarService,
+	}
+
+	limiter := ratelimit.NewLimiter(rateLimitStore, s.rateLimitKey)
+	limit := limiter.Limit
+
+	// Files of the local file storage are served by the server itself, their keys never change.
+	if cfg.FileStorage == "local" {
+		app.Static("/files", cfg.FileStorageDir, fiber.Static{MaxAge: 365 * 24 * 60 * 60})
 	}
 
 	api := app.Group("/api")
-	apiV1 := api.Group("/v1")
+	apiV1 := api.Group("/v1", limit("default", domain.RateLimit{Requests: cfg.RateLimitRequests, Window: cfg.RateLimitWindow}))
 
 	apiV1.Get("/users/@me", s.HandleGetUserMe)
-	apiV1.Post("/users/@me", s.HandleCreateUserMe)
+	apiV1.Post("/users/@me", limit("users.create", rateLimitSignUp), s.HandleCreateUserMe)
 	apiV1.Patch("/users/@me", s.HandleUpdateUserMe)
 	apiV1.Delete("/users/@me", s.HandleDeleteUserMe)
+	apiV1.Put("/users/@me/avatar", limit("users.avatar", rateLimitAvatars), s.HandleUpdateUserMeAvatar)
+	apiV1.Put("/users/@me/email", limit("users.email", rateLimitEmail), s.HandleChangeUserMeEmail)
+	apiV1.Post("/users/@me/email/verify", limit("users.email.verify", rateLimitEmail), s.HandleVerifyUserMeEmail)
+	apiV1.Get("/users/@me/devices", s.HandleGetUserMeDevices)
+	apiV1.Post("/users/@me/devices", s.HandleRegisterUserMeDevice)
+	apiV1.Delete("/users/@me/devices/:token", s.HandleUnregisterUserMeDevice)
+	apiV1.Get("/users/@me/notification-settings", s.HandleGetUserMeNotificationSettings)
+	apiV1.Put("/users/@me/notification-settings", s.HandleUpdateUserMeNotificationSettings)
+	apiV1.Get("/users/@me/attendance", s.HandleGetUserMeAttendance)
+	apiV1.Get("/users/@me/invitations", s.HandleGetUserMeInvitations)
+	apiV1.Get("/users/@me/blocks", s.HandleGetUserMeBlocks)
+	apiV1.Put("/users/@me/blocks/:username", s.HandleBlockUser)
+	apiV1.Delete("/users/@me/blocks/:username", s.HandleUnblockUser)
+	apiV1.Get("/users/@me/moderation-actions", s.HandleGetUserMeModerationActions)
+	apiV1.Get("/users/@me/appeals", s.HandleGetUserMeAppeals)
+	apiV1.Get("/users/@me/age-verification", s.HandleGetUserMeAgeVerification)
+	apiV1.Post("/users/@me/age-verification", limit("users.age-verification", rateLimitAgeVerifications), s.HandleSubmitUserMeAgeVerification)
+	apiV1.Get("/users", limit("users.search", rateLimitSearch), s.HandleSearchUsers)
+	apiV1.Get("/users/:username", s.HandleGetUserProfile)
+
+	apiV1.Get("/meetups", limit("meetups.discover", rateLimitSearch), s.HandleDiscoverMeetups)
+	apiV1.Post("/meetups", limit("meetups.create", rateLimitMeetups), s.HandleCreateMeetup)
+	apiV1.Get("/meetups/:id", s.HandleGetMeetup)
+	apiV1.Patch("/meetups/:id", s.HandleUpdateMeetup)
+	apiV1.Delete("/meetups/:id", s.HandleDeleteMeetup)
+	apiV1.Put("/meetups/:id/participants/@me", s.HandleJoinMeetup)
+	apiV1.Delete("/meetups/:id/participants/@me", s.HandleLeaveMeetup)
+	apiV1.Post("/meetups/:id/invitations", limit("invitations.create", rateLimitInvitations), s.HandleCreateInvitation)
+	apiV1.Delete("/meetups/:id/invitations/@me", s.HandleDeclineInvitation)
+	apiV1.Get("/meetups/:id/attendance", s.HandleGetMeetupAttendance)
+	apiV1.Put("/meetups/:id/attendance", s.HandleMarkMeetupAttendance)
+	apiV1.Get("/meetups/:id/reviews", s.HandleGetMeetupReviews)
+	apiV1.Post("/meetups/:id/reviews", s.HandleCreateReview)
+	apiV1.Patch("/meetups/:id/reviews/@me", s.HandleUpdateReviewMe)
+	apiV1.Delete("/meetups/:id/reviews/@me", s.HandleDeleteReviewMe)
+	apiV1.Get("/meetups/:id/messages", s.HandleGetMeetupMessages)
+	apiV1.Post("/meetups/:id/messages", limit("messages.create", rateLimitMessages), s.HandleCreateMeetupMessage)
+	apiV1.Get("/meetups/:id/read-markers", s.HandleGetMeetupReadMarkers)
+	apiV1.Get("/meetups/:id/webhooks", s.HandleGetWebhooks)
+	apiV1.Post("/meetups/:id/webhooks", s.HandleCreateWebhook)
+	apiV1.Patch("/meetups/:id/webhooks/:webhookId", s.HandleUpdateWebhook)
+	apiV1.Delete("/meetups/:id/webhooks/:webhookId", s.HandleDeleteWebhook)
+	apiV1.Get("/meetups/:id/webhooks/:webhookId/deliveries", s.HandleGetWebhookDeliveries)
+	apiV1.Get("/meetups/:id/chat", s.HandleMeetupChatUpgrade, websocket.New(s.HandleMeetupChat))
+
+	apiV1.Post("/conversations", limit("conversations.create", rateLimitConversations), s.HandleStartConversation)
+	apiV1.Get("/conversations", s.HandleGetConversations)
+	apiV1.Get("/conversations/:id/messages", s.HandleGetConversationMessages)
+	apiV1.Post("/conversations/:id/messages", limit("messages.create", rateLimitMessages), s.HandleCreateConversationMessage)
+	apiV1.Get("/conversations/:id/read-markers", s.HandleGetConversationReadMarkers)
+	apiV1.Put("/conversations/:id/read", s.HandleMarkConversationRead)
+
+	apiV1.Patch("/messages/:id", s.HandleUpdateMessage)
+	apiV1.Delete("/messages/:id", s.HandleDeleteMessage)
+	apiV1.Put("/messages/:id/reactions/:emoji", s.HandleAddReaction)
+	apiV1.Delete("/messages/:id/reactions/:emoji", s.HandleRemoveReaction)
+	apiV1.Put("/messages/:id/read", s.HandleMarkMessageRead)
+
+	apiV1.Get("/notifications", s.HandleGetNotifications)
+	apiV1.Put("/notifications/read", s.HandleMarkAllNotificationsRead)
+	apiV1.Put("/notifications/:id/read", s.HandleMarkNotificationRead)
+	apiV1.Delete("/notifications/:id", s.HandleDeleteNotification)
+
+	apiV1.Post("/reports", limit("reports.create", rateLimitReports), s.HandleCreateReport)
+	apiV1.Post("/appeals", limit("appeals.create", rateLimitAppeals), s.HandleCreateAppeal)
+
+	apiV1.Get("/admin/reports", s.HandleGetReports)
+	apiV1.Get("/admin/reports/:id", s.HandleGetReport)
+	apiV1.Put("/admin/reports/:id/assignee", s.HandleAssignReport)
+	apiV1.Delete("/admin/reports/:id/assignee", s.HandleUnassignReport)
+	apiV1.Post("/admin/reports/:id/resolve", s.HandleResolveReport)
+	apiV1.Get("/admin/appeals", s.HandleGetAppeals)
+	apiV1.Get("/admin/appeals/:id", s.HandleGetAppeal)
+	apiV1.Post("/admin/appeals/:id/resolve", s.HandleResolveAppeal)
+	apiV1.Get("/admin/age-verifications", s.HandleGetAgeVerifications)
+	apiV1.Get("/admin/age-verifications/:id", s.HandleGetAgeVerification)
+	apiV1.Get("/admin/age-verifications/:id/document", s.HandleGetAgeVerificationDocument)
+	apiV1.Post("/admin/age-verifications/:id/review", s.HandleReviewAgeVerification)
+	apiV1.Get("/admin/moderation-actions", s.HandleGetModerationActions)
+	apiV1.Put("/admin/users/:id/status", s.HandleUpdateUserStatus)
+	apiV1.Get("/admin/abuse-signals", s.HandleGetAbuseSignals)
+
+	apiV1.Get("/realtime", s.HandleRealtimeUpgrade, websocket.New(s.HandleRealtime))
+	apiV1.Get("/events/stream", s.HandleEventStream)
 
 	return s
 }
 
+// errorHandler responds with the reason and end of the suspension for user status errors, every other error is handled by the default error handler.
+func errorHandler(ctx *fiber.Ctx, err error) error {
+	var statusErr *domain.UserStatusError
+	if errors.As(err, &statusErr) {
+		return ctx.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error":           statusErr.Error(),
+			"status":          statusErr.Status,
+			"reason":          statusErr.Reason,
+			"suspended_until": statusErr.SuspendedUntil,
+		})
+	}
+	return fiber.DefaultErrorHandler(ctx, err)
+}
+
 // Start starts the (web) server.
 func (s *Server) Start(bindAddress string) {
 	err := s.app.Listen(bindAddress)
@@ -0,0 +1,34 @@
+package server
+
+import (
+	"bytes"
+	"github.com/UpMeetApp/server/pkg/config"
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"net/http/httptest"
+	"testing"
+)
+
+func Test_requestConfig(t *testing.T) {
+	app := fiber.New(appConfig(&config.Config{}))
+	app.Server().HeaderReceived = requestConfig
+	ok := func(ctx *fiber.Ctx) error {
+		return ctx.SendStatus(fiber.StatusNoContent)
+	}
+	app.Put("/api/v1/users/@me/avatar", ok)
+	app.Patch("/api/v1/users/@me", ok)
+
+	// app.Test reports bodies over the limit as an error, the server responds with 413 Request Entity Too Large.
+	accepted := func(method string, path string, size int) bool {
+		res, err := app.Test(httptest.NewRequest(method, path, bytes.NewReader(make([]byte, size))), -1)
+		return err == nil && res.StatusCode == fiber.StatusNoContent
+	}
+
+	// Other routes keep the default limit
+	assert.True(t, accepted("PATCH", "/api/v1/users/@me", 1<<10))
+	assert.False(t, accepted("PATCH", "/api/v1/users/@me", fiber.DefaultBodyLimit+1))
+
+	// Upload routes have a larger limit
+	assert.True(t, accepted("PUT", "/api/v1/users/@me/avatar?size=1", fiber.DefaultBodyLimit+1))
+	assert.False(t, accepted("PUT", "/api/v1/users/@me/avatar", uploadBodyLimits["PUT /api/v1/users/@me/avatar"]+1))
+}
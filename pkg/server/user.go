@@ -20,7 +20,7 @@ func (s *Server) HandleGetUserMe(ctx *fiber.Ctx) error {
 
 // HandleCreateUserMe handles POST /users/@me
 func (s *Server) HandleCreateUserMe(ctx *fiber.Ctx) error {
-	uid, err := s.FirebaseAuth(ctx)
+	t, err := s.FirebaseToken(ctx)
 	if err != nil {
 		return err
 	}
@@ -29,7 +29,9 @@ func (s *Server) HandleCreateUserMe(ctx *fiber.Ctx) error {
 	if err != nil {
 		return fiber.ErrBadRequest
 	}
-	u, err := s.userService.CreateUser(uid, &dto)
+	dto.Email, _ = t.Claims["email"].(string)
+	dto.EmailVerified, _ = t.Claims["email_verified"].(bool)
+	u, err := s.userService.CreateUser(t.UID, &dto)
 	if err != nil {
 		return err
 	}
@@ -66,3 +68,65 @@ func (s *Server) HandleDeleteUserMe(ctx *fiber.Ctx) error {
 	}
 	return ctx.SendStatus(200)
 }
+
+// HandleSearchUsers handles GET /users
+func (s *Server) HandleSearchUsers(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	u, err := s.userService.SearchUsers(uid, ctx.Query("q"))
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(u)
+}
+
+// HandleGetUserProfile handles GET /users/:username
+func (s *Server) HandleGetUserProfile(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	p, err := s.userService.GetUserProfile(uid, ctx.Params("username"))
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(p)
+}
+
+// HandleChangeUserMeEmail handles PUT /users/@me/email
+func (s *Server) HandleChangeUserMeEmail(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	var dto domain.ChangeEmailDTO
+	err = ctx.BodyParser(&dto)
+	if err != nil {
+		return fiber.ErrBadRequest
+	}
+	err = s.userService.ChangeEmail(uid, &dto)
+	if err != nil {
+		return err
+	}
+	return ctx.SendStatus(200)
+}
+
+// HandleVerifyUserMeEmail handles POST /users/@me/email/verify
+func (s *Server) HandleVerifyUserMeEmail(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	var dto domain.VerifyEmailDTO
+	err = ctx.BodyParser(&dto)
+	if err != nil {
+		return fiber.ErrBadRequest
+	}
+	u, err := s.userService.VerifyEmail(uid, &dto)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(u)
+}
@@ -0,0 +1,121 @@
+package server
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+	"io"
+	"strconv"
+)
+
+// HandleGetUserMeAgeVerification handles GET /users/@me/age-verification
+func (s *Server) HandleGetUserMeAgeVerification(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	v, err := s.ageVerificationService.GetUserAgeVerification(uid)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(v)
+}
+
+// HandleSubmitUserMeAgeVerification handles POST /users/@me/age-verification
+// The request is a multipart form with the birthdate and the document image.
+func (s *Server) HandleSubmitUserMeAgeVerification(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	fh, err := ctx.FormFile("document")
+	if err != nil {
+		return domain.ErrInvalidDocument
+	}
+	f, err := fh.Open()
+	if err != nil {
+		return domain.ErrInvalidDocument
+	}
+	defer f.Close()
+	// One byte more than allowed is read, so the service can tell a document that is too large.
+	document, err := io.ReadAll(io.LimitReader(f, domain.AgeVerificationDocumentMaxSize+1))
+	if err != nil {
+		return domain.ErrInvalidDocument
+	}
+	v, err := s.ageVerificationService.SubmitAgeVerification(uid, &domain.SubmitAgeVerificationDTO{
+		Birthdate: ctx.FormValue("birthdate"),
+		Document:  document,
+	})
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(v)
+}
+
+// HandleGetAgeVerifications handles GET /admin/age-verifications
+func (s *Server) HandleGetAgeVerifications(ctx *fiber.Ctx) error {
+	_, err := s.AdminAuth(ctx)
+	if err != nil {
+		return err
+	}
+	limit, _ := strconv.Atoi(ctx.Query("limit"))
+	v, err := s.ageVerificationService.GetAgeVerifications(&domain.AgeVerificationFilter{
+		Status: ctx.Query("status"),
+		UserID: ctx.Query("user_id"),
+		Before: ctx.Query("before"),
+		Limit:  limit,
+	})
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(v)
+}
+
+// HandleGetAgeVerification handles GET /admin/age-verifications/:id
+func (s *Server) HandleGetAgeVerification(ctx *fiber.Ctx) error {
+	_, err := s.AdminAuth(ctx)
+	if err != nil {
+		return err
+	}
+	v, err := s.ageVerificationService.GetAgeVerification(ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(v)
+}
+
+// HandleGetAgeVerificationDocument handles GET /admin/age-verifications/:id/document
+func (s *Server) HandleGetAgeVerificationDocument(ctx *fiber.Ctx) error {
+	_, err := s.AdminAuth(ctx)
+	if err != nil {
+		return err
+	}
+	v, err := s.ageVerificationService.GetAgeVerification(ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	// Documents are deleted once the verification was reviewed.
+	if len(v.Document) == 0 {
+		return fiber.ErrNotFound
+	}
+	ctx.Set(fiber.HeaderContentType, v.DocumentType)
+	ctx.Set(fiber.HeaderCacheControl, "no-store")
+	return ctx.Send(v.Document)
+}
+
+// HandleReviewAgeVerification handles POST /admin/age-verifications/:id/review
+func (s *Server) HandleReviewAgeVerification(ctx *fiber.Ctx) error {
+	uid, err := s.AdminAuth(ctx)
+	if err != nil {
+		return err
+	}
+	var dto domain.ReviewAgeVerificationDTO
+	err = ctx.BodyParser(&dto)
+	if err != nil {
+		return fiber.ErrBadRequest
+	}
+	v, err := s.ageVerificationService.ReviewAgeVerification(uid, ctx.Params("id"), &dto)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(v)
+}
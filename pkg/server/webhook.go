@@ -0,0 +1,83 @@
+package server
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+	"strconv"
+)
+
+// HandleGetWebhooks handles GET /meetups/:id/webhooks
+func (s *Server) HandleGetWebhooks(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	w, err := s.webhookService.GetWebhooks(uid, ctx.Params("id"))
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(w)
+}
+
+// HandleCreateWebhook handles POST /meetups/:id/webhooks
+func (s *Server) HandleCreateWebhook(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	var dto domain.CreateWebhookDTO
+	err = ctx.BodyParser(&dto)
+	if err != nil {
+		return fiber.ErrBadRequest
+	}
+	w, err := s.webhookService.CreateWebhook(uid, ctx.Params("id"), &dto)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(w)
+}
+
+// HandleUpdateWebhook handles PATCH /meetups/:id/webhooks/:webhookId
+func (s *Server) HandleUpdateWebhook(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	var dto domain.UpdateWebhookDTO
+	err = ctx.BodyParser(&dto)
+	if err != nil {
+		return fiber.ErrBadRequest
+	}
+	w, err := s.webhookService.UpdateWebhook(uid, ctx.Params("id"), ctx.Params("webhookId"), &dto)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(w)
+}
+
+// HandleDeleteWebhook handles DELETE /meetups/:id/webhooks/:webhookId
+func (s *Server) HandleDeleteWebhook(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	err = s.webhookService.DeleteWebhook(uid, ctx.Params("id"), ctx.Params("webhookId"))
+	if err != nil {
+		return err
+	}
+	return ctx.SendStatus(200)
+}
+
+// HandleGetWebhookDeliveries handles GET /meetups/:id/webhooks/:webhookId/deliveries
+func (s *Server) HandleGetWebhookDeliveries(ctx *fiber.Ctx) error {
+	uid, err := s.FirebaseAuth(ctx)
+	if err != nil {
+		return err
+	}
+	limit, _ := strconv.Atoi(ctx.Query("limit"))
+	d, err := s.webhookService.GetWebhookDeliveries(uid, ctx.Params("id"), ctx.Params("webhookId"), ctx.Query("before"), limit)
+	if err != nil {
+		return err
+	}
+	return ctx.JSON(d)
+}
@@ -0,0 +1,84 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+	"github.com/gofiber/websocket/v2"
+	"time"
+)
+
+// websocketPingInterval is the interval in which pings are sent to keep idle WebSocket connections alive.
+const websocketPingInterval = 30 * time.Second
+
+// commandHandler handles a real-time command sent by a client.
+type commandHandler func(cmd *domain.RealtimeCommand) error
+
+// serveWebSocket forwards the events of sub to conn and passes the commands read from conn to handle until either side closes.
+// All writes happen on the calling goroutine since WebSocket connections don't support concurrent writers.
+func serveWebSocket(conn *websocket.Conn, sub domain.Subscription, handle commandHandler) {
+	defer sub.Close()
+
+	replies := make(chan *domain.RealtimeEvent, 16)
+	reply := func(err error) {
+		select {
+		case replies <- commandError(err):
+		default:
+		}
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			_, msg, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			var cmd domain.RealtimeCommand
+			err = json.Unmarshal(msg, &cmd)
+			if err != nil {
+				reply(fiber.ErrBadRequest)
+				continue
+			}
+			err = handle(&cmd)
+			if err != nil {
+				reply(err)
+			}
+		}
+	}()
+
+	ping := time.NewTicker(websocketPingInterval)
+	defer ping.Stop()
+	for {
+		var err error
+		select {
+		case <-done:
+			return
+		case e, ok := <-sub.Events():
+			if !ok {
+				return
+			}
+			err = conn.WriteJSON(e)
+		case e := <-replies:
+			err = conn.WriteJSON(e)
+		case <-ping.C:
+			err = conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(websocketPingInterval))
+		}
+		if err != nil {
+			return
+		}
+	}
+}
+
+func commandError(err error) *domain.RealtimeEvent {
+	msg := fiber.ErrInternalServerError.Message
+	var fErr *fiber.Error
+	if errors.As(err, &fErr) {
+		msg = fErr.Message
+	}
+	return &domain.RealtimeEvent{
+		Type: domain.RealtimeEventError,
+		Data: msg,
+	}
+}
@@ -0,0 +1,78 @@
+package storage
+
+import (
+	gcs "cloud.google.com/go/storage"
+	"context"
+	firebase "firebase.google.com/go"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"strings"
+	"time"
+)
+
+// gcsCacheControl lets clients and CDNs cache files forever, files under a key never change.
+const gcsCacheControl = "public, max-age=31536000, immutable"
+
+type gcsStorage struct {
+	bucket  *gcs.BucketHandle
+	baseURL string
+}
+
+// NewGCSStorage creates a file storage writing to the Google Cloud Storage bucket of the Firebase project.
+// Without a base URL the files are served under the public URL of the bucket.
+func NewGCSStorage(fbApp *firebase.App, bucket string, baseURL string) domain.FileStorage {
+	client, err := fbApp.Storage(context.Background())
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Fatal("failed to create firebase storage client", zap.Error(err))
+	}
+	b, err := client.Bucket(bucket)
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Fatal("failed to open storage bucket", zap.String("bucket", bucket), zap.Error(err))
+	}
+	if len(baseURL) == 0 {
+		baseURL = "https://storage.googleapis.com/" + bucket
+	}
+	return &gcsStorage{
+		bucket:  b,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+func (s *gcsStorage) Put(key string, contentType string, data []byte) error {
+	c, ccl := context.WithTimeout(context.Background(), time.Second*30)
+	defer ccl()
+	w := s.bucket.Object(key).NewWriter(c)
+	w.ContentType = contentType
+	w.CacheControl = gcsCacheControl
+	_, err := w.Write(data)
+	// Closing the writer finishes the upload, its error has to be checked even if the write failed.
+	if cErr := w.Close(); err == nil {
+		err = cErr
+	}
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to store file", zap.String("key", key), zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (s *gcsStorage) Delete(key string) error {
+	c, ccl := context.WithTimeout(context.Background(), time.Second*10)
+	defer ccl()
+	err := s.bucket.Object(key).Delete(c)
+	if err != nil && err != gcs.ErrObjectNotExist {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to delete file", zap.String("key", key), zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (s *gcsStorage) URL(key string) string {
+	return s.baseURL + "/" + key
+}
@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+type localStorage struct {
+	dir     string
+	baseURL string
+}
+
+// NewLocalStorage creates a file storage writing to the local directory, the files have to be served under the base URL.
+func NewLocalStorage(dir string, baseURL string) domain.FileStorage {
+	return &localStorage{
+		dir:     dir,
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+	}
+}
+
+func (s *localStorage) Put(key string, contentType string, data []byte) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(filepath.Dir(p), 0755)
+	if err == nil {
+		err = os.WriteFile(p, data, 0644)
+	}
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to store file", zap.String("key", key), zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (s *localStorage) Delete(key string) error {
+	p, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	err = os.Remove(p)
+	if err != nil && !os.IsNotExist(err) {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to delete file", zap.String("key", key), zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (s *localStorage) URL(key string) string {
+	return s.baseURL + "/" + key
+}
+
+// path returns the path of the file stored under the key, keys can't point outside of the directory.
+func (s *localStorage) path(key string) (string, error) {
+	clean := path.Clean("/" + key)
+	if clean == "/" || clean != "/"+key {
+		zap.L().Error("invalid file key", zap.String("key", key))
+		return "", fiber.ErrInternalServerError
+	}
+	return filepath.Join(s.dir, filepath.FromSlash(clean)), nil
+}
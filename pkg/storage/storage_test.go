@@ -0,0 +1,36 @@
+package storage
+
+import (
+	"github.com/gofiber/fiber/v2"
+	"github.com/stretchr/testify/assert"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLocalStorage(t *testing.T) {
+	dir := t.TempDir()
+	s := NewLocalStorage(dir, "https://files.upmeet.app/")
+
+	// Put creates the directories
+	err := s.Put("avatars/1/a1/64.jpg", "image/jpeg", []byte("jpeg"))
+	assert.NoError(t, err)
+	data, err := os.ReadFile(filepath.Join(dir, "avatars", "1", "a1", "64.jpg"))
+	assert.NoError(t, err)
+	assert.Equal(t, []byte("jpeg"), data)
+	assert.Equal(t, "https://files.upmeet.app/avatars/1/a1/64.jpg", s.URL("avatars/1/a1/64.jpg"))
+
+	// Delete removes the file and ignores missing ones
+	err = s.Delete("avatars/1/a1/64.jpg")
+	assert.NoError(t, err)
+	_, err = os.Stat(filepath.Join(dir, "avatars", "1", "a1", "64.jpg"))
+	assert.True(t, os.IsNotExist(err))
+	err = s.Delete("avatars/1/a1/64.jpg")
+	assert.NoError(t, err)
+
+	// Keys can't point outside of the directory
+	err = s.Put("../64.jpg", "image/jpeg", []byte("jpeg"))
+	assert.ErrorIs(t, err, fiber.ErrInternalServerError)
+	err = s.Delete("avatars/../../64.jpg")
+	assert.ErrorIs(t, err, fiber.ErrInternalServerError)
+}
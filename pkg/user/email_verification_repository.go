@@ -0,0 +1,54 @@
+package user
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type emailVerificationRepository struct {
+	db *gorm.DB
+}
+
+// NewEmailVerificationRepository creates a new email verification repository instance.
+func NewEmailVerificationRepository(db *gorm.DB) domain.EmailVerificationRepository {
+	return &emailVerificationRepository{
+		db: db,
+	}
+}
+
+func (r *emailVerificationRepository) CreateEmailVerification(v *domain.EmailVerification) error {
+	err := r.db.Create(v).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to create email verification", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *emailVerificationRepository) GetEmailVerification(tokenHash string) (*domain.EmailVerification, error) {
+	v := &domain.EmailVerification{}
+	err := r.db.Where("token_hash = ?", tokenHash).First(v).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fiber.ErrNotFound
+		}
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get email verification", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return v, nil
+}
+
+func (r *emailVerificationRepository) DeleteEmailVerificationsByUserID(userID string) error {
+	err := r.db.Delete(&domain.EmailVerification{}, "user_id = ?", userID).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to delete email verifications", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
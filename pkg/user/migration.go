@@ -0,0 +1,22 @@
+package user
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"gorm.io/gorm"
+)
+
+// MigrateAgeToBirthdate moves the static age of users to their birthdate and drops the age column afterwards.
+// The exact birthdate is unknown, so it is estimated as today that many years ago which never makes a user older than they said.
+// It does nothing once the age column is gone.
+func MigrateAgeToBirthdate(db *gorm.DB) error {
+	if !db.Migrator().HasColumn(&domain.User{}, "age") {
+		return nil
+	}
+	return db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("UPDATE users SET birthdate = CURRENT_DATE - make_interval(years => age) WHERE age > 0 AND birthdate IS NULL").Error
+		if err != nil {
+			return err
+		}
+		return tx.Migrator().DropColumn(&domain.User{}, "age")
+	})
+}
@@ -2,12 +2,17 @@ package user
 
 import (
 	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/UpMeetApp/server/pkg/outbox"
 	"github.com/getsentry/sentry-go"
 	"github.com/gofiber/fiber/v2"
 	"go.uber.org/zap"
 	"gorm.io/gorm"
+	"strings"
 )
 
+// userStatusColumns are the columns only written by UpdateUserStatus.
+var userStatusColumns = []string{"status", "status_reason", "suspended_until"}
+
 type userRepository struct {
 	db *gorm.DB
 }
@@ -20,7 +25,13 @@ func NewUserRepository(db *gorm.DB) domain.UserRepository {
 }
 
 func (r *userRepository) CreateUser(u *domain.User) error {
-	err := r.db.Create(u).Error
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(u).Error
+		if err != nil {
+			return err
+		}
+		return outbox.Append(tx, domain.EventTypeUserCreated, u.ID, u)
+	})
 	if err != nil {
 		sentry.CaptureException(err)
 		zap.L().Error("failed to create user", zap.Error(err))
@@ -45,7 +56,7 @@ func (r *userRepository) GetUserByID(id string) (*domain.User, error) {
 
 func (r *userRepository) GetUserByEmail(email string) (*domain.User, error) {
 	u := &domain.User{}
-	err := r.db.Where("email = ?", email).First(u).Error
+	err := r.db.Where("email = ?", strings.ToLower(email)).First(u).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, fiber.ErrNotFound
@@ -73,7 +84,7 @@ func (r *userRepository) GetUserByUsername(username string) (*domain.User, error
 
 func (r *userRepository) SearchUsersByName(name string) ([]*domain.User, error) {
 	var users []*domain.User
-	err := r.db.Where("name LIKE ?", "%"+name+"%").Find(&users).Error
+	err := r.db.Where("name LIKE ? AND status <> ?", "%"+name+"%", domain.UserStatusBanned).Find(&users).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, fiber.ErrNotFound
@@ -87,7 +98,7 @@ func (r *userRepository) SearchUsersByName(name string) ([]*domain.User, error)
 
 func (r *userRepository) SearchUsersByUsername(username string) ([]*domain.User, error) {
 	var users []*domain.User
-	err := r.db.Where("username LIKE ?", "%"+username+"%").Find(&users).Error
+	err := r.db.Where("username LIKE ? AND status <> ?", "%"+username+"%", domain.UserStatusBanned).Find(&users).Error
 	if err != nil {
 		if err == gorm.ErrRecordNotFound {
 			return nil, fiber.ErrNotFound
@@ -99,8 +110,32 @@ func (r *userRepository) SearchUsersByUsername(username string) ([]*domain.User,
 	return users, nil
 }
 
+func (r *userRepository) GetUsersWithEmail(afterID string, limit int) ([]*domain.User, error) {
+	var users []*domain.User
+	err := r.db.Where("email <> '' AND email_verified AND id > ?", afterID).Order("id").Limit(limit).Find(&users).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get users with email", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return users, nil
+}
+
+// UpdateUser saves the user except for their status, which only UpdateUserStatus changes.
+// Otherwise a profile edit based on a user loaded before a moderator banned them would lift the ban again.
 func (r *userRepository) UpdateUser(u *domain.User) error {
-	err := r.db.Save(u).Error
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Omit(userStatusColumns...).Save(u).Error
+		if err != nil {
+			return err
+		}
+		// The event carries the status as it is stored, not the one the user was loaded with.
+		err = tx.Select(userStatusColumns).Where("id = ?", u.ID).Take(u).Error
+		if err != nil {
+			return err
+		}
+		return outbox.Append(tx, domain.EventTypeUserUpdated, u.ID, u)
+	})
 	if err != nil {
 		sentry.CaptureException(err)
 		zap.L().Error("failed to update user", zap.Error(err))
@@ -109,8 +144,59 @@ func (r *userRepository) UpdateUser(u *domain.User) error {
 	return nil
 }
 
+func (r *userRepository) UpdateUserStatus(u *domain.User) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(u).Select(userStatusColumns).Updates(u).Error
+		if err != nil {
+			return err
+		}
+		return outbox.Append(tx, domain.EventTypeUserUpdated, u.ID, u)
+	})
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to update user status", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
 func (r *userRepository) DeleteUser(id string) error {
-	err := r.db.Delete(&domain.User{}, "id = ?", id).Error
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		// Meetups of the user are deleted with their account, everything referring to them or the user goes first.
+		var meetupIDs []string
+		err := tx.Model(&domain.Meetup{}).Where("owner_id = ?", id).Pluck("id", &meetupIDs).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Exec("DELETE FROM participants WHERE user_id = ? OR meetup_id IN ?", id, meetupIDs).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("user_id = ? OR meetup_id IN ?", id, meetupIDs).Delete(&domain.Attendance{}).Error
+		if err != nil {
+			return err
+		}
+		err = tx.Where("user_id = ? OR meetup_id IN ?", id, meetupIDs).Delete(&domain.Invitation{}).Error
+		if err != nil {
+			return err
+		}
+		for _, meetupID := range meetupIDs {
+			err = tx.Delete(&domain.Meetup{}, "id = ?", meetupID).Error
+			if err != nil {
+				return err
+			}
+			err = outbox.Append(tx, domain.EventTypeMeetupDeleted, meetupID, &domain.DeletedEvent{ID: meetupID})
+			if err != nil {
+				return err
+			}
+		}
+
+		err = tx.Delete(&domain.User{}, "id = ?", id).Error
+		if err != nil {
+			return err
+		}
+		return outbox.Append(tx, domain.EventTypeUserDeleted, id, &domain.DeletedEvent{ID: id})
+	})
 	if err != nil {
 		sentry.CaptureException(err)
 		zap.L().Error("failed to delete user", zap.Error(err))
@@ -0,0 +1,95 @@
+package user
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+	"io"
+	"strings"
+	"testing"
+)
+
+// recordingConnector is a database connection that records every statement and answers queries with the given rows.
+type recordingConnector struct {
+	statements []string
+	// rows maps a part of a query to the single column values it returns.
+	rows map[string][]string
+}
+
+func (c *recordingConnector) Connect(context.Context) (driver.Conn, error) { return c, nil }
+func (c *recordingConnector) Driver() driver.Driver                        { return nil }
+func (c *recordingConnector) Prepare(query string) (driver.Stmt, error) {
+	return &recordingStmt{c: c, query: query}, nil
+}
+func (c *recordingConnector) Close() error              { return nil }
+func (c *recordingConnector) Begin() (driver.Tx, error) { return c, nil }
+func (c *recordingConnector) Commit() error             { return nil }
+func (c *recordingConnector) Rollback() error           { return nil }
+
+type recordingStmt struct {
+	c     *recordingConnector
+	query string
+}
+
+func (s *recordingStmt) Close() error  { return nil }
+func (s *recordingStmt) NumInput() int { return -1 }
+func (s *recordingStmt) Exec([]driver.Value) (driver.Result, error) {
+	s.c.statements = append(s.c.statements, s.query)
+	return driver.RowsAffected(1), nil
+}
+func (s *recordingStmt) Query([]driver.Value) (driver.Rows, error) {
+	s.c.statements = append(s.c.statements, s.query)
+	for part, values := range s.c.rows {
+		if strings.Contains(s.query, part) {
+			return &recordingRows{values: values}, nil
+		}
+	}
+	return &recordingRows{}, nil
+}
+
+type recordingRows struct {
+	values []string
+}
+
+func (r *recordingRows) Columns() []string { return []string{"id"} }
+func (r *recordingRows) Close() error      { return nil }
+func (r *recordingRows) Next(dest []driver.Value) error {
+	if len(r.values) == 0 {
+		return io.EOF
+	}
+	dest[0], r.values = r.values[0], r.values[1:]
+	return nil
+}
+
+// indexOf returns the index of the first statement containing the part, or -1.
+func (c *recordingConnector) indexOf(part string) int {
+	for i, statement := range c.statements {
+		if strings.Contains(statement, part) {
+			return i
+		}
+	}
+	return -1
+}
+
+func Test_userRepository_DeleteUser(t *testing.T) {
+	c := &recordingConnector{rows: map[string][]string{`FROM "meetups"`: {"m1"}}}
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sql.OpenDB(c)}), &gorm.Config{Logger: logger.Discard})
+	assert.NoError(t, err)
+	r := NewUserRepository(db)
+
+	// Everything referring to the user and their meetups is deleted before the user
+	err = r.DeleteUser("1")
+	assert.NoError(t, err)
+	deleteUser := c.indexOf(`DELETE FROM "users"`)
+	assert.NotEqual(t, -1, deleteUser)
+	for _, part := range []string{"DELETE FROM participants", `DELETE FROM "attendances"`, `DELETE FROM "invitations"`, `DELETE FROM "meetups"`} {
+		i := c.indexOf(part)
+		assert.NotEqual(t, -1, i, part)
+		assert.Less(t, i, deleteUser, part)
+	}
+	assert.Equal(t, 2, strings.Count(strings.Join(c.statements, "\n"), `INSERT INTO "events"`), "meetup and user deleted events")
+}
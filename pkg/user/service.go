@@ -1,19 +1,45 @@
 package user
 
 import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
 	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/UpMeetApp/server/pkg/email"
+	"github.com/getsentry/sentry-go"
 	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"net/mail"
+	"net/url"
+	"strings"
 	"time"
 )
 
 type userService struct {
-	userRepository domain.UserRepository
+	userRepository              domain.UserRepository
+	attendanceRepository        domain.AttendanceRepository
+	reviewRepository            domain.ReviewRepository
+	blockRepository             domain.BlockRepository
+	emailVerificationRepository domain.EmailVerificationRepository
+	contentFilter               domain.ContentFilter
+	avatarService               domain.AvatarService
+	emailSender                 domain.EmailSender
+	emailVerificationURL        string
 }
 
 // NewUserService creates a new user service instance.
-func NewUserService(userRepository domain.UserRepository) domain.UserService {
+// The token of email verifications is appended to the emailVerificationURL as the token query parameter.
+func NewUserService(userRepository domain.UserRepository, attendanceRepository domain.AttendanceRepository, reviewRepository domain.ReviewRepository, blockRepository domain.BlockRepository, emailVerificationRepository domain.EmailVerificationRepository, contentFilter domain.ContentFilter, avatarService domain.AvatarService, emailSender domain.EmailSender, emailVerificationURL string) domain.UserService {
 	return &userService{
-		userRepository: userRepository,
+		userRepository:              userRepository,
+		attendanceRepository:        attendanceRepository,
+		reviewRepository:            reviewRepository,
+		blockRepository:             blockRepository,
+		emailVerificationRepository: emailVerificationRepository,
+		contentFilter:               contentFilter,
+		avatarService:               avatarService,
+		emailSender:                 emailSender,
+		emailVerificationURL:        emailVerificationURL,
 	}
 }
 
@@ -21,6 +47,99 @@ func (s *userService) GetUserByID(uid string) (*domain.User, error) {
 	return s.userRepository.GetUserByID(uid)
 }
 
+func (s *userService) GetUserProfile(uid string, username string) (*domain.UserProfile, error) {
+	u, err := s.userRepository.GetUserByUsername(username)
+	if err != nil {
+		return nil, err
+	}
+	if u.Status == domain.UserStatusBanned {
+		return nil, fiber.ErrNotFound
+	}
+	// Blocked users look like they don't exist, so blocking can't be used to find out who blocked you.
+	if u.ID != uid {
+		blocked, err := s.blockRepository.IsBlocked(uid, u.ID)
+		if err != nil {
+			return nil, err
+		}
+		if blocked {
+			return nil, fiber.ErrNotFound
+		}
+	}
+
+	p := &domain.UserProfile{
+		ID:               u.ID,
+		Username:         u.Username,
+		Name:             u.Name,
+		ProfilePicture:   u.ProfilePicture,
+		AgeVerified:      u.AgeVerified,
+		Bio:              u.Bio,
+		InstagramProfile: u.InstagramProfile,
+		FacebookProfile:  u.FacebookProfile,
+		TwitterProfile:   u.TwitterProfile,
+		DiscordTag:       u.DiscordTag,
+		CreatedAt:        u.CreatedAt,
+	}
+	if age := u.Age(time.Now()); !u.AgePrivate && age > 0 {
+		p.Age = age
+	}
+	if !u.EmailPrivate && u.EmailVerified {
+		p.Email = u.Email
+	}
+	if !u.AttendancePrivate {
+		p.Attendance, err = s.attendanceRepository.GetAttendanceStatsByUserID(u.ID)
+		if err != nil {
+			return nil, err
+		}
+	}
+	p.HostRating, err = s.reviewRepository.GetRatingSummaryByHostID(u.ID)
+	if err != nil {
+		return nil, err
+	}
+	if p.HostRating.Count == 0 {
+		p.HostRating = nil
+	}
+	return p, nil
+}
+
+func (s *userService) SearchUsers(uid string, query string) ([]*domain.UserPreview, error) {
+	query = strings.TrimSpace(query)
+	if len(query) < domain.UserSearchQueryMinLength {
+		return nil, domain.ErrInvalidSearchQuery
+	}
+	byUsername, err := s.userRepository.SearchUsersByUsername(query)
+	if err != nil && err != fiber.ErrNotFound {
+		return nil, err
+	}
+	byName, err := s.userRepository.SearchUsersByName(query)
+	if err != nil && err != fiber.ErrNotFound {
+		return nil, err
+	}
+	blockedIDs, err := s.blockRepository.GetBlockedUserIDs(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	// Username matches come first, users matching both are only listed once.
+	skip := make(map[string]bool, len(blockedIDs))
+	for _, id := range blockedIDs {
+		skip[id] = true
+	}
+	results := make([]*domain.UserPreview, 0)
+	for _, u := range append(byUsername, byName...) {
+		if skip[u.ID] {
+			continue
+		}
+		skip[u.ID] = true
+		results = append(results, &domain.UserPreview{
+			ID:             u.ID,
+			Username:       u.Username,
+			Name:           u.Name,
+			ProfilePicture: u.ProfilePicture,
+		})
+	}
+	return results, nil
+}
+
 func (s *userService) CreateUser(uid string, dto *domain.CreateUserDTO) (*domain.User, error) {
 	_, err := s.userRepository.GetUserByID(uid)
 	if err != fiber.ErrNotFound {
@@ -36,6 +155,14 @@ func (s *userService) CreateUser(uid string, dto *domain.CreateUserDTO) (*domain
 	if len(dto.Name) > domain.UserNameMaxLength {
 		return nil, domain.ErrInvalidName
 	}
+	err = s.contentFilter.CheckUsername(dto.Username)
+	if err != nil {
+		return nil, err
+	}
+	err = s.contentFilter.CheckText(dto.Name)
+	if err != nil {
+		return nil, err
+	}
 
 	_, err = s.userRepository.GetUserByUsername(dto.Username)
 	if err != fiber.ErrNotFound {
@@ -46,10 +173,23 @@ func (s *userService) CreateUser(uid string, dto *domain.CreateUserDTO) (*domain
 	}
 
 	u := &domain.User{
-		ID:        uid,
-		Name:      dto.Name,
-		Username:  dto.Username,
-		CreatedAt: time.Now(),
+		ID:           uid,
+		Name:         dto.Name,
+		Username:     dto.Username,
+		EmailPrivate: true,
+		CreatedAt:    time.Now(),
+	}
+
+	// The email address of the auth provider is only taken over if it was verified there and is not used by another account.
+	if e, ok := normalizeEmail(dto.Email); ok && dto.EmailVerified {
+		_, err = s.userRepository.GetUserByEmail(e)
+		if err != nil && err != fiber.ErrNotFound {
+			return nil, err
+		}
+		if err == fiber.ErrNotFound {
+			u.Email = e
+			u.EmailVerified = true
+		}
 	}
 
 	err = s.userRepository.CreateUser(u)
@@ -67,6 +207,10 @@ func (s *userService) UpdateUser(uid string, dto *domain.UpdateUserDTO) (*domain
 		if len(dto.Username) < domain.UsernameMinLength || len(dto.Username) > domain.UsernameMaxLength {
 			return nil, domain.ErrInvalidUsername
 		}
+		err = s.contentFilter.CheckUsername(dto.Username)
+		if err != nil {
+			return nil, err
+		}
 		_, err = s.userRepository.GetUserByUsername(dto.Username)
 		if err != fiber.ErrNotFound {
 			if err != nil {
@@ -82,6 +226,10 @@ func (s *userService) UpdateUser(uid string, dto *domain.UpdateUserDTO) (*domain
 		if len(dto.Name) > domain.UserNameMaxLength {
 			return nil, domain.ErrInvalidName
 		}
+		err = s.contentFilter.CheckText(dto.Name)
+		if err != nil {
+			return nil, err
+		}
 		u.Name = dto.Name
 	}
 
@@ -90,15 +238,24 @@ func (s *userService) UpdateUser(uid string, dto *domain.UpdateUserDTO) (*domain
 		if len(dto.Bio) > domain.UserBioMaxLength {
 			return nil, domain.ErrInvalidBio
 		}
+		err = s.contentFilter.CheckText(dto.Bio)
+		if err != nil {
+			return nil, err
+		}
 		u.Bio = dto.Bio
 	}
 
-	// Update Age
-	if dto.Age > 0 {
-		if dto.Age > domain.UserMaxAge {
-			return nil, domain.ErrInvalidAge
+	// Update Birthdate
+	if len(dto.Birthdate) > 0 {
+		birthdate, err := domain.ParseBirthdate(dto.Birthdate, time.Now())
+		if err != nil {
+			return nil, err
 		}
-		u.Age = dto.Age
+		// A verified birthdate is final.
+		if u.AgeVerified && (u.Birthdate == nil || !birthdate.Equal(*u.Birthdate)) {
+			return nil, domain.ErrAgeAlreadyVerified
+		}
+		u.Birthdate = &birthdate
 	}
 
 	// Update Age private
@@ -106,6 +263,17 @@ func (s *userService) UpdateUser(uid string, dto *domain.UpdateUserDTO) (*domain
 		u.AgePrivate = dto.AgePrivate
 	}
 
+	// Update Attendance private
+	if dto.AttendancePrivate != u.AttendancePrivate {
+		u.AttendancePrivate = dto.AttendancePrivate
+	}
+
+	// Update Email private
+	// Unlike the other privacy flags it is only changed when it is sent, so an update can't accidentally make the address public.
+	if dto.EmailPrivate != nil {
+		u.EmailPrivate = *dto.EmailPrivate
+	}
+
 	err = s.userRepository.UpdateUser(u)
 	if err != nil {
 		return nil, err
@@ -114,9 +282,162 @@ func (s *userService) UpdateUser(uid string, dto *domain.UpdateUserDTO) (*domain
 }
 
 func (s *userService) DeleteUser(uid string) error {
-	_, err := s.userRepository.GetUserByID(uid)
+	u, err := s.userRepository.GetUserByID(uid)
+	if err != nil {
+		return err
+	}
+	err = s.userRepository.DeleteUser(uid)
 	if err != nil {
 		return err
 	}
-	return s.userRepository.DeleteUser(uid)
+	// The account is already gone, so avatar files that couldn't be deleted are only logged.
+	_ = s.avatarService.DeleteAvatarFiles(uid, u.AvatarID)
+	return nil
+}
+
+func (s *userService) CheckUserStatus(uid string) error {
+	u, err := s.userRepository.GetUserByID(uid)
+	if err != nil {
+		// Users who didn't create their account yet have no status to check.
+		if err == fiber.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+
+	now := time.Now()
+	switch {
+	case u.Status == domain.UserStatusBanned:
+		return &domain.UserStatusError{Status: u.Status, Reason: u.StatusReason}
+	case u.IsSuspended(now):
+		return &domain.UserStatusError{Status: u.Status, Reason: u.StatusReason, SuspendedUntil: u.SuspendedUntil}
+	case u.Status == domain.UserStatusSuspended:
+		// The suspension is over, the user is active again.
+		u.Status = domain.UserStatusActive
+		u.StatusReason = ""
+		u.SuspendedUntil = nil
+		return s.userRepository.UpdateUserStatus(u)
+	}
+	return nil
+}
+
+func (s *userService) ChangeEmail(uid string, dto *domain.ChangeEmailDTO) error {
+	e, ok := normalizeEmail(dto.Email)
+	if !ok {
+		return domain.ErrInvalidEmail
+	}
+	u, err := s.userRepository.GetUserByID(uid)
+	if err != nil {
+		return err
+	}
+	err = s.checkEmailAvailable(uid, e)
+	if err != nil {
+		return err
+	}
+
+	b := make([]byte, 32)
+	_, err = rand.Read(b)
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to generate email verification token", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	token := hex.EncodeToString(b)
+
+	now := time.Now()
+	err = s.emailVerificationRepository.CreateEmailVerification(&domain.EmailVerification{
+		TokenHash: hashToken(token),
+		UserID:    uid,
+		Email:     e,
+		ExpiresAt: now.Add(domain.EmailVerificationTTL),
+		CreatedAt: now,
+	})
+	if err != nil {
+		return err
+	}
+
+	m, err := email.Render(domain.EmailTemplateEmailVerification, &domain.EmailData{
+		User: u,
+		URL:  s.emailVerificationURL + "?token=" + url.QueryEscape(token),
+	})
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to render email verification", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	// The email goes to the new address which is not stored on the user until it is verified.
+	m.To = e
+	err = s.emailSender.Send(m)
+	if err != nil {
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (s *userService) VerifyEmail(uid string, dto *domain.VerifyEmailDTO) (*domain.User, error) {
+	v, err := s.emailVerificationRepository.GetEmailVerification(hashToken(dto.Token))
+	if err != nil {
+		if err == fiber.ErrNotFound {
+			return nil, domain.ErrInvalidEmailVerificationToken
+		}
+		return nil, err
+	}
+	if v.UserID != uid || time.Now().After(v.ExpiresAt) {
+		return nil, domain.ErrInvalidEmailVerificationToken
+	}
+
+	u, err := s.userRepository.GetUserByID(uid)
+	if err != nil {
+		return nil, err
+	}
+	// Another user may have verified the same address since the verification was requested.
+	err = s.checkEmailAvailable(uid, v.Email)
+	if err != nil {
+		return nil, err
+	}
+
+	u.Email = v.Email
+	u.EmailVerified = true
+	err = s.userRepository.UpdateUser(u)
+	if err != nil {
+		return nil, err
+	}
+	err = s.emailVerificationRepository.DeleteEmailVerificationsByUserID(uid)
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// checkEmailAvailable returns domain.ErrEmailTaken if the email address belongs to another user.
+func (s *userService) checkEmailAvailable(uid string, email string) error {
+	u, err := s.userRepository.GetUserByEmail(email)
+	if err == fiber.ErrNotFound {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if u.ID != uid {
+		return domain.ErrEmailTaken
+	}
+	return nil
+}
+
+// normalizeEmail returns the lower-cased address if it is a valid plain email address (without a display name).
+func normalizeEmail(e string) (string, bool) {
+	if len(e) == 0 || len(e) > domain.UserEmailMaxLength {
+		return "", false
+	}
+	addr, err := mail.ParseAddress(e)
+	if err != nil || addr.Address != e {
+		return "", false
+	}
+	return strings.ToLower(e), true
+}
+
+// hashToken returns the hex encoded SHA-256 hash of a token.
+func hashToken(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
 }
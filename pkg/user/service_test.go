@@ -6,7 +6,9 @@ import (
 	"github.com/gofiber/fiber/v2"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"strings"
 	"testing"
+	"time"
 )
 
 func Test_userService_GetUserByID(t *testing.T) {
@@ -19,7 +21,7 @@ func Test_userService_GetUserByID(t *testing.T) {
 	repo.EXPECT().GetUserByID(gomock.Eq(uid1)).Return(&domain.User{ID: uid1}, nil)
 	repo.EXPECT().GetUserByID(gomock.Eq(uid2)).Return(nil, fiber.ErrNotFound)
 
-	s := NewUserService(repo)
+	s := NewUserService(repo, mock.NewMockAttendanceRepository(ctrl), mock.NewMockReviewRepository(ctrl), mock.NewMockBlockRepository(ctrl), mock.NewMockEmailVerificationRepository(ctrl), mock.NewMockContentFilter(ctrl), mock.NewMockAvatarService(ctrl), mock.NewMockEmailSender(ctrl), "")
 
 	u, err := s.GetUserByID(uid1)
 	assert.NoError(t, err)
@@ -30,10 +32,144 @@ func Test_userService_GetUserByID(t *testing.T) {
 	assert.Nil(t, u)
 }
 
+func Test_userService_GetUserProfile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockUserRepository(ctrl)
+	attendanceRepo := mock.NewMockAttendanceRepository(ctrl)
+	reviewRepo := mock.NewMockReviewRepository(ctrl)
+	blockRepo := mock.NewMockBlockRepository(ctrl)
+	s := NewUserService(repo, attendanceRepo, reviewRepo, blockRepo, mock.NewMockEmailVerificationRepository(ctrl), mock.NewMockContentFilter(ctrl), mock.NewMockAvatarService(ctrl), mock.NewMockEmailSender(ctrl), "")
+
+	uid := "1"
+
+	// GetUserByUsername returns error
+	repo.EXPECT().GetUserByUsername(gomock.Eq("test")).Return(nil, fiber.ErrNotFound)
+	p, err := s.GetUserProfile(uid, "test")
+	assert.ErrorIs(t, err, fiber.ErrNotFound)
+	assert.Nil(t, p)
+
+	// Banned user looks like it doesn't exist
+	repo.EXPECT().GetUserByUsername(gomock.Eq("test")).Return(&domain.User{ID: "2", Username: "test", Status: domain.UserStatusBanned}, nil)
+	p, err = s.GetUserProfile(uid, "test")
+	assert.ErrorIs(t, err, fiber.ErrNotFound)
+	assert.Nil(t, p)
+
+	// Blocked user looks like it doesn't exist
+	repo.EXPECT().GetUserByUsername(gomock.Eq("test")).Return(&domain.User{ID: "2", Username: "test"}, nil)
+	blockRepo.EXPECT().IsBlocked(gomock.Eq(uid), gomock.Eq("2")).Return(true, nil)
+	p, err = s.GetUserProfile(uid, "test")
+	assert.ErrorIs(t, err, fiber.ErrNotFound)
+	assert.Nil(t, p)
+
+	// Private age, email and attendance are hidden
+	birthdate := time.Now().AddDate(-19, 0, -1)
+	repo.EXPECT().GetUserByUsername(gomock.Eq("test")).Return(&domain.User{ID: "2", Username: "test", Birthdate: &birthdate, AgePrivate: true, AttendancePrivate: true, Email: "test@upmeet.app", EmailVerified: true, EmailPrivate: true}, nil)
+	blockRepo.EXPECT().IsBlocked(gomock.Eq(uid), gomock.Eq("2")).Return(false, nil)
+	reviewRepo.EXPECT().GetRatingSummaryByHostID(gomock.Eq("2")).Return(&domain.RatingSummary{}, nil)
+	p, err = s.GetUserProfile(uid, "test")
+	assert.NoError(t, err)
+	assert.Equal(t, "2", p.ID)
+	assert.Zero(t, p.Age)
+	assert.Empty(t, p.Email)
+	assert.Nil(t, p.Attendance)
+	assert.Nil(t, p.HostRating)
+
+	// GetAttendanceStatsByUserID returns error
+	repo.EXPECT().GetUserByUsername(gomock.Eq("test")).Return(&domain.User{ID: "2", Username: "test"}, nil)
+	blockRepo.EXPECT().IsBlocked(gomock.Eq(uid), gomock.Eq("2")).Return(false, nil)
+	attendanceRepo.EXPECT().GetAttendanceStatsByUserID(gomock.Eq("2")).Return(nil, fiber.ErrInternalServerError)
+	p, err = s.GetUserProfile(uid, "test")
+	assert.ErrorIs(t, err, fiber.ErrInternalServerError)
+	assert.Nil(t, p)
+
+	// Public age, email and attendance are shown
+	stats := &domain.AttendanceStats{Joined: 4, Attended: 3, Reliability: 0.75}
+	repo.EXPECT().GetUserByUsername(gomock.Eq("test")).Return(&domain.User{ID: "2", Username: "test", Birthdate: &birthdate, Email: "test@upmeet.app", EmailVerified: true}, nil)
+	blockRepo.EXPECT().IsBlocked(gomock.Eq(uid), gomock.Eq("2")).Return(false, nil)
+	attendanceRepo.EXPECT().GetAttendanceStatsByUserID(gomock.Eq("2")).Return(stats, nil)
+	reviewRepo.EXPECT().GetRatingSummaryByHostID(gomock.Eq("2")).Return(&domain.RatingSummary{Count: 2, Average: 4.5}, nil)
+	p, err = s.GetUserProfile(uid, "test")
+	assert.NoError(t, err)
+	assert.Equal(t, 19, p.Age)
+	assert.Equal(t, "test@upmeet.app", p.Email)
+	assert.Equal(t, stats, p.Attendance)
+	assert.Equal(t, 4.5, p.HostRating.Average)
+}
+
+func Test_userService_SearchUsers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockUserRepository(ctrl)
+	blockRepo := mock.NewMockBlockRepository(ctrl)
+	s := NewUserService(repo, mock.NewMockAttendanceRepository(ctrl), mock.NewMockReviewRepository(ctrl), blockRepo, mock.NewMockEmailVerificationRepository(ctrl), mock.NewMockContentFilter(ctrl), mock.NewMockAvatarService(ctrl), mock.NewMockEmailSender(ctrl), "")
+
+	uid := "1"
+
+	// Query too short
+	u, err := s.SearchUsers(uid, " a ")
+	assert.ErrorIs(t, err, domain.ErrInvalidSearchQuery)
+	assert.Nil(t, u)
+
+	// Blocked users are left out and users matching twice are only listed once
+	repo.EXPECT().SearchUsersByUsername(gomock.Eq("te")).Return([]*domain.User{{ID: "2", Username: "test"}, {ID: "3", Username: "tea"}}, nil)
+	repo.EXPECT().SearchUsersByName(gomock.Eq("te")).Return([]*domain.User{{ID: "2", Username: "test"}, {ID: "4", Name: "Ted"}}, nil)
+	blockRepo.EXPECT().GetBlockedUserIDs(gomock.Eq(uid)).Return([]string{"3"}, nil)
+	u, err = s.SearchUsers(uid, "te")
+	assert.NoError(t, err)
+	assert.Len(t, u, 2)
+	assert.Equal(t, "2", u[0].ID)
+	assert.Equal(t, "4", u[1].ID)
+}
+
+func Test_userService_CheckUserStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockUserRepository(ctrl)
+	s := NewUserService(repo, mock.NewMockAttendanceRepository(ctrl), mock.NewMockReviewRepository(ctrl), mock.NewMockBlockRepository(ctrl), mock.NewMockEmailVerificationRepository(ctrl), mock.NewMockContentFilter(ctrl), mock.NewMockAvatarService(ctrl), mock.NewMockEmailSender(ctrl), "")
+
+	uid := "1"
+
+	// User without account
+	repo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(nil, fiber.ErrNotFound)
+	err := s.CheckUserStatus(uid)
+	assert.NoError(t, err)
+
+	// Active user
+	repo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{ID: uid, Status: domain.UserStatusActive}, nil)
+	err = s.CheckUserStatus(uid)
+	assert.NoError(t, err)
+
+	// Banned user
+	repo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{ID: uid, Status: domain.UserStatusBanned, StatusReason: "scam"}, nil)
+	err = s.CheckUserStatus(uid)
+	assert.Equal(t, &domain.UserStatusError{Status: domain.UserStatusBanned, Reason: "scam"}, err)
+
+	// Suspended user
+	until := time.Now().Add(time.Hour)
+	repo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{ID: uid, Status: domain.UserStatusSuspended, StatusReason: "spam", SuspendedUntil: &until}, nil)
+	err = s.CheckUserStatus(uid)
+	assert.Equal(t, &domain.UserStatusError{Status: domain.UserStatusSuspended, Reason: "spam", SuspendedUntil: &until}, err)
+	assert.EqualError(t, err, "user-suspended")
+
+	// Expired suspension is lifted
+	expired := time.Now().Add(-time.Minute)
+	repo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{ID: uid, Status: domain.UserStatusSuspended, StatusReason: "spam", SuspendedUntil: &expired}, nil)
+	repo.EXPECT().UpdateUserStatus(gomock.Any()).DoAndReturn(func(u *domain.User) error {
+		assert.Equal(t, domain.UserStatusActive, u.Status)
+		assert.Empty(t, u.StatusReason)
+		assert.Nil(t, u.SuspendedUntil)
+		return nil
+	})
+	err = s.CheckUserStatus(uid)
+	assert.NoError(t, err)
+}
+
 func Test_userService_CreateUser(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	repo := mock.NewMockUserRepository(ctrl)
-	s := NewUserService(repo)
+	contentFilter := mock.NewMockContentFilter(ctrl)
+	contentFilter.EXPECT().CheckUsername(gomock.Eq("admin")).Return(domain.ErrReservedUsername)
+	contentFilter.EXPECT().CheckUsername(gomock.Any()).Return(nil).AnyTimes()
+	contentFilter.EXPECT().CheckText(gomock.Any()).Return(nil).AnyTimes()
+	s := NewUserService(repo, mock.NewMockAttendanceRepository(ctrl), mock.NewMockReviewRepository(ctrl), mock.NewMockBlockRepository(ctrl), mock.NewMockEmailVerificationRepository(ctrl), contentFilter, mock.NewMockAvatarService(ctrl), mock.NewMockEmailSender(ctrl), "")
 
 	uid := "1"
 
@@ -78,6 +214,16 @@ func Test_userService_CreateUser(t *testing.T) {
 	assert.ErrorIs(t, err, domain.ErrInvalidName)
 	assert.Nil(t, u)
 
+	// Reserved username
+	dto = &domain.CreateUserDTO{
+		Name:     "test",
+		Username: "admin",
+	}
+	repo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(nil, fiber.ErrNotFound)
+	u, err = s.CreateUser(uid, dto)
+	assert.ErrorIs(t, err, domain.ErrReservedUsername)
+	assert.Nil(t, u)
+
 	// GetByUsername returns error
 	dto = &domain.CreateUserDTO{
 		Name:     "test",
@@ -116,12 +262,53 @@ func Test_userService_CreateUser(t *testing.T) {
 	assert.Equal(t, u.Name, dto.Name)
 	assert.Equal(t, u.Username, dto.Username)
 	assert.NotNil(t, u.CreatedAt)
+	assert.Empty(t, u.Email)
+	assert.True(t, u.EmailPrivate)
+
+	// Verified email of the auth provider is taken over
+	dto = &domain.CreateUserDTO{
+		Name:          "test",
+		Username:      "test",
+		Email:         "Test@UpMeet.app",
+		EmailVerified: true,
+	}
+	repo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(nil, fiber.ErrNotFound)
+	repo.EXPECT().GetUserByUsername(gomock.Eq(dto.Username)).Return(nil, fiber.ErrNotFound)
+	repo.EXPECT().GetUserByEmail(gomock.Eq("test@upmeet.app")).Return(nil, fiber.ErrNotFound)
+	repo.EXPECT().CreateUser(gomock.Any()).Return(nil)
+	u, err = s.CreateUser(uid, dto)
+	assert.NoError(t, err)
+	assert.Equal(t, "test@upmeet.app", u.Email)
+	assert.True(t, u.EmailVerified)
+
+	// Email of the auth provider used by another user is ignored
+	repo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(nil, fiber.ErrNotFound)
+	repo.EXPECT().GetUserByUsername(gomock.Eq(dto.Username)).Return(nil, fiber.ErrNotFound)
+	repo.EXPECT().GetUserByEmail(gomock.Eq("test@upmeet.app")).Return(&domain.User{ID: "2"}, nil)
+	repo.EXPECT().CreateUser(gomock.Any()).Return(nil)
+	u, err = s.CreateUser(uid, dto)
+	assert.NoError(t, err)
+	assert.Empty(t, u.Email)
+	assert.False(t, u.EmailVerified)
+
+	// Unverified email of the auth provider is ignored
+	dto.EmailVerified = false
+	repo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(nil, fiber.ErrNotFound)
+	repo.EXPECT().GetUserByUsername(gomock.Eq(dto.Username)).Return(nil, fiber.ErrNotFound)
+	repo.EXPECT().CreateUser(gomock.Any()).Return(nil)
+	u, err = s.CreateUser(uid, dto)
+	assert.NoError(t, err)
+	assert.Empty(t, u.Email)
 }
 
 func Test_userService_UpdateUser(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	repo := mock.NewMockUserRepository(ctrl)
-	s := NewUserService(repo)
+	contentFilter := mock.NewMockContentFilter(ctrl)
+	contentFilter.EXPECT().CheckText(gomock.Eq("badword")).Return(domain.ErrInappropriateContent)
+	contentFilter.EXPECT().CheckUsername(gomock.Any()).Return(nil).AnyTimes()
+	contentFilter.EXPECT().CheckText(gomock.Any()).Return(nil).AnyTimes()
+	s := NewUserService(repo, mock.NewMockAttendanceRepository(ctrl), mock.NewMockReviewRepository(ctrl), mock.NewMockBlockRepository(ctrl), mock.NewMockEmailVerificationRepository(ctrl), contentFilter, mock.NewMockAvatarService(ctrl), mock.NewMockEmailSender(ctrl), "")
 
 	uid := "1"
 
@@ -211,6 +398,15 @@ func Test_userService_UpdateUser(t *testing.T) {
 	assert.ErrorIs(t, err, domain.ErrInvalidBio)
 	assert.Nil(t, u)
 
+	// Bio with a blocked word
+	dto = &domain.UpdateUserDTO{
+		Bio: "badword",
+	}
+	repo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{}, nil)
+	u, err = s.UpdateUser(uid, dto)
+	assert.ErrorIs(t, err, domain.ErrInappropriateContent)
+	assert.Nil(t, u)
+
 	// Bio updated
 	dto = &domain.UpdateUserDTO{
 		Bio: "test",
@@ -222,25 +418,35 @@ func Test_userService_UpdateUser(t *testing.T) {
 	assert.NotNil(t, u)
 	assert.Equal(t, u.Bio, dto.Bio)
 
-	// Age invalid
+	// Birthdate invalid
 	dto = &domain.UpdateUserDTO{
-		Age: 420,
+		Birthdate: "1900-01-01",
 	}
 	repo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{}, nil)
 	u, err = s.UpdateUser(uid, dto)
-	assert.ErrorIs(t, err, domain.ErrInvalidAge)
+	assert.ErrorIs(t, err, domain.ErrInvalidBirthdate)
 	assert.Nil(t, u)
 
-	// Age updated
+	// Birthdate can't be changed once verified
+	verified := time.Date(2003, 4, 1, 0, 0, 0, 0, time.UTC)
 	dto = &domain.UpdateUserDTO{
-		Age: 19,
+		Birthdate: "2000-04-01",
+	}
+	repo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{Birthdate: &verified, AgeVerified: true, VerifiedBirthdate: &verified}, nil)
+	u, err = s.UpdateUser(uid, dto)
+	assert.ErrorIs(t, err, domain.ErrAgeAlreadyVerified)
+	assert.Nil(t, u)
+
+	// Birthdate updated
+	dto = &domain.UpdateUserDTO{
+		Birthdate: "2003-04-01",
 	}
 	repo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{}, nil)
 	repo.EXPECT().UpdateUser(gomock.Any()).Return(nil)
 	u, err = s.UpdateUser(uid, dto)
 	assert.NoError(t, err)
 	assert.NotNil(t, u)
-	assert.Equal(t, u.Age, dto.Age)
+	assert.Equal(t, verified, *u.Birthdate)
 
 	// AgePrivate updated
 	dto = &domain.UpdateUserDTO{
@@ -253,6 +459,36 @@ func Test_userService_UpdateUser(t *testing.T) {
 	assert.NotNil(t, u)
 	assert.Equal(t, u.AgePrivate, dto.AgePrivate)
 
+	// AttendancePrivate updated
+	dto = &domain.UpdateUserDTO{
+		AttendancePrivate: true,
+	}
+	repo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{}, nil)
+	repo.EXPECT().UpdateUser(gomock.Any()).Return(nil)
+	u, err = s.UpdateUser(uid, dto)
+	assert.NoError(t, err)
+	assert.NotNil(t, u)
+	assert.Equal(t, u.AttendancePrivate, dto.AttendancePrivate)
+
+	// EmailPrivate is kept when not sent
+	dto = &domain.UpdateUserDTO{}
+	repo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{EmailPrivate: true}, nil)
+	repo.EXPECT().UpdateUser(gomock.Any()).Return(nil)
+	u, err = s.UpdateUser(uid, dto)
+	assert.NoError(t, err)
+	assert.True(t, u.EmailPrivate)
+
+	// EmailPrivate updated
+	emailPrivate := false
+	dto = &domain.UpdateUserDTO{
+		EmailPrivate: &emailPrivate,
+	}
+	repo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{EmailPrivate: true}, nil)
+	repo.EXPECT().UpdateUser(gomock.Any()).Return(nil)
+	u, err = s.UpdateUser(uid, dto)
+	assert.NoError(t, err)
+	assert.False(t, u.EmailPrivate)
+
 	// UpdateUser returns error
 	dto = &domain.UpdateUserDTO{}
 	repo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{}, nil)
@@ -273,7 +509,8 @@ func Test_userService_UpdateUser(t *testing.T) {
 func Test_userService_DeleteUser(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	repo := mock.NewMockUserRepository(ctrl)
-	s := NewUserService(repo)
+	avatarService := mock.NewMockAvatarService(ctrl)
+	s := NewUserService(repo, mock.NewMockAttendanceRepository(ctrl), mock.NewMockReviewRepository(ctrl), mock.NewMockBlockRepository(ctrl), mock.NewMockEmailVerificationRepository(ctrl), mock.NewMockContentFilter(ctrl), avatarService, mock.NewMockEmailSender(ctrl), "")
 
 	uid := "1"
 
@@ -288,9 +525,97 @@ func Test_userService_DeleteUser(t *testing.T) {
 	err = s.DeleteUser(uid)
 	assert.ErrorIs(t, err, fiber.ErrInternalServerError)
 
-	// DeleteUser successful
-	repo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{}, nil)
+	// DeleteUser successful and the avatar files are deleted
+	repo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{ID: uid, AvatarID: "a1"}, nil)
 	repo.EXPECT().DeleteUser(gomock.Eq(uid)).Return(nil)
+	avatarService.EXPECT().DeleteAvatarFiles(gomock.Eq(uid), gomock.Eq("a1")).Return(fiber.ErrInternalServerError)
 	err = s.DeleteUser(uid)
 	assert.NoError(t, err)
 }
+
+func Test_userService_ChangeEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockUserRepository(ctrl)
+	verificationRepo := mock.NewMockEmailVerificationRepository(ctrl)
+	emailSender := mock.NewMockEmailSender(ctrl)
+	s := NewUserService(repo, mock.NewMockAttendanceRepository(ctrl), mock.NewMockReviewRepository(ctrl), mock.NewMockBlockRepository(ctrl), verificationRepo, mock.NewMockContentFilter(ctrl), mock.NewMockAvatarService(ctrl), emailSender, "https://upmeet.app/verify-email")
+
+	uid := "1"
+
+	// Invalid email
+	err := s.ChangeEmail(uid, &domain.ChangeEmailDTO{Email: "Test <test@upmeet.app>"})
+	assert.ErrorIs(t, err, domain.ErrInvalidEmail)
+
+	// Email taken
+	repo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{ID: uid}, nil)
+	repo.EXPECT().GetUserByEmail(gomock.Eq("test@upmeet.app")).Return(&domain.User{ID: "2"}, nil)
+	err = s.ChangeEmail(uid, &domain.ChangeEmailDTO{Email: "test@upmeet.app"})
+	assert.ErrorIs(t, err, domain.ErrEmailTaken)
+
+	// ChangeEmail successful
+	var verification *domain.EmailVerification
+	repo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{ID: uid, Name: "Test", Email: "old@upmeet.app"}, nil)
+	repo.EXPECT().GetUserByEmail(gomock.Eq("new@upmeet.app")).Return(nil, fiber.ErrNotFound)
+	verificationRepo.EXPECT().CreateEmailVerification(gomock.Any()).DoAndReturn(func(v *domain.EmailVerification) error {
+		verification = v
+		return nil
+	})
+	emailSender.EXPECT().Send(gomock.Any()).DoAndReturn(func(e *domain.Email) error {
+		assert.Equal(t, "new@upmeet.app", e.To)
+		assert.Contains(t, e.Text, "https://upmeet.app/verify-email?token=")
+		token := e.Text[strings.Index(e.Text, "token=")+len("token=") : strings.Index(e.Text, "token=")+len("token=")+64]
+		assert.Equal(t, hashToken(token), verification.TokenHash)
+		return nil
+	})
+	err = s.ChangeEmail(uid, &domain.ChangeEmailDTO{Email: "New@upmeet.app"})
+	assert.NoError(t, err)
+	assert.Equal(t, uid, verification.UserID)
+	assert.Equal(t, "new@upmeet.app", verification.Email)
+}
+
+func Test_userService_VerifyEmail(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockUserRepository(ctrl)
+	verificationRepo := mock.NewMockEmailVerificationRepository(ctrl)
+	s := NewUserService(repo, mock.NewMockAttendanceRepository(ctrl), mock.NewMockReviewRepository(ctrl), mock.NewMockBlockRepository(ctrl), verificationRepo, mock.NewMockContentFilter(ctrl), mock.NewMockAvatarService(ctrl), mock.NewMockEmailSender(ctrl), "")
+
+	uid := "1"
+	dto := &domain.VerifyEmailDTO{Token: "token"}
+
+	// Unknown token
+	verificationRepo.EXPECT().GetEmailVerification(gomock.Eq(hashToken("token"))).Return(nil, fiber.ErrNotFound)
+	u, err := s.VerifyEmail(uid, dto)
+	assert.ErrorIs(t, err, domain.ErrInvalidEmailVerificationToken)
+	assert.Nil(t, u)
+
+	// Token of another user
+	verificationRepo.EXPECT().GetEmailVerification(gomock.Eq(hashToken("token"))).Return(&domain.EmailVerification{UserID: "2", ExpiresAt: time.Now().Add(time.Hour)}, nil)
+	u, err = s.VerifyEmail(uid, dto)
+	assert.ErrorIs(t, err, domain.ErrInvalidEmailVerificationToken)
+	assert.Nil(t, u)
+
+	// Expired token
+	verificationRepo.EXPECT().GetEmailVerification(gomock.Eq(hashToken("token"))).Return(&domain.EmailVerification{UserID: uid, ExpiresAt: time.Now().Add(-time.Hour)}, nil)
+	u, err = s.VerifyEmail(uid, dto)
+	assert.ErrorIs(t, err, domain.ErrInvalidEmailVerificationToken)
+	assert.Nil(t, u)
+
+	// Email taken in the meantime
+	verificationRepo.EXPECT().GetEmailVerification(gomock.Eq(hashToken("token"))).Return(&domain.EmailVerification{UserID: uid, Email: "new@upmeet.app", ExpiresAt: time.Now().Add(time.Hour)}, nil)
+	repo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{ID: uid}, nil)
+	repo.EXPECT().GetUserByEmail(gomock.Eq("new@upmeet.app")).Return(&domain.User{ID: "2"}, nil)
+	u, err = s.VerifyEmail(uid, dto)
+	assert.ErrorIs(t, err, domain.ErrEmailTaken)
+	assert.Nil(t, u)
+
+	// VerifyEmail successful
+	verificationRepo.EXPECT().GetEmailVerification(gomock.Eq(hashToken("token"))).Return(&domain.EmailVerification{UserID: uid, Email: "new@upmeet.app", ExpiresAt: time.Now().Add(time.Hour)}, nil)
+	repo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{ID: uid, Email: "old@upmeet.app"}, nil)
+	repo.EXPECT().GetUserByEmail(gomock.Eq("new@upmeet.app")).Return(nil, fiber.ErrNotFound)
+	repo.EXPECT().UpdateUser(gomock.Any()).Return(nil)
+	verificationRepo.EXPECT().DeleteEmailVerificationsByUserID(gomock.Eq(uid)).Return(nil)
+	u, err = s.VerifyEmail(uid, dto)
+	assert.NoError(t, err)
+	assert.Equal(t, "new@upmeet.app", u.Email)
+	assert.True(t, u.EmailVerified)
+}
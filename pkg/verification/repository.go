@@ -0,0 +1,91 @@
+package verification
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type ageVerificationRepository struct {
+	db *gorm.DB
+}
+
+// NewAgeVerificationRepository creates a new age verification repository instance.
+func NewAgeVerificationRepository(db *gorm.DB) domain.AgeVerificationRepository {
+	return &ageVerificationRepository{
+		db: db,
+	}
+}
+
+func (r *ageVerificationRepository) CreateAgeVerification(v *domain.AgeVerification) error {
+	err := r.db.Create(v).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to create age verification", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *ageVerificationRepository) GetAgeVerificationByID(id string) (*domain.AgeVerification, error) {
+	v := &domain.AgeVerification{}
+	err := r.db.Where("id = ?", id).First(v).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fiber.ErrNotFound
+		}
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get age verification by id", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return v, nil
+}
+
+// GetLatestAgeVerification leaves out the document, it is only loaded by id for the review.
+func (r *ageVerificationRepository) GetLatestAgeVerification(userID string) (*domain.AgeVerification, error) {
+	v := &domain.AgeVerification{}
+	err := r.db.Omit("Document").Where("user_id = ?", userID).Order("created_at DESC").First(v).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fiber.ErrNotFound
+		}
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get latest age verification", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return v, nil
+}
+
+// GetAgeVerifications leaves out the documents, they are only loaded by id for the review.
+func (r *ageVerificationRepository) GetAgeVerifications(filter *domain.AgeVerificationFilter) ([]*domain.AgeVerification, error) {
+	var verifications []*domain.AgeVerification
+	q := r.db.Omit("Document")
+	if len(filter.Status) > 0 {
+		q = q.Where("status = ?", filter.Status)
+	}
+	if len(filter.UserID) > 0 {
+		q = q.Where("user_id = ?", filter.UserID)
+	}
+	if len(filter.Before) > 0 {
+		q = q.Where("created_at < (SELECT created_at FROM age_verifications WHERE id = ?)", filter.Before)
+	}
+	err := q.Order("created_at DESC").Limit(filter.Limit).Find(&verifications).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get age verifications", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return verifications, nil
+}
+
+func (r *ageVerificationRepository) UpdateAgeVerification(v *domain.AgeVerification) error {
+	err := r.db.Save(v).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to update age verification", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
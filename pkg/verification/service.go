@@ -0,0 +1,152 @@
+package verification
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"net/http"
+	"time"
+)
+
+type ageVerificationService struct {
+	ageVerificationRepository domain.AgeVerificationRepository
+	userRepository            domain.UserRepository
+	notificationService       domain.NotificationService
+}
+
+// NewAgeVerificationService creates a new age verification service instance.
+func NewAgeVerificationService(ageVerificationRepository domain.AgeVerificationRepository, userRepository domain.UserRepository, notificationService domain.NotificationService) domain.AgeVerificationService {
+	return &ageVerificationService{
+		ageVerificationRepository: ageVerificationRepository,
+		userRepository:            userRepository,
+		notificationService:       notificationService,
+	}
+}
+
+func (s *ageVerificationService) SubmitAgeVerification(uid string, dto *domain.SubmitAgeVerificationDTO) (*domain.AgeVerification, error) {
+	now := time.Now()
+	birthdate, err := domain.ParseBirthdate(dto.Birthdate, now)
+	if err != nil {
+		return nil, err
+	}
+	if len(dto.Document) == 0 || len(dto.Document) > domain.AgeVerificationDocumentMaxSize {
+		return nil, domain.ErrInvalidDocument
+	}
+	documentType := http.DetectContentType(dto.Document)
+	if !isDocumentType(documentType) {
+		return nil, domain.ErrInvalidDocument
+	}
+
+	u, err := s.userRepository.GetUserByID(uid)
+	if err != nil {
+		return nil, err
+	}
+	if u.AgeVerified {
+		return nil, domain.ErrAgeAlreadyVerified
+	}
+	latest, err := s.ageVerificationRepository.GetLatestAgeVerification(uid)
+	if err != nil && err != fiber.ErrNotFound {
+		return nil, err
+	}
+	if latest != nil && latest.Status == domain.AgeVerificationStatusPending {
+		return nil, domain.ErrAgeVerificationPending
+	}
+
+	v := &domain.AgeVerification{
+		ID:           uuid.NewString(),
+		UserID:       uid,
+		Birthdate:    birthdate,
+		Document:     dto.Document,
+		DocumentType: documentType,
+		Status:       domain.AgeVerificationStatusPending,
+		CreatedAt:    now,
+	}
+	err = s.ageVerificationRepository.CreateAgeVerification(v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func (s *ageVerificationService) GetUserAgeVerification(uid string) (*domain.AgeVerification, error) {
+	v, err := s.ageVerificationRepository.GetLatestAgeVerification(uid)
+	if err != nil {
+		return nil, err
+	}
+	// Admins stay anonymous to users.
+	v.ReviewedBy = ""
+	return v, nil
+}
+
+func (s *ageVerificationService) GetAgeVerifications(filter *domain.AgeVerificationFilter) ([]*domain.AgeVerification, error) {
+	if filter.Limit <= 0 || filter.Limit > domain.AgeVerificationsMaxLimit {
+		filter.Limit = domain.AgeVerificationsDefaultLimit
+	}
+	return s.ageVerificationRepository.GetAgeVerifications(filter)
+}
+
+func (s *ageVerificationService) GetAgeVerification(id string) (*domain.AgeVerification, error) {
+	return s.ageVerificationRepository.GetAgeVerificationByID(id)
+}
+
+// ReviewAgeVerification approves or rejects the age verification. Either way the document is deleted, it isn't needed anymore.
+func (s *ageVerificationService) ReviewAgeVerification(adminID string, id string, dto *domain.ReviewAgeVerificationDTO) (*domain.AgeVerification, error) {
+	if dto.Status != domain.AgeVerificationStatusApproved && dto.Status != domain.AgeVerificationStatusRejected {
+		return nil, domain.ErrInvalidAgeVerificationStatus
+	}
+	if len(dto.Note) > domain.ModerationNoteMaxLength {
+		return nil, domain.ErrInvalidModerationNote
+	}
+	v, err := s.ageVerificationRepository.GetAgeVerificationByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if v.Status != domain.AgeVerificationStatusPending {
+		return nil, domain.ErrAgeVerificationReviewed
+	}
+
+	if dto.Status == domain.AgeVerificationStatusApproved {
+		u, err := s.userRepository.GetUserByID(v.UserID)
+		if err != nil {
+			return nil, err
+		}
+		birthdate := v.Birthdate
+		u.VerifiedBirthdate = &birthdate
+		u.Birthdate = &birthdate
+		u.AgeVerified = true
+		err = s.userRepository.UpdateUser(u)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	v.Status = dto.Status
+	v.Note = dto.Note
+	v.Document = nil
+	v.DocumentType = ""
+	v.ReviewedBy = adminID
+	v.ReviewedAt = &now
+	err = s.ageVerificationRepository.UpdateAgeVerification(v)
+	if err != nil {
+		return nil, err
+	}
+
+	// The verification was already reviewed, so a failed notification is only logged.
+	_ = s.notificationService.NotifyLater([]string{v.UserID}, domain.NotificationTypeAgeVerificationReviewed, &domain.AgeVerificationReview{
+		VerificationID: v.ID,
+		Status:         v.Status,
+		Note:           v.Note,
+	})
+	return v, nil
+}
+
+// isDocumentType returns whether the content type is an accepted document image type.
+func isDocumentType(contentType string) bool {
+	for _, t := range domain.AgeVerificationDocumentTypes {
+		if t == contentType {
+			return true
+		}
+	}
+	return false
+}
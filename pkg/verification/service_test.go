@@ -0,0 +1,126 @@
+package verification
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/UpMeetApp/server/pkg/domain/mock"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"testing"
+	"time"
+)
+
+var png = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func Test_ageVerificationService_SubmitAgeVerification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockAgeVerificationRepository(ctrl)
+	userRepo := mock.NewMockUserRepository(ctrl)
+	s := NewAgeVerificationService(repo, userRepo, mock.NewMockNotificationService(ctrl))
+
+	uid := "1"
+
+	// Invalid birthdate
+	v, err := s.SubmitAgeVerification(uid, &domain.SubmitAgeVerificationDTO{Birthdate: "01.02.2000", Document: png})
+	assert.ErrorIs(t, err, domain.ErrInvalidBirthdate)
+	assert.Nil(t, v)
+
+	// Birthdate in the future
+	v, err = s.SubmitAgeVerification(uid, &domain.SubmitAgeVerificationDTO{Birthdate: time.Now().AddDate(0, 0, 2).Format(domain.BirthdateLayout), Document: png})
+	assert.ErrorIs(t, err, domain.ErrInvalidBirthdate)
+	assert.Nil(t, v)
+
+	// Document isn't an image
+	v, err = s.SubmitAgeVerification(uid, &domain.SubmitAgeVerificationDTO{Birthdate: "2000-02-01", Document: []byte("%PDF-1.4")})
+	assert.ErrorIs(t, err, domain.ErrInvalidDocument)
+	assert.Nil(t, v)
+
+	// Already verified
+	userRepo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{ID: uid, AgeVerified: true}, nil)
+	v, err = s.SubmitAgeVerification(uid, &domain.SubmitAgeVerificationDTO{Birthdate: "2000-02-01", Document: png})
+	assert.ErrorIs(t, err, domain.ErrAgeAlreadyVerified)
+	assert.Nil(t, v)
+
+	// Verification pending
+	userRepo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{ID: uid}, nil)
+	repo.EXPECT().GetLatestAgeVerification(gomock.Eq(uid)).Return(&domain.AgeVerification{Status: domain.AgeVerificationStatusPending}, nil)
+	v, err = s.SubmitAgeVerification(uid, &domain.SubmitAgeVerificationDTO{Birthdate: "2000-02-01", Document: png})
+	assert.ErrorIs(t, err, domain.ErrAgeVerificationPending)
+	assert.Nil(t, v)
+
+	// SubmitAgeVerification successful after a rejection
+	userRepo.EXPECT().GetUserByID(gomock.Eq(uid)).Return(&domain.User{ID: uid}, nil)
+	repo.EXPECT().GetLatestAgeVerification(gomock.Eq(uid)).Return(&domain.AgeVerification{Status: domain.AgeVerificationStatusRejected}, nil)
+	repo.EXPECT().CreateAgeVerification(gomock.Any()).Return(nil)
+	v, err = s.SubmitAgeVerification(uid, &domain.SubmitAgeVerificationDTO{Birthdate: "2000-02-01", Document: png})
+	assert.NoError(t, err)
+	assert.Equal(t, time.Date(2000, 2, 1, 0, 0, 0, 0, time.UTC), v.Birthdate)
+	assert.Equal(t, "image/png", v.DocumentType)
+	assert.Equal(t, domain.AgeVerificationStatusPending, v.Status)
+}
+
+func Test_ageVerificationService_ReviewAgeVerification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockAgeVerificationRepository(ctrl)
+	userRepo := mock.NewMockUserRepository(ctrl)
+	notificationService := mock.NewMockNotificationService(ctrl)
+	s := NewAgeVerificationService(repo, userRepo, notificationService)
+
+	adminID := "admin"
+	birthdate := time.Date(2000, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	// Unknown status
+	v, err := s.ReviewAgeVerification(adminID, "v1", &domain.ReviewAgeVerificationDTO{Status: domain.AgeVerificationStatusPending})
+	assert.ErrorIs(t, err, domain.ErrInvalidAgeVerificationStatus)
+	assert.Nil(t, v)
+
+	// Already reviewed
+	repo.EXPECT().GetAgeVerificationByID(gomock.Eq("v1")).Return(&domain.AgeVerification{ID: "v1", Status: domain.AgeVerificationStatusRejected}, nil)
+	v, err = s.ReviewAgeVerification(adminID, "v1", &domain.ReviewAgeVerificationDTO{Status: domain.AgeVerificationStatusApproved})
+	assert.ErrorIs(t, err, domain.ErrAgeVerificationReviewed)
+	assert.Nil(t, v)
+
+	// Rejection deletes the document and notifies the user
+	repo.EXPECT().GetAgeVerificationByID(gomock.Eq("v1")).Return(&domain.AgeVerification{ID: "v1", UserID: "1", Birthdate: birthdate, Document: png, DocumentType: "image/png", Status: domain.AgeVerificationStatusPending}, nil)
+	repo.EXPECT().UpdateAgeVerification(gomock.Any()).Return(nil)
+	notificationService.EXPECT().NotifyLater(gomock.Eq([]string{"1"}), gomock.Eq(domain.NotificationTypeAgeVerificationReviewed), gomock.Any()).Return(nil)
+	v, err = s.ReviewAgeVerification(adminID, "v1", &domain.ReviewAgeVerificationDTO{Status: domain.AgeVerificationStatusRejected, Note: "unreadable"})
+	assert.NoError(t, err)
+	assert.Equal(t, domain.AgeVerificationStatusRejected, v.Status)
+	assert.Nil(t, v.Document)
+	assert.Equal(t, adminID, v.ReviewedBy)
+
+	// Approval sets the verified birthdate
+	repo.EXPECT().GetAgeVerificationByID(gomock.Eq("v2")).Return(&domain.AgeVerification{ID: "v2", UserID: "1", Birthdate: birthdate, Document: png, DocumentType: "image/png", Status: domain.AgeVerificationStatusPending}, nil)
+	userRepo.EXPECT().GetUserByID(gomock.Eq("1")).Return(&domain.User{ID: "1"}, nil)
+	userRepo.EXPECT().UpdateUser(gomock.Any()).DoAndReturn(func(u *domain.User) error {
+		assert.True(t, u.AgeVerified)
+		assert.Equal(t, birthdate, *u.VerifiedBirthdate)
+		assert.Equal(t, birthdate, *u.Birthdate)
+		return nil
+	})
+	repo.EXPECT().UpdateAgeVerification(gomock.Any()).Return(nil)
+	notificationService.EXPECT().NotifyLater(gomock.Eq([]string{"1"}), gomock.Eq(domain.NotificationTypeAgeVerificationReviewed), gomock.Any()).Return(nil)
+	v, err = s.ReviewAgeVerification(adminID, "v2", &domain.ReviewAgeVerificationDTO{Status: domain.AgeVerificationStatusApproved})
+	assert.NoError(t, err)
+	assert.Equal(t, domain.AgeVerificationStatusApproved, v.Status)
+	assert.Nil(t, v.Document)
+}
+
+func Test_ageVerificationService_GetUserAgeVerification(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockAgeVerificationRepository(ctrl)
+	s := NewAgeVerificationService(repo, mock.NewMockUserRepository(ctrl), mock.NewMockNotificationService(ctrl))
+
+	// Never requested
+	repo.EXPECT().GetLatestAgeVerification(gomock.Eq("1")).Return(nil, fiber.ErrNotFound)
+	v, err := s.GetUserAgeVerification("1")
+	assert.ErrorIs(t, err, fiber.ErrNotFound)
+	assert.Nil(t, v)
+
+	// The reviewing admin is left out
+	repo.EXPECT().GetLatestAgeVerification(gomock.Eq("1")).Return(&domain.AgeVerification{ID: "v1", ReviewedBy: "admin"}, nil)
+	v, err = s.GetUserAgeVerification("1")
+	assert.NoError(t, err)
+	assert.Empty(t, v.ReviewedBy)
+}
@@ -0,0 +1,45 @@
+package webhook
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type webhookDeliveryRepository struct {
+	db *gorm.DB
+}
+
+// NewWebhookDeliveryRepository creates a new webhook delivery repository instance.
+func NewWebhookDeliveryRepository(db *gorm.DB) domain.WebhookDeliveryRepository {
+	return &webhookDeliveryRepository{
+		db: db,
+	}
+}
+
+func (r *webhookDeliveryRepository) CreateWebhookDelivery(d *domain.WebhookDelivery) error {
+	err := r.db.Create(d).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to create webhook delivery", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *webhookDeliveryRepository) GetWebhookDeliveries(webhookID string, before string, limit int) ([]*domain.WebhookDelivery, error) {
+	var deliveries []*domain.WebhookDelivery
+	q := r.db.Where("webhook_id = ?", webhookID)
+	if len(before) > 0 {
+		q = q.Where("created_at < (SELECT created_at FROM webhook_deliveries WHERE id = ?)", before)
+	}
+	err := q.Order("created_at DESC").Limit(limit).Find(&deliveries).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get webhook deliveries", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return deliveries, nil
+}
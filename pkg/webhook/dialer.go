@@ -0,0 +1,108 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+)
+
+// blockedNetworks are the special purpose networks webhooks must not reach that the net.IP methods don't cover.
+var blockedNetworks = []*net.IPNet{
+	mustParseCIDR("0.0.0.0/8"),     // "This" network
+	mustParseCIDR("100.64.0.0/10"), // Shared address space, also used for cloud metadata services
+	mustParseCIDR("192.0.0.0/24"),  // IETF protocol assignments
+	mustParseCIDR("198.18.0.0/15"), // Benchmarking
+	mustParseCIDR("64:ff9b::/96"),  // NAT64, which can embed any IPv4 address
+}
+
+// Resolver looks up the IP addresses of a host, it is implemented by net.Resolver.
+type Resolver interface {
+	LookupIPAddr(ctx context.Context, host string) ([]net.IPAddr, error)
+}
+
+// Dialer connects to webhook endpoints.
+// Webhook URLs are chosen by users, so it resolves the hosts itself and refuses to connect to anything but public addresses.
+// Checking the address when dialing, instead of only when the webhook is registered, also covers DNS records changing later on.
+type Dialer struct {
+	// Resolver resolves the webhook hosts, net.DefaultResolver is used when it is nil.
+	Resolver Resolver
+	// AllowLoopback permits loopback addresses, which is only meant for tests.
+	AllowLoopback bool
+}
+
+// DialContext connects to the address like net.Dialer does, but fails if the host resolves to a non-public address.
+func (d *Dialer) DialContext(ctx context.Context, network string, address string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	ips, err := d.lookup(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	dialer := &net.Dialer{Timeout: 5 * time.Second}
+	for _, ip := range ips {
+		var conn net.Conn
+		conn, err = dialer.DialContext(ctx, network, net.JoinHostPort(ip.String(), port))
+		if err == nil {
+			return conn, nil
+		}
+	}
+	return nil, err
+}
+
+// lookup resolves the host and returns its addresses.
+// Hosts with any non-public address are refused as a whole, so the result doesn't depend on which address is tried first.
+func (d *Dialer) lookup(ctx context.Context, host string) ([]net.IP, error) {
+	var ips []net.IP
+	if ip := net.ParseIP(host); ip != nil {
+		ips = []net.IP{ip}
+	} else {
+		resolver := d.Resolver
+		if resolver == nil {
+			resolver = net.DefaultResolver
+		}
+		addrs, err := resolver.LookupIPAddr(ctx, host)
+		if err != nil {
+			return nil, err
+		}
+		for _, addr := range addrs {
+			ips = append(ips, addr.IP)
+		}
+	}
+	if len(ips) == 0 {
+		return nil, fmt.Errorf("no addresses found for %s", host)
+	}
+	for _, ip := range ips {
+		if !d.isAllowed(ip) {
+			return nil, fmt.Errorf("webhook host %s resolves to non-public address %s", host, ip)
+		}
+	}
+	return ips, nil
+}
+
+// isAllowed returns whether the dialer may connect to the address.
+func (d *Dialer) isAllowed(ip net.IP) bool {
+	if ip.IsLoopback() {
+		return d.AllowLoopback
+	}
+	if ip.IsPrivate() || ip.IsUnspecified() || ip.IsLinkLocalUnicast() || ip.IsMulticast() {
+		return false
+	}
+	for _, n := range blockedNetworks {
+		if n.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+func mustParseCIDR(s string) *net.IPNet {
+	_, n, err := net.ParseCIDR(s)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
@@ -0,0 +1,81 @@
+package webhook
+
+import (
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"go.uber.org/zap"
+	"gorm.io/gorm"
+)
+
+type webhookRepository struct {
+	db *gorm.DB
+}
+
+// NewWebhookRepository creates a new webhook repository instance.
+func NewWebhookRepository(db *gorm.DB) domain.WebhookRepository {
+	return &webhookRepository{
+		db: db,
+	}
+}
+
+func (r *webhookRepository) CreateWebhook(w *domain.Webhook) error {
+	err := r.db.Create(w).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to create webhook", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *webhookRepository) GetWebhookByID(id string) (*domain.Webhook, error) {
+	w := &domain.Webhook{}
+	err := r.db.Where("id = ?", id).First(w).Error
+	if err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, fiber.ErrNotFound
+		}
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get webhook by id", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return w, nil
+}
+
+func (r *webhookRepository) GetWebhooksByMeetupID(meetupID string) ([]*domain.Webhook, error) {
+	var webhooks []*domain.Webhook
+	err := r.db.Where("meetup_id = ?", meetupID).Order("created_at").Find(&webhooks).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to get webhooks by meetup id", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+	return webhooks, nil
+}
+
+func (r *webhookRepository) UpdateWebhook(w *domain.Webhook) error {
+	err := r.db.Save(w).Error
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to update webhook", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
+
+func (r *webhookRepository) DeleteWebhook(id string) error {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		err := tx.Where("webhook_id = ?", id).Delete(&domain.WebhookDelivery{}).Error
+		if err != nil {
+			return err
+		}
+		return tx.Where("id = ?", id).Delete(&domain.Webhook{}).Error
+	})
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to delete webhook", zap.Error(err))
+		return fiber.ErrInternalServerError
+	}
+	return nil
+}
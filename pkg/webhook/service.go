@@ -0,0 +1,379 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/getsentry/sentry-go"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+type webhookService struct {
+	webhookRepository         domain.WebhookRepository
+	webhookDeliveryRepository domain.WebhookDeliveryRepository
+	meetupRepository          domain.MeetupRepository
+	jobScheduler              domain.JobScheduler
+	dialer                    *Dialer
+	client                    *http.Client
+}
+
+// NewWebhookService creates a new webhook service instance.
+// Deliveries only connect through the dialer and never follow redirects, since a redirect could lead to a non-public address as well.
+func NewWebhookService(webhookRepository domain.WebhookRepository, webhookDeliveryRepository domain.WebhookDeliveryRepository, meetupRepository domain.MeetupRepository, jobScheduler domain.JobScheduler, dialer *Dialer) domain.WebhookService {
+	return &webhookService{
+		webhookRepository:         webhookRepository,
+		webhookDeliveryRepository: webhookDeliveryRepository,
+		meetupRepository:          meetupRepository,
+		jobScheduler:              jobScheduler,
+		dialer:                    dialer,
+		client: &http.Client{
+			Timeout: domain.WebhookTimeout,
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: domain.WebhookTimeout,
+				MaxIdleConnsPerHost: 2,
+			},
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+func (s *webhookService) GetWebhooks(uid string, meetupID string) ([]*domain.Webhook, error) {
+	err := s.checkMeetupOwner(uid, meetupID)
+	if err != nil {
+		return nil, err
+	}
+	webhooks, err := s.webhookRepository.GetWebhooksByMeetupID(meetupID)
+	if err != nil {
+		return nil, err
+	}
+	for _, w := range webhooks {
+		w.Secret = ""
+	}
+	return webhooks, nil
+}
+
+func (s *webhookService) CreateWebhook(uid string, meetupID string, dto *domain.CreateWebhookDTO) (*domain.Webhook, error) {
+	err := s.checkMeetupOwner(uid, meetupID)
+	if err != nil {
+		return nil, err
+	}
+	err = s.checkURL(dto.URL)
+	if err != nil {
+		return nil, err
+	}
+	if !isValidEventFilter(dto.Events) {
+		return nil, domain.ErrInvalidWebhookEvents
+	}
+	webhooks, err := s.webhookRepository.GetWebhooksByMeetupID(meetupID)
+	if err != nil {
+		return nil, err
+	}
+	if len(webhooks) >= domain.WebhooksMaxPerMeetup {
+		return nil, domain.ErrTooManyWebhooks
+	}
+
+	secret := make([]byte, 32)
+	_, err = rand.Read(secret)
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to generate webhook secret", zap.Error(err))
+		return nil, fiber.ErrInternalServerError
+	}
+
+	w := &domain.Webhook{
+		ID:        uuid.NewString(),
+		MeetupID:  meetupID,
+		OwnerID:   uid,
+		URL:       dto.URL,
+		Secret:    hex.EncodeToString(secret),
+		Events:    dto.Events,
+		Active:    true,
+		CreatedAt: time.Now(),
+	}
+	err = s.webhookRepository.CreateWebhook(w)
+	if err != nil {
+		return nil, err
+	}
+	return w, nil
+}
+
+func (s *webhookService) UpdateWebhook(uid string, meetupID string, id string, dto *domain.UpdateWebhookDTO) (*domain.Webhook, error) {
+	w, err := s.getMeetupWebhook(uid, meetupID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update URL
+	if len(dto.URL) > 0 {
+		err = s.checkURL(dto.URL)
+		if err != nil {
+			return nil, err
+		}
+		w.URL = dto.URL
+	}
+
+	// Update Events
+	if len(dto.Events) > 0 {
+		if !isValidEventFilter(dto.Events) {
+			return nil, domain.ErrInvalidWebhookEvents
+		}
+		w.Events = dto.Events
+	}
+
+	// Update Active
+	if dto.Active != nil && *dto.Active != w.Active {
+		w.Active = *dto.Active
+		w.ConsecutiveFailures = 0
+		w.DisabledAt = nil
+	}
+
+	err = s.webhookRepository.UpdateWebhook(w)
+	if err != nil {
+		return nil, err
+	}
+	w.Secret = ""
+	return w, nil
+}
+
+func (s *webhookService) DeleteWebhook(uid string, meetupID string, id string) error {
+	_, err := s.getMeetupWebhook(uid, meetupID, id)
+	if err != nil {
+		return err
+	}
+	return s.webhookRepository.DeleteWebhook(id)
+}
+
+func (s *webhookService) GetWebhookDeliveries(uid string, meetupID string, id string, before string, limit int) ([]*domain.WebhookDelivery, error) {
+	_, err := s.getMeetupWebhook(uid, meetupID, id)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > domain.WebhookDeliveriesMaxLimit {
+		limit = domain.WebhookDeliveriesDefaultLimit
+	}
+	return s.webhookDeliveryRepository.GetWebhookDeliveries(id, before, limit)
+}
+
+func (s *webhookService) DispatchEvent(e *domain.Event) error {
+	if !isWebhookEventType(e.Type) {
+		return nil
+	}
+	// Every event webhooks can subscribe to is about a meetup.
+	webhooks, err := s.webhookRepository.GetWebhooksByMeetupID(e.AggregateID)
+	if err != nil {
+		return err
+	}
+	for _, w := range webhooks {
+		if !w.Active || !w.Events.Matches(e.Type) {
+			continue
+		}
+		// The key makes scheduling idempotent while the delivery is pending, so an event relayed twice is usually only delivered once per webhook.
+		key := domain.JobTypeWebhookDelivery + ":" + w.ID + ":" + e.ID
+		err = s.jobScheduler.Schedule(domain.JobTypeWebhookDelivery, key, time.Now(), &domain.WebhookDeliveryJob{WebhookID: w.ID, Event: e})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *webhookService) DeliverWebhook(j *domain.Job) error {
+	p := &domain.WebhookDeliveryJob{}
+	err := json.Unmarshal(j.Payload, p)
+	if err != nil {
+		sentry.CaptureException(err)
+		zap.L().Error("failed to unmarshal webhook delivery job payload", zap.Error(err))
+		return err
+	}
+	w, err := s.webhookRepository.GetWebhookByID(p.WebhookID)
+	if err != nil {
+		// The webhook was deleted in the meantime.
+		if err == fiber.ErrNotFound {
+			return nil
+		}
+		return err
+	}
+	if !w.Active {
+		return nil
+	}
+
+	start := time.Now()
+	statusCode, err := s.post(w, p.Event, start)
+	if err == nil && (statusCode < 200 || statusCode > 299) {
+		err = fmt.Errorf("webhook responded with status %d", statusCode)
+	}
+	d := &domain.WebhookDelivery{
+		ID:         uuid.NewString(),
+		WebhookID:  w.ID,
+		EventID:    p.Event.ID,
+		EventType:  p.Event.Type,
+		Attempt:    j.Attempts,
+		StatusCode: statusCode,
+		Success:    err == nil,
+		DurationMs: time.Since(start).Milliseconds(),
+		CreatedAt:  start,
+	}
+	if err != nil {
+		d.Error = err.Error()
+	}
+	_ = s.webhookDeliveryRepository.CreateWebhookDelivery(d)
+
+	if err == nil {
+		if w.ConsecutiveFailures > 0 {
+			w.ConsecutiveFailures = 0
+			_ = s.webhookRepository.UpdateWebhook(w)
+		}
+		return nil
+	}
+
+	w.ConsecutiveFailures++
+	if w.ConsecutiveFailures >= domain.WebhookMaxConsecutiveFailures {
+		w.Active = false
+		w.DisabledAt = &start
+	}
+	updateErr := s.webhookRepository.UpdateWebhook(w)
+	if updateErr != nil {
+		return updateErr
+	}
+	if !w.Active {
+		zap.L().Info("disabled failing webhook", zap.String("id", w.ID), zap.String("meetup_id", w.MeetupID))
+		return nil
+	}
+	// Returning the error lets the job scheduler retry the delivery with backoff.
+	return err
+}
+
+// post sends the signed event to the webhook and returns the status code of the response.
+func (s *webhookService) post(w *domain.Webhook, e *domain.Event, now time.Time) (int, error) {
+	body, err := json.Marshal(&domain.WebhookPayload{
+		ID:        e.ID,
+		Type:      e.Type,
+		CreatedAt: e.CreatedAt,
+		Data:      e.Payload,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "UpMeet-Webhooks/1.0")
+	req.Header.Set(domain.WebhookEventHeader, e.Type)
+	req.Header.Set(domain.WebhookDeliveryHeader, e.ID)
+	req.Header.Set(domain.WebhookTimestampHeader, timestamp)
+	req.Header.Set(domain.WebhookSignatureHeader, "sha256="+sign(w.Secret, timestamp, body))
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+	// The body is drained so the connection can be reused, but never read beyond a small limit.
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 64*1024))
+	return res.StatusCode, nil
+}
+
+// checkURL returns ErrInvalidWebhookURL if the URL is invalid or its host doesn't resolve to public addresses only.
+func (s *webhookService) checkURL(rawURL string) error {
+	if !isValidURL(rawURL) {
+		return domain.ErrInvalidWebhookURL
+	}
+	u, _ := url.Parse(rawURL)
+	c, ccl := context.WithTimeout(context.Background(), time.Second*5)
+	defer ccl()
+	_, err := s.dialer.lookup(c, u.Hostname())
+	if err != nil {
+		return domain.ErrInvalidWebhookURL
+	}
+	return nil
+}
+
+// checkMeetupOwner returns an error if the meetup doesn't exist or the user doesn't own it.
+func (s *webhookService) checkMeetupOwner(uid string, meetupID string) error {
+	m, err := s.meetupRepository.GetMeetupByID(meetupID)
+	if err != nil {
+		return err
+	}
+	if m.OwnerID != uid {
+		return domain.ErrNotMeetupOwner
+	}
+	return nil
+}
+
+// getMeetupWebhook returns the webhook if it belongs to the meetup and the user owns the meetup.
+func (s *webhookService) getMeetupWebhook(uid string, meetupID string, id string) (*domain.Webhook, error) {
+	err := s.checkMeetupOwner(uid, meetupID)
+	if err != nil {
+		return nil, err
+	}
+	w, err := s.webhookRepository.GetWebhookByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if w.MeetupID != meetupID {
+		return nil, fiber.ErrNotFound
+	}
+	return w, nil
+}
+
+// sign returns the hex encoded HMAC-SHA256 of "<timestamp>.<body>".
+// The timestamp is part of the signature so receivers can reject replayed requests.
+func sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func isValidURL(s string) bool {
+	if len(s) > domain.WebhookURLMaxLength {
+		return false
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && len(u.Host) > 0
+}
+
+func isValidEventFilter(events []string) bool {
+	if len(events) == 0 {
+		return false
+	}
+	for _, t := range events {
+		if t != domain.EventTypeAll && !isWebhookEventType(t) {
+			return false
+		}
+	}
+	return true
+}
+
+func isWebhookEventType(t string) bool {
+	for _, webhookEventType := range domain.WebhookEventTypes {
+		if t == webhookEventType {
+			return true
+		}
+	}
+	return false
+}
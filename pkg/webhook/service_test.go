@@ -0,0 +1,269 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/UpMeetApp/server/pkg/domain"
+	"github.com/UpMeetApp/server/pkg/domain/mock"
+	"github.com/gofiber/fiber/v2"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+type staticResolver map[string]string
+
+func (r staticResolver) LookupIPAddr(_ context.Context, host string) ([]net.IPAddr, error) {
+	ip, ok := r[host]
+	if !ok {
+		return nil, &net.DNSError{Err: "no such host", Name: host, IsNotFound: true}
+	}
+	return []net.IPAddr{{IP: net.ParseIP(ip)}}, nil
+}
+
+var testDialer = &Dialer{Resolver: staticResolver{
+	"example.com":          "93.184.216.34",
+	"internal.example.com": "10.0.0.1",
+	"localhost":            "127.0.0.1",
+}}
+
+func Test_webhookService_CreateWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockWebhookRepository(ctrl)
+	meetupRepo := mock.NewMockMeetupRepository(ctrl)
+	s := NewWebhookService(repo, mock.NewMockWebhookDeliveryRepository(ctrl), meetupRepo, mock.NewMockJobScheduler(ctrl), testDialer)
+
+	uid := "1"
+	meetupID := "m1"
+
+	// Not the owner
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(meetupID)).Return(&domain.Meetup{ID: meetupID, OwnerID: "2"}, nil)
+	w, err := s.CreateWebhook(uid, meetupID, &domain.CreateWebhookDTO{})
+	assert.ErrorIs(t, err, domain.ErrNotMeetupOwner)
+	assert.Nil(t, w)
+
+	// Invalid URL
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(meetupID)).Return(&domain.Meetup{ID: meetupID, OwnerID: uid}, nil)
+	w, err = s.CreateWebhook(uid, meetupID, &domain.CreateWebhookDTO{URL: "ftp://example.com", Events: []string{domain.EventTypeAll}})
+	assert.ErrorIs(t, err, domain.ErrInvalidWebhookURL)
+	assert.Nil(t, w)
+
+	// Internal addresses
+	for _, u := range []string{"https://internal.example.com/hook", "http://localhost:8080", "http://169.254.169.254/latest/meta-data", "http://[::1]/", "http://unknown.example.com"} {
+		meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(meetupID)).Return(&domain.Meetup{ID: meetupID, OwnerID: uid}, nil)
+		w, err = s.CreateWebhook(uid, meetupID, &domain.CreateWebhookDTO{URL: u, Events: []string{domain.EventTypeAll}})
+		assert.ErrorIs(t, err, domain.ErrInvalidWebhookURL, u)
+		assert.Nil(t, w)
+	}
+
+	// Unknown event type
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(meetupID)).Return(&domain.Meetup{ID: meetupID, OwnerID: uid}, nil)
+	w, err = s.CreateWebhook(uid, meetupID, &domain.CreateWebhookDTO{URL: "https://example.com", Events: []string{domain.EventTypeUserCreated}})
+	assert.ErrorIs(t, err, domain.ErrInvalidWebhookEvents)
+	assert.Nil(t, w)
+
+	// Too many webhooks
+	dto := &domain.CreateWebhookDTO{URL: "https://example.com/hook", Events: []string{domain.EventTypeParticipantAdded}}
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(meetupID)).Return(&domain.Meetup{ID: meetupID, OwnerID: uid}, nil)
+	repo.EXPECT().GetWebhooksByMeetupID(gomock.Eq(meetupID)).Return(make([]*domain.Webhook, domain.WebhooksMaxPerMeetup), nil)
+	w, err = s.CreateWebhook(uid, meetupID, dto)
+	assert.ErrorIs(t, err, domain.ErrTooManyWebhooks)
+	assert.Nil(t, w)
+
+	// CreateWebhook successful
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(meetupID)).Return(&domain.Meetup{ID: meetupID, OwnerID: uid}, nil)
+	repo.EXPECT().GetWebhooksByMeetupID(gomock.Eq(meetupID)).Return(nil, nil)
+	repo.EXPECT().CreateWebhook(gomock.Any()).Return(nil)
+	w, err = s.CreateWebhook(uid, meetupID, dto)
+	assert.NoError(t, err)
+	assert.Equal(t, dto.URL, w.URL)
+	assert.Len(t, w.Secret, 64)
+	assert.True(t, w.Active)
+}
+
+func Test_webhookService_UpdateWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockWebhookRepository(ctrl)
+	meetupRepo := mock.NewMockMeetupRepository(ctrl)
+	s := NewWebhookService(repo, mock.NewMockWebhookDeliveryRepository(ctrl), meetupRepo, mock.NewMockJobScheduler(ctrl), testDialer)
+
+	uid := "1"
+	meetupID := "m1"
+	id := "w1"
+
+	// Webhook of another meetup
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(meetupID)).Return(&domain.Meetup{ID: meetupID, OwnerID: uid}, nil)
+	repo.EXPECT().GetWebhookByID(gomock.Eq(id)).Return(&domain.Webhook{ID: id, MeetupID: "m2"}, nil)
+	w, err := s.UpdateWebhook(uid, meetupID, id, &domain.UpdateWebhookDTO{})
+	assert.ErrorIs(t, err, fiber.ErrNotFound)
+	assert.Nil(t, w)
+
+	// Enabling a disabled webhook resets its failures
+	active := true
+	disabledAt := time.Now()
+	meetupRepo.EXPECT().GetMeetupByID(gomock.Eq(meetupID)).Return(&domain.Meetup{ID: meetupID, OwnerID: uid}, nil)
+	repo.EXPECT().GetWebhookByID(gomock.Eq(id)).Return(&domain.Webhook{ID: id, MeetupID: meetupID, Secret: "secret", ConsecutiveFailures: 15, DisabledAt: &disabledAt}, nil)
+	repo.EXPECT().UpdateWebhook(gomock.Any()).Return(nil)
+	w, err = s.UpdateWebhook(uid, meetupID, id, &domain.UpdateWebhookDTO{Active: &active})
+	assert.NoError(t, err)
+	assert.True(t, w.Active)
+	assert.Zero(t, w.ConsecutiveFailures)
+	assert.Nil(t, w.DisabledAt)
+	assert.Empty(t, w.Secret)
+}
+
+func Test_webhookService_DispatchEvent(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockWebhookRepository(ctrl)
+	jobScheduler := mock.NewMockJobScheduler(ctrl)
+	s := NewWebhookService(repo, mock.NewMockWebhookDeliveryRepository(ctrl), mock.NewMockMeetupRepository(ctrl), jobScheduler, testDialer)
+
+	// Events webhooks can't subscribe to are ignored
+	err := s.DispatchEvent(&domain.Event{ID: "e1", Type: domain.EventTypeUserCreated, AggregateID: "1"})
+	assert.NoError(t, err)
+
+	// Only active webhooks with a matching filter receive the event
+	e := &domain.Event{ID: "e2", Type: domain.EventTypeParticipantAdded, AggregateID: "m1"}
+	repo.EXPECT().GetWebhooksByMeetupID(gomock.Eq("m1")).Return([]*domain.Webhook{
+		{ID: "w1", Active: true, Events: domain.WebhookEvents{domain.EventTypeParticipantAdded}},
+		{ID: "w2", Active: true, Events: domain.WebhookEvents{domain.EventTypeAll}},
+		{ID: "w3", Active: true, Events: domain.WebhookEvents{domain.EventTypeMeetupUpdated}},
+		{ID: "w4", Active: false, Events: domain.WebhookEvents{domain.EventTypeAll}},
+	}, nil)
+	jobScheduler.EXPECT().Schedule(gomock.Eq(domain.JobTypeWebhookDelivery), gomock.Eq("webhook.delivery:w1:e2"), gomock.Any(), gomock.Any()).Return(nil)
+	jobScheduler.EXPECT().Schedule(gomock.Eq(domain.JobTypeWebhookDelivery), gomock.Eq("webhook.delivery:w2:e2"), gomock.Any(), gomock.Any()).Return(nil)
+	err = s.DispatchEvent(e)
+	assert.NoError(t, err)
+}
+
+func Test_webhookService_DeliverWebhook(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockWebhookRepository(ctrl)
+	deliveryRepo := mock.NewMockWebhookDeliveryRepository(ctrl)
+
+	status := http.StatusOK
+	var received *http.Request
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r
+		body, _ = io.ReadAll(r.Body)
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+	s := NewWebhookService(repo, deliveryRepo, mock.NewMockMeetupRepository(ctrl), mock.NewMockJobScheduler(ctrl), &Dialer{AllowLoopback: true})
+
+	e := &domain.Event{ID: "e1", Type: domain.EventTypeMeetupUpdated, AggregateID: "m1", Payload: json.RawMessage(`{"id":"m1"}`)}
+	payload, _ := json.Marshal(&domain.WebhookDeliveryJob{WebhookID: "w1", Event: e})
+	j := &domain.Job{Type: domain.JobTypeWebhookDelivery, Payload: payload, Attempts: 1}
+
+	// Deleted webhook
+	repo.EXPECT().GetWebhookByID(gomock.Eq("w1")).Return(nil, fiber.ErrNotFound)
+	err := s.DeliverWebhook(j)
+	assert.NoError(t, err)
+
+	// Delivery successful and signed
+	repo.EXPECT().GetWebhookByID(gomock.Eq("w1")).Return(&domain.Webhook{ID: "w1", URL: srv.URL, Secret: "secret", Active: true}, nil)
+	deliveryRepo.EXPECT().CreateWebhookDelivery(gomock.Any()).DoAndReturn(func(d *domain.WebhookDelivery) error {
+		assert.True(t, d.Success)
+		assert.Equal(t, http.StatusOK, d.StatusCode)
+		assert.Equal(t, "e1", d.EventID)
+		return nil
+	})
+	err = s.DeliverWebhook(j)
+	assert.NoError(t, err)
+	assert.Equal(t, domain.EventTypeMeetupUpdated, received.Header.Get(domain.WebhookEventHeader))
+	assert.Equal(t, "e1", received.Header.Get(domain.WebhookDeliveryHeader))
+	assert.Equal(t, "sha256="+sign("secret", received.Header.Get(domain.WebhookTimestampHeader), body), received.Header.Get(domain.WebhookSignatureHeader))
+	p := &domain.WebhookPayload{}
+	assert.NoError(t, json.Unmarshal(body, p))
+	assert.Equal(t, "e1", p.ID)
+	assert.JSONEq(t, `{"id":"m1"}`, string(p.Data))
+
+	// Failed delivery is recorded and retried
+	status = http.StatusInternalServerError
+	repo.EXPECT().GetWebhookByID(gomock.Eq("w1")).Return(&domain.Webhook{ID: "w1", URL: srv.URL, Secret: "secret", Active: true}, nil)
+	deliveryRepo.EXPECT().CreateWebhookDelivery(gomock.Any()).DoAndReturn(func(d *domain.WebhookDelivery) error {
+		assert.False(t, d.Success)
+		assert.Equal(t, http.StatusInternalServerError, d.StatusCode)
+		assert.NotEmpty(t, d.Error)
+		return nil
+	})
+	repo.EXPECT().UpdateWebhook(gomock.Any()).DoAndReturn(func(w *domain.Webhook) error {
+		assert.Equal(t, 1, w.ConsecutiveFailures)
+		assert.True(t, w.Active)
+		return nil
+	})
+	err = s.DeliverWebhook(j)
+	assert.Error(t, err)
+
+	// Webhook failing too often is disabled and not retried
+	repo.EXPECT().GetWebhookByID(gomock.Eq("w1")).Return(&domain.Webhook{ID: "w1", URL: srv.URL, Secret: "secret", Active: true, ConsecutiveFailures: domain.WebhookMaxConsecutiveFailures - 1}, nil)
+	deliveryRepo.EXPECT().CreateWebhookDelivery(gomock.Any()).Return(nil)
+	repo.EXPECT().UpdateWebhook(gomock.Any()).DoAndReturn(func(w *domain.Webhook) error {
+		assert.False(t, w.Active)
+		assert.NotNil(t, w.DisabledAt)
+		return nil
+	})
+	err = s.DeliverWebhook(j)
+	assert.NoError(t, err)
+
+	// Success resets the failures
+	status = http.StatusNoContent
+	repo.EXPECT().GetWebhookByID(gomock.Eq("w1")).Return(&domain.Webhook{ID: "w1", URL: srv.URL, Secret: "secret", Active: true, ConsecutiveFailures: 3}, nil)
+	deliveryRepo.EXPECT().CreateWebhookDelivery(gomock.Any()).Return(nil)
+	repo.EXPECT().UpdateWebhook(gomock.Any()).DoAndReturn(func(w *domain.Webhook) error {
+		assert.Zero(t, w.ConsecutiveFailures)
+		return nil
+	})
+	err = s.DeliverWebhook(j)
+	assert.NoError(t, err)
+}
+
+func Test_webhookService_DeliverWebhook_Restricted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mock.NewMockWebhookRepository(ctrl)
+	deliveryRepo := mock.NewMockWebhookDeliveryRepository(ctrl)
+
+	requested := false
+	internal := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requested = true
+	}))
+	defer internal.Close()
+	redirect := httptest.NewServer(http.RedirectHandler(internal.URL, http.StatusFound))
+	defer redirect.Close()
+
+	e := &domain.Event{ID: "e1", Type: domain.EventTypeMeetupUpdated, AggregateID: "m1"}
+	payload, _ := json.Marshal(&domain.WebhookDeliveryJob{WebhookID: "w1", Event: e})
+	j := &domain.Job{Type: domain.JobTypeWebhookDelivery, Payload: payload, Attempts: 1}
+
+	// Loopback addresses are refused when dialing
+	s := NewWebhookService(repo, deliveryRepo, mock.NewMockMeetupRepository(ctrl), mock.NewMockJobScheduler(ctrl), &Dialer{})
+	repo.EXPECT().GetWebhookByID(gomock.Eq("w1")).Return(&domain.Webhook{ID: "w1", URL: internal.URL, Active: true}, nil)
+	deliveryRepo.EXPECT().CreateWebhookDelivery(gomock.Any()).DoAndReturn(func(d *domain.WebhookDelivery) error {
+		assert.False(t, d.Success)
+		assert.Contains(t, d.Error, "non-public address")
+		return nil
+	})
+	repo.EXPECT().UpdateWebhook(gomock.Any()).Return(nil)
+	err := s.DeliverWebhook(j)
+	assert.Error(t, err)
+	assert.False(t, requested)
+
+	// Redirects aren't followed
+	s = NewWebhookService(repo, deliveryRepo, mock.NewMockMeetupRepository(ctrl), mock.NewMockJobScheduler(ctrl), &Dialer{AllowLoopback: true})
+	repo.EXPECT().GetWebhookByID(gomock.Eq("w1")).Return(&domain.Webhook{ID: "w1", URL: redirect.URL, Active: true}, nil)
+	deliveryRepo.EXPECT().CreateWebhookDelivery(gomock.Any()).DoAndReturn(func(d *domain.WebhookDelivery) error {
+		assert.False(t, d.Success)
+		assert.Equal(t, http.StatusFound, d.StatusCode)
+		return nil
+	})
+	repo.EXPECT().UpdateWebhook(gomock.Any()).Return(nil)
+	err = s.DeliverWebhook(j)
+	assert.Error(t, err)
+	assert.False(t, requested)
+}